@@ -68,6 +68,8 @@ type TenantInterface interface {
 	GetManyDeployVersion(w http.ResponseWriter, r *http.Request)
 	LimitTenantMemory(w http.ResponseWriter, r *http.Request)
 	TenantResourcesStatus(w http.ResponseWriter, r *http.Request)
+	ImageScanPolicy(w http.ResponseWriter, r *http.Request)
+	LogRetentionPolicy(w http.ResponseWriter, r *http.Request)
 	CheckResourceName(w http.ResponseWriter, r *http.Request)
 	Log(w http.ResponseWriter, r *http.Request)
 }
@@ -107,9 +109,18 @@ type ServiceInterface interface {
 	GetDeployVersion(w http.ResponseWriter, r *http.Request)
 	AutoscalerRules(w http.ResponseWriter, r *http.Request)
 	ScalingRecords(w http.ResponseWriter, r *http.Request)
+	ScalingSchedules(w http.ResponseWriter, r *http.Request)
+	ScalingSchedule(w http.ResponseWriter, r *http.Request)
 	AddServiceMonitors(w http.ResponseWriter, r *http.Request)
 	DeleteServiceMonitors(w http.ResponseWriter, r *http.Request)
 	UpdateServiceMonitors(w http.ResponseWriter, r *http.Request)
+	CanaryRelease(w http.ResponseWriter, r *http.Request)
+	PromoteCanaryRelease(w http.ResponseWriter, r *http.Request)
+	PauseCanaryRelease(w http.ResponseWriter, r *http.Request)
+	AbortCanaryRelease(w http.ResponseWriter, r *http.Request)
+	ReleaseAnalysis(w http.ResponseWriter, r *http.Request)
+	ReleaseHealthRecords(w http.ResponseWriter, r *http.Request)
+	IdlePolicy(w http.ResponseWriter, r *http.Request)
 	UploadPackage(w http.ResponseWriter, r *http.Request)
 	K8sAttributes(w http.ResponseWriter, r *http.Request)
 }
@@ -132,6 +143,7 @@ type TenantInterfaceWithV1 interface {
 //LogInterface log interface
 type LogInterface interface {
 	HistoryLogs(w http.ResponseWriter, r *http.Request)
+	SearchLogs(w http.ResponseWriter, r *http.Request)
 	LogList(w http.ResponseWriter, r *http.Request)
 	LogFile(w http.ResponseWriter, r *http.Request)
 	LogSocket(w http.ResponseWriter, r *http.Request)
@@ -205,6 +217,7 @@ type ApplicationInterface interface {
 	ListAppStatuses(w http.ResponseWriter, r *http.Request)
 	CheckGovernanceMode(w http.ResponseWriter, r *http.Request)
 	ChangeVolumes(w http.ResponseWriter, r *http.Request)
+	UpdateTracing(w http.ResponseWriter, r *http.Request)
 }
 
 //Gatewayer gateway api interface
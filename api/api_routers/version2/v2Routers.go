@@ -53,6 +53,8 @@ func (v2 *V2) Routes() chi.Router {
 	// deprecated, use /events/<event_id>/log
 	r.Get("/event-log", controller.GetManager().LogByAction)
 	r.Mount("/events", v2.eventsRouter())
+	r.Get("/audit", controller.ListAuditLogs)
+	r.Get("/audit/export", controller.ExportAuditLogs)
 	r.Get("/gateway/ips", controller.GetGatewayIPs)
 	r.Get("/gateway/ports", controller.GetManager().GetAvailablePort)
 	r.Get("/volume-options", controller.VolumeOptions)
@@ -190,6 +192,12 @@ func (v2 *V2) tenantNameRouter() chi.Router {
 	//团队资源限制
 	r.Post("/limit_memory", controller.GetManager().LimitTenantMemory)
 	r.Get("/limit_memory", controller.GetManager().TenantResourcesStatus)
+	//镜像漏洞扫描策略
+	r.Get("/image-scan-policy", controller.GetManager().ImageScanPolicy)
+	r.Put("/image-scan-policy", controller.GetManager().ImageScanPolicy)
+	//组件日志保留策略
+	r.Get("/log-retention-policy", controller.GetManager().LogRetentionPolicy)
+	r.Put("/log-retention-policy", controller.GetManager().LogRetentionPolicy)
 
 	// Gateway
 	r.Post("/http-rule", controller.GetManager().HTTPRule)
@@ -254,6 +262,7 @@ func (v2 *V2) serviceRouter() chi.Router {
 	r.Post("/share", middleware.WrapEL(controller.GetManager().Share, dbmodel.TargetTypeService, "share-service", dbmodel.SYNEVENTTYPE))
 	r.Get("/share/{share_id}", controller.GetManager().ShareResult)
 	r.Get("/logs", controller.GetManager().HistoryLogs)
+	r.Get("/logs/search", controller.GetManager().SearchLogs)
 	r.Get("/log-file", controller.GetManager().LogList)
 	r.Get("/log-instance", controller.GetManager().LogSocket)
 	r.Post("/event-log", controller.GetManager().LogByAction)
@@ -337,12 +346,32 @@ func (v2 *V2) serviceRouter() chi.Router {
 	r.Post("/xparules", middleware.WrapEL(controller.GetManager().AutoscalerRules, dbmodel.TargetTypeService, "add-app-autoscaler-rule", dbmodel.SYNEVENTTYPE))
 	r.Put("/xparules", middleware.WrapEL(controller.GetManager().AutoscalerRules, dbmodel.TargetTypeService, "update-app-autoscaler-rule", dbmodel.SYNEVENTTYPE))
 	r.Get("/xparecords", controller.GetManager().ScalingRecords)
+	r.Get("/xpaschedules", controller.GetManager().ScalingSchedules)
+	r.Post("/xpaschedules", middleware.WrapEL(controller.GetManager().ScalingSchedules, dbmodel.TargetTypeService, "add-app-scaling-schedule", dbmodel.SYNEVENTTYPE))
+	r.Put("/xpaschedules/{schedule_id}", middleware.WrapEL(controller.GetManager().ScalingSchedule, dbmodel.TargetTypeService, "update-app-scaling-schedule", dbmodel.SYNEVENTTYPE))
+	r.Delete("/xpaschedules/{schedule_id}", middleware.WrapEL(controller.GetManager().ScalingSchedule, dbmodel.TargetTypeService, "delete-app-scaling-schedule", dbmodel.SYNEVENTTYPE))
 
 	//service monitor
 	r.Post("/service-monitors", middleware.WrapEL(controller.GetManager().AddServiceMonitors, dbmodel.TargetTypeService, "add-app-service-monitor", dbmodel.SYNEVENTTYPE))
 	r.Put("/service-monitors/{name}", middleware.WrapEL(controller.GetManager().UpdateServiceMonitors, dbmodel.TargetTypeService, "update-app-service-monitor", dbmodel.SYNEVENTTYPE))
 	r.Delete("/service-monitors/{name}", middleware.WrapEL(controller.GetManager().DeleteServiceMonitors, dbmodel.TargetTypeService, "delete-app-service-monitor", dbmodel.SYNEVENTTYPE))
 
+	// canary release
+	r.Get("/canary", controller.GetManager().CanaryRelease)
+	r.Put("/canary", middleware.WrapEL(controller.GetManager().CanaryRelease, dbmodel.TargetTypeService, "update-service-canary-strategy", dbmodel.SYNEVENTTYPE))
+	r.Post("/canary/promote", middleware.WrapEL(controller.GetManager().PromoteCanaryRelease, dbmodel.TargetTypeService, "promote-service-canary", dbmodel.ASYNEVENTTYPE))
+	r.Post("/canary/pause", middleware.WrapEL(controller.GetManager().PauseCanaryRelease, dbmodel.TargetTypeService, "pause-service-canary", dbmodel.SYNEVENTTYPE))
+	r.Post("/canary/abort", middleware.WrapEL(controller.GetManager().AbortCanaryRelease, dbmodel.TargetTypeService, "abort-service-canary", dbmodel.ASYNEVENTTYPE))
+
+	// post-deploy analysis
+	r.Get("/release-analysis", controller.GetManager().ReleaseAnalysis)
+	r.Put("/release-analysis", middleware.WrapEL(controller.GetManager().ReleaseAnalysis, dbmodel.TargetTypeService, "update-service-release-analysis", dbmodel.SYNEVENTTYPE))
+	r.Get("/release-health-records", controller.GetManager().ReleaseHealthRecords)
+
+	// scale to zero when idle
+	r.Get("/idle-policy", controller.GetManager().IdlePolicy)
+	r.Put("/idle-policy", middleware.WrapEL(controller.GetManager().IdlePolicy, dbmodel.TargetTypeService, "update-service-idle-policy", dbmodel.SYNEVENTTYPE))
+
 	r.Get("/log", controller.GetManager().Log)
 
 	return r
@@ -358,6 +387,8 @@ func (v2 *V2) applicationRouter() chi.Router {
 	r.Put("/", controller.GetManager().UpdateApp)
 	r.Delete("/", controller.GetManager().DeleteApp)
 	r.Put("/volumes", controller.GetManager().ChangeVolumes)
+	// tracing of the build-in service mesh
+	r.Put("/tracing", controller.GetManager().UpdateTracing)
 	// Get services under application
 	r.Get("/services", controller.GetManager().ListServices)
 	// bind components
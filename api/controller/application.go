@@ -312,3 +312,18 @@ func (a *ApplicationController) ChangeVolumes(w http.ResponseWriter, r *http.Req
 	}
 	httputil.ReturnSuccess(r, w, nil)
 }
+
+// UpdateTracing updates the tracing of the build-in service mesh of the application.
+func (a *ApplicationController) UpdateTracing(w http.ResponseWriter, r *http.Request) {
+	var req model.UpdateAppTracingReq
+	if !httputil.ValidatorRequestStructAndErrorResponse(r, w, &req, nil) {
+		return
+	}
+	app := r.Context().Value(ctxutil.ContextKey("application")).(*dbmodel.Application)
+	app, err := handler.GetApplicationHandler().UpdateTracing(app, &req)
+	if err != nil {
+		httputil.ReturnBcodeError(r, w, err)
+		return
+	}
+	httputil.ReturnSuccess(r, w, app)
+}
@@ -0,0 +1,100 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/goodrain/rainbond/api/handler"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	httputil "github.com/goodrain/rainbond/util/http"
+	"github.com/sirupsen/logrus"
+)
+
+// maxAuditLogPageSize the most audit logs listed in a page
+const maxAuditLogPageSize = 100
+
+// ListAuditLogs lists the audit logs of the mutating requests
+func ListAuditLogs(w http.ResponseWriter, r *http.Request) {
+	query, err := auditLogQuery(r)
+	if err != nil {
+		httputil.ReturnError(r, w, 400, err.Error())
+		return
+	}
+	var page, size int
+	if page, err = strconv.Atoi(r.FormValue("page")); err != nil || page <= 0 {
+		page = 1
+	}
+	if size, err = strconv.Atoi(r.FormValue("size")); err != nil || size <= 0 {
+		size = 10
+	}
+	if size > maxAuditLogPageSize {
+		size = maxAuditLogPageSize
+	}
+	logs, total, err := handler.GetAuditHandler().ListAuditLogs(query, page, size)
+	if err != nil {
+		logrus.Errorf("list audit logs: %v", err)
+		httputil.ReturnError(r, w, 500, "list audit logs failure")
+		return
+	}
+	httputil.ReturnList(r, w, total, page, logs)
+}
+
+// ExportAuditLogs exports the audit logs as json lines
+func ExportAuditLogs(w http.ResponseWriter, r *http.Request) {
+	query, err := auditLogQuery(r)
+	if err != nil {
+		httputil.ReturnError(r, w, 400, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", "attachment; filename=audit-logs.jsonl")
+	if err := handler.GetAuditHandler().ExportAuditLogs(query, w); err != nil {
+		// the response has been started, so only log the error
+		logrus.Errorf("export audit logs: %v", err)
+	}
+}
+
+func auditLogQuery(r *http.Request) (*dbmodel.AuditLogQuery, error) {
+	query := &dbmodel.AuditLogQuery{
+		Actor:      r.FormValue("actor"),
+		Operator:   r.FormValue("operator"),
+		TenantID:   r.FormValue("tenant_id"),
+		TenantName: r.FormValue("tenant_name"),
+		Resource:   r.FormValue("resource"),
+		ResourceID: r.FormValue("resource_id"),
+		Action:     r.FormValue("action"),
+		Result:     r.FormValue("result"),
+	}
+	var err error
+	if start := r.FormValue("start_time"); start != "" {
+		if query.StartTime, err = time.Parse(time.RFC3339, start); err != nil {
+			return nil, fmt.Errorf("start_time should be in RFC3339 format, such as 2006-01-02T15:04:05+08:00")
+		}
+	}
+	if end := r.FormValue("end_time"); end != "" {
+		if query.EndTime, err = time.Parse(time.RFC3339, end); err != nil {
+			return nil, fmt.Errorf("end_time should be in RFC3339 format, such as 2006-01-02T15:04:05+08:00")
+		}
+	}
+	return query, nil
+}
@@ -22,11 +22,13 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/go-chi/chi"
 	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
 
 	"github.com/goodrain/rainbond/api/handler"
 	"github.com/goodrain/rainbond/api/model"
+	"github.com/goodrain/rainbond/api/util/bcode"
 	ctxutil "github.com/goodrain/rainbond/api/util/ctx"
 	"github.com/goodrain/rainbond/db/errors"
 	httputil "github.com/goodrain/rainbond/util/http"
@@ -56,6 +58,10 @@ func (t *TenantStruct) addAutoscalerRule(w http.ResponseWriter, r *http.Request)
 			httputil.ReturnError(r, w, 400, err.Error())
 			return
 		}
+		if _, ok := err.(bcode.Coder); ok {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
 		logrus.Errorf("add autoscaler rule: %v", err)
 		httputil.ReturnError(r, w, 500, err.Error())
 		return
@@ -80,6 +86,10 @@ func (t *TenantStruct) updAutoscalerRule(w http.ResponseWriter, r *http.Request)
 			httputil.ReturnError(r, w, 404, err.Error())
 			return
 		}
+		if _, ok := err.(bcode.Coder); ok {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
 		logrus.Errorf("update autoscaler rule: %v", err)
 		httputil.ReturnError(r, w, 500, err.Error())
 		return
@@ -128,3 +138,57 @@ func (t *TenantStruct) listScalingRecords(w http.ResponseWriter, r *http.Request
 		"data":  records,
 	})
 }
+
+// ScalingSchedules list or add the scaling schedules of the component
+func (t *TenantStruct) ScalingSchedules(w http.ResponseWriter, r *http.Request) {
+	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
+	switch r.Method {
+	case "GET":
+		schedules, err := handler.GetServiceManager().ListScalingSchedules(serviceID)
+		if err != nil {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
+		httputil.ReturnSuccess(r, w, schedules)
+	case "POST":
+		var req model.ScalingScheduleReq
+		if !httputil.ValidatorRequestStructAndErrorResponse(r, w, &req, nil) {
+			return
+		}
+		schedule, err := handler.GetServiceManager().AddScalingSchedule(serviceID, &req)
+		if err != nil {
+			if err == errors.ErrRecordAlreadyExist {
+				httputil.ReturnError(r, w, 400, err.Error())
+				return
+			}
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
+		httputil.ReturnSuccess(r, w, schedule)
+	}
+}
+
+// ScalingSchedule update or delete the scaling schedule of the component
+func (t *TenantStruct) ScalingSchedule(w http.ResponseWriter, r *http.Request) {
+	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
+	scheduleID := chi.URLParam(r, "schedule_id")
+	switch r.Method {
+	case "PUT":
+		var req model.ScalingScheduleReq
+		if !httputil.ValidatorRequestStructAndErrorResponse(r, w, &req, nil) {
+			return
+		}
+		schedule, err := handler.GetServiceManager().UpdateScalingSchedule(serviceID, scheduleID, &req)
+		if err != nil {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
+		httputil.ReturnSuccess(r, w, schedule)
+	case "DELETE":
+		if err := handler.GetServiceManager().DeleteScalingSchedule(serviceID, scheduleID); err != nil {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
+		httputil.ReturnSuccess(r, w, nil)
+	}
+}
@@ -0,0 +1,91 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"net/http"
+
+	"github.com/goodrain/rainbond/api/handler"
+	api_model "github.com/goodrain/rainbond/api/model"
+	ctxutil "github.com/goodrain/rainbond/api/util/ctx"
+	httputil "github.com/goodrain/rainbond/util/http"
+)
+
+// CanaryRelease get or update the canary strategy of the component
+func (t *TenantStruct) CanaryRelease(w http.ResponseWriter, r *http.Request) {
+	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
+	switch r.Method {
+	case "GET":
+		release, err := handler.GetServiceManager().GetCanaryRelease(serviceID)
+		if err != nil {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
+		httputil.ReturnSuccess(r, w, release)
+	case "PUT":
+		var req api_model.CanaryStrategyReq
+		if !httputil.ValidatorRequestStructAndErrorResponse(r, w, &req, nil) {
+			return
+		}
+		release, err := handler.GetServiceManager().UpdateCanaryStrategy(serviceID, &req)
+		if err != nil {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
+		httputil.ReturnSuccess(r, w, release)
+	}
+}
+
+// PromoteCanaryRelease move the canary release to the next step, or promote it fully
+func (t *TenantStruct) PromoteCanaryRelease(w http.ResponseWriter, r *http.Request) {
+	var req api_model.PromoteCanaryReq
+	if !httputil.ValidatorRequestStructAndErrorResponse(r, w, &req, nil) {
+		return
+	}
+	tenantID := r.Context().Value(ctxutil.ContextKey("tenant_id")).(string)
+	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
+	eventID := r.Context().Value(ctxutil.ContextKey("event_id")).(string)
+	if err := handler.GetServiceManager().PromoteCanaryRelease(tenantID, serviceID, eventID, req.Full); err != nil {
+		httputil.ReturnBcodeError(r, w, err)
+		return
+	}
+	httputil.ReturnSuccess(r, w, nil)
+}
+
+// PauseCanaryRelease keep the canary release on the current step
+func (t *TenantStruct) PauseCanaryRelease(w http.ResponseWriter, r *http.Request) {
+	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
+	if err := handler.GetServiceManager().PauseCanaryRelease(serviceID); err != nil {
+		httputil.ReturnBcodeError(r, w, err)
+		return
+	}
+	httputil.ReturnSuccess(r, w, nil)
+}
+
+// AbortCanaryRelease remove the canary version of the component
+func (t *TenantStruct) AbortCanaryRelease(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.Context().Value(ctxutil.ContextKey("tenant_id")).(string)
+	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
+	eventID := r.Context().Value(ctxutil.ContextKey("event_id")).(string)
+	if err := handler.GetServiceManager().AbortCanaryRelease(tenantID, serviceID, eventID); err != nil {
+		httputil.ReturnBcodeError(r, w, err)
+		return
+	}
+	httputil.ReturnSuccess(r, w, nil)
+}
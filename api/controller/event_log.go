@@ -35,6 +35,7 @@ import (
 	api_model "github.com/goodrain/rainbond/api/model"
 	"github.com/goodrain/rainbond/api/proxy"
 	ctxutil "github.com/goodrain/rainbond/api/util/ctx"
+	dbmodel "github.com/goodrain/rainbond/db/model"
 )
 
 //EventLogStruct eventlog struct
@@ -58,6 +59,39 @@ func (e *EventLogStruct) HistoryLogs(w http.ResponseWriter, r *http.Request) {
 	e.EventlogServerProxy.Proxy(w, r)
 }
 
+//SearchLogs search the component logs in the time range by the keyword, the regex and the containers.
+//The pod name is resolved to the ids of its containers.
+//proxy
+func (e *EventLogStruct) SearchLogs(w http.ResponseWriter, r *http.Request) {
+	tenant := r.Context().Value(ctxutil.ContextKey("tenant")).(*dbmodel.Tenants)
+	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
+	serviceAlias := r.Context().Value(ctxutil.ContextKey("service_alias")).(string)
+	query := r.URL.Query()
+	if podName := query.Get("pod_name"); podName != "" {
+		containerIDs, err := handler.GetServiceManager().GetPodContainerIDs(tenant.Namespace, serviceID, podName)
+		if err != nil {
+			httputil.ReturnBcodeError(r, w, err)
+			return
+		}
+		if len(containerIDs) == 0 {
+			httputil.ReturnSuccess(r, w, map[string]interface{}{"list": []interface{}{}, "total": 0})
+			return
+		}
+		query.Del("pod_name")
+		for _, id := range containerIDs {
+			query.Add("container_id", id)
+		}
+		r.URL.RawQuery = query.Encode()
+	}
+	name, _ := handler.GetEventHandler().GetLogInstance(serviceID)
+	if name != "" {
+		r = r.WithContext(context.WithValue(r.Context(), proxy.ContextKey("host_id"), name))
+	}
+	r.URL.Path = strings.Replace(r.URL.Path, serviceAlias, serviceID, 1)
+	r.URL.Path = strings.Replace(r.URL.Path, "/v2/", "/", 1)
+	e.EventlogServerProxy.Proxy(w, r)
+}
+
 //HistoryLogs get rbd history logs
 //proxy
 func (e *EventLogStruct) HistoryRbdLogs(w http.ResponseWriter, r *http.Request) {
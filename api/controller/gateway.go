@@ -29,6 +29,12 @@ import (
 	api_model "github.com/goodrain/rainbond/api/model"
 	ctxutil "github.com/goodrain/rainbond/api/util/ctx"
 	"github.com/goodrain/rainbond/cmd/api/option"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"github.com/goodrain/rainbond/gateway/annotations/authtls"
+	"github.com/goodrain/rainbond/gateway/annotations/ipaccess"
+	"github.com/goodrain/rainbond/gateway/annotations/proxyssl"
+	"github.com/goodrain/rainbond/gateway/annotations/ratelimit"
 	"github.com/goodrain/rainbond/mq/client"
 	httputil "github.com/goodrain/rainbond/util/http"
 	"github.com/jinzhu/gorm"
@@ -68,6 +74,125 @@ func validateDomain(domain string) []string {
 	return errs
 }
 
+// validateRuleExtensions validates the rate limit, ip access control, authentication and tls extensions of the http rule
+func validateRuleExtensions(certificateID string, ruleExtensions []*api_model.RuleExtensionStruct) []string {
+	var errs []string
+	for _, re := range ruleExtensions {
+		switch re.Key {
+		case string(dbmodel.RateLimitRPS), string(dbmodel.RateLimitBurst):
+			if n, err := strconv.Atoi(re.Value); err != nil || n < 0 {
+				errs = append(errs, fmt.Sprintf("%s must be a non-negative integer", re.Key))
+			}
+		case string(dbmodel.RateLimitKey):
+			if err := ratelimit.ValidKey(re.Value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case string(dbmodel.AllowCIDRs), string(dbmodel.DenyCIDRs):
+			if _, err := ipaccess.ParseCIDRs(re.Value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", re.Key, err))
+			}
+		case string(dbmodel.AutoTLS), string(dbmodel.UpstreamTLSVerify):
+			if re.Value != "true" && re.Value != "false" {
+				errs = append(errs, fmt.Sprintf("%s must be true or false", re.Key))
+			}
+		case string(dbmodel.AutoTLSChallenge):
+			if re.Value != "http-01" && re.Value != "dns-01" {
+				errs = append(errs, fmt.Sprintf("%s must be http-01 or dns-01", re.Key))
+			}
+		case string(dbmodel.AuthType):
+			if re.Value != auth.TypeBasic && re.Value != auth.TypeJWT && re.Value != auth.TypeForward {
+				errs = append(errs, fmt.Sprintf("%s must be one of basic, jwt and forward", re.Key))
+			}
+		case string(dbmodel.AuthBasicUsers):
+			if _, err := auth.ParseUsers(re.Value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", re.Key, err))
+			}
+		case string(dbmodel.AuthURL), string(dbmodel.AuthJWKSURL):
+			if err := auth.ValidURL(re.Value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", re.Key, err))
+			}
+		case string(dbmodel.AuthJWTClaimHeaders):
+			if _, err := auth.ParseClaimHeaders(re.Value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", re.Key, err))
+			}
+		case string(dbmodel.AuthResponseHeaders):
+			if _, err := auth.ParseHeaders(re.Value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", re.Key, err))
+			}
+		case string(dbmodel.ClientCA), string(dbmodel.UpstreamTLSCA):
+			if err := authtls.ValidCABundle(re.Value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", re.Key, err))
+			}
+		case string(dbmodel.ClientVerify):
+			if err := authtls.ValidVerifyClient(re.Value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case string(dbmodel.ClientVerifyDepth):
+			if _, err := authtls.ParseVerifyDepth(re.Value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case string(dbmodel.UpstreamProtocol):
+			if err := proxyssl.ValidProtocol(re.Value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case string(dbmodel.UpstreamTLSServerName):
+			if err := proxyssl.ValidServerName(re.Value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+	errs = append(errs, validateAuthExtensions(ruleExtensions)...)
+	return append(errs, validateTLSExtensions(certificateID, ruleExtensions)...)
+}
+
+// validateAuthExtensions checks the extensions required by the authentication type
+func validateAuthExtensions(ruleExtensions []*api_model.RuleExtensionStruct) []string {
+	values := make(map[string]string)
+	for _, re := range ruleExtensions {
+		values[re.Key] = re.Value
+	}
+	switch values[string(dbmodel.AuthType)] {
+	case auth.TypeBasic:
+		if values[string(dbmodel.AuthBasicUsers)] == "" {
+			return []string{fmt.Sprintf("%s is required by the basic authentication", dbmodel.AuthBasicUsers)}
+		}
+	case auth.TypeJWT:
+		if values[string(dbmodel.AuthJWKSURL)] == "" && values[string(dbmodel.AuthJWTKeys)] == "" {
+			return []string{fmt.Sprintf("%s or %s is required by the jwt authentication", dbmodel.AuthJWKSURL, dbmodel.AuthJWTKeys)}
+		}
+	case auth.TypeForward:
+		if values[string(dbmodel.AuthURL)] == "" {
+			return []string{fmt.Sprintf("%s is required by the forward authentication", dbmodel.AuthURL)}
+		}
+	}
+	return nil
+}
+
+// validateTLSExtensions checks the extensions required by the client certificate verification and the upstream tls
+func validateTLSExtensions(certificateID string, ruleExtensions []*api_model.RuleExtensionStruct) []string {
+	values := make(map[string]string)
+	for _, re := range ruleExtensions {
+		values[re.Key] = re.Value
+	}
+	var errs []string
+	if values[string(dbmodel.ClientCA)] != "" {
+		// the client certificates are requested by the tls handshake
+		if strings.TrimSpace(certificateID) == "" && values[string(dbmodel.AutoTLS)] != "true" {
+			errs = append(errs, fmt.Sprintf("%s requires a certificate", dbmodel.ClientCA))
+		}
+	} else if values[string(dbmodel.ClientVerify)] != "" || values[string(dbmodel.ClientVerifyDepth)] != "" {
+		errs = append(errs, fmt.Sprintf("%s is required by the client certificate verification", dbmodel.ClientCA))
+	}
+	if values[string(dbmodel.UpstreamProtocol)] != proxyssl.ProtocolHTTPS {
+		for _, key := range []dbmodel.RuleExtensionKey{dbmodel.UpstreamTLSVerify, dbmodel.UpstreamTLSCA, dbmodel.UpstreamTLSServerName} {
+			if values[string(key)] != "" {
+				errs = append(errs, fmt.Sprintf("%s requires %s to be https", key, dbmodel.UpstreamProtocol))
+			}
+		}
+	}
+	return errs
+}
+
 func (g *GatewayStruct) addHTTPRule(w http.ResponseWriter, r *http.Request) {
 	var req api_model.AddHTTPRuleStruct
 	ok := httputil.ValidatorRequestStructAndErrorResponse(r, w, &req, nil)
@@ -93,6 +218,9 @@ func (g *GatewayStruct) addHTTPRule(w http.ResponseWriter, r *http.Request) {
 		logrus.Debugf("Invalid domain: %s", strings.Join(errs, ";"))
 		values["domain"] = []string{"The domain field is invalid"}
 	}
+	if errs := validateRuleExtensions(req.CertificateID, req.RuleExtensions); len(errs) > 0 {
+		values["rule_extensions"] = errs
+	}
 	if len(values) != 0 {
 		httputil.ReturnValidationError(r, w, values)
 		return
@@ -141,6 +269,9 @@ func (g *GatewayStruct) updateHTTPRule(w http.ResponseWriter, r *http.Request) {
 		logrus.Debugf("Invalid domain: %s", strings.Join(errs, ";"))
 		values["domain"] = []string{"The domain field is invalid"}
 	}
+	if errs := validateRuleExtensions(req.CertificateID, req.RuleExtensions); len(errs) > 0 {
+		values["rule_extensions"] = errs
+	}
 	if len(values) != 0 {
 		httputil.ReturnValidationError(r, w, values)
 		return
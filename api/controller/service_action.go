@@ -19,6 +19,7 @@
 package controller
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -372,10 +373,10 @@ func (t *TenantStruct) BuildList(w http.ResponseWriter, r *http.Request) {
 
 //BuildVersionIsExist -
 func (t *TenantStruct) BuildVersionIsExist(w http.ResponseWriter, r *http.Request) {
-	statusMap := make(map[string]bool)
+	statusMap := make(map[string]interface{})
 	serviceID := r.Context().Value(ctxutil.ContextKey("service_id")).(string)
 	buildVersion := chi.URLParam(r, "build_version")
-	_, err := db.GetManager().VersionInfoDao().GetVersionByDeployVersion(buildVersion, serviceID)
+	version, err := db.GetManager().VersionInfoDao().GetVersionByDeployVersion(buildVersion, serviceID)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		httputil.ReturnError(r, w, 500, fmt.Sprintf("get build version status erro, %v", err))
 		return
@@ -384,6 +385,11 @@ func (t *TenantStruct) BuildVersionIsExist(w http.ResponseWriter, r *http.Reques
 		statusMap["status"] = false
 	} else {
 		statusMap["status"] = true
+		// the vulnerability scanning result of the image
+		statusMap["scan_status"] = version.ScanStatus
+		if version.ScanReport != "" {
+			statusMap["scan_report"] = json.RawMessage(version.ScanReport)
+		}
 	}
 	httputil.ReturnSuccess(r, w, statusMap)
 
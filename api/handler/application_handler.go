@@ -67,6 +67,7 @@ type ApplicationHandler interface {
 	ListAppStatuses(ctx context.Context, appIDs []string) ([]*model.AppStatus, error)
 	CheckGovernanceMode(ctx context.Context, governanceMode string) error
 	ChangeVolumes(app *dbmodel.Application) error
+	UpdateTracing(app *dbmodel.Application, req *model.UpdateAppTracingReq) (*dbmodel.Application, error)
 }
 
 // NewApplicationHandler creates a new Tenant Application Handler.
@@ -843,3 +844,32 @@ func changeVolumeDirectoryNames(parentDir, newPath string) error {
 	}
 	return nil
 }
+
+// UpdateTracing updates the tracing of the build-in service mesh. It takes effect after the components restart.
+func (a *ApplicationAction) UpdateTracing(app *dbmodel.Application, req *model.UpdateAppTracingReq) (*dbmodel.Application, error) {
+	switch req.Driver {
+	case "":
+		app.TracingDriver, app.TracingCollector, app.TracingSampling = "", "", 0
+		return app, db.GetManager().ApplicationDao().UpdateModel(app)
+	case dbmodel.TracingDriverZipkin, dbmodel.TracingDriverOTLP:
+	default:
+		return nil, bcode.ErrInvalidTracingDriver
+	}
+	if _, err := util.ParseTracingCollector(req.Collector); err != nil {
+		logrus.Warningf("parse tracing collector %s: %v", req.Collector, err)
+		return nil, bcode.ErrInvalidTracingCollector
+	}
+	if req.Sampling < 0 || req.Sampling > 100 {
+		return nil, bcode.ErrInvalidTracingSampling
+	}
+	if req.Sampling == 0 {
+		req.Sampling = 100
+	}
+	app.TracingDriver = req.Driver
+	app.TracingCollector = req.Collector
+	app.TracingSampling = req.Sampling
+	if err := db.GetManager().ApplicationDao().UpdateModel(app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
@@ -0,0 +1,60 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+)
+
+// exportBatchSize the number of the audit logs read from the database at a time when exporting
+const exportBatchSize = 500
+
+// AuditHandler queries and exports the audit logs
+type AuditHandler struct {
+}
+
+// NewAuditHandler -
+func NewAuditHandler() *AuditHandler {
+	return &AuditHandler{}
+}
+
+// ListAuditLogs returns a page of the audit logs matching the query, the latest first
+func (a *AuditHandler) ListAuditLogs(query *dbmodel.AuditLogQuery, page, size int) ([]*dbmodel.AuditLog, int, error) {
+	return db.GetManager().AuditLogDao().ListAuditLogs(query, (page-1)*size, size)
+}
+
+// ExportAuditLogs writes the audit logs matching the query to w as json lines, the oldest first
+func (a *AuditHandler) ExportAuditLogs(query *dbmodel.AuditLogQuery, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	return db.GetManager().AuditLogDao().IterateAuditLogs(query, exportBatchSize, func(logs []*dbmodel.AuditLog) error {
+		for _, log := range logs {
+			if err := encoder.Encode(log); err != nil {
+				return err
+			}
+		}
+		if flusher, ok := w.(interface{ Flush() }); ok {
+			flusher.Flush()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,144 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	api_model "github.com/goodrain/rainbond/api/model"
+	"github.com/goodrain/rainbond/api/util/bcode"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	gclient "github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// GetCanaryRelease get the canary strategy and the current canary release of the component
+func (s *ServiceAction) GetCanaryRelease(serviceID string) (*dbmodel.TenantServiceCanaryRelease, error) {
+	release, err := db.GetManager().TenantServiceCanaryReleaseDao().GetByServiceID(serviceID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &dbmodel.TenantServiceCanaryRelease{
+				ServiceID: serviceID,
+				Steps:     dbmodel.DefaultCanarySteps,
+				Replicas:  1,
+			}, nil
+		}
+		return nil, err
+	}
+	return release, nil
+}
+
+// UpdateCanaryStrategy update the canary strategy of the component
+func (s *ServiceAction) UpdateCanaryStrategy(serviceID string, req *api_model.CanaryStrategyReq) (*dbmodel.TenantServiceCanaryRelease, error) {
+	release, err := db.GetManager().TenantServiceCanaryReleaseDao().GetByServiceID(serviceID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if release != nil && release.IsActive() {
+		return nil, bcode.ErrSyncOperation
+	}
+	strategy := &dbmodel.TenantServiceCanaryRelease{
+		ServiceID:    serviceID,
+		Steps:        req.Steps,
+		Replicas:     req.Replicas,
+		StepInterval: req.StepInterval,
+	}
+	if strategy.Steps == "" {
+		strategy.Steps = dbmodel.DefaultCanarySteps
+	}
+	if strategy.Replicas <= 0 {
+		strategy.Replicas = 1
+	}
+	if _, err := strategy.StepWeights(); err != nil {
+		return nil, bcode.ErrInvalidCanarySteps
+	}
+	if release == nil {
+		return strategy, db.GetManager().TenantServiceCanaryReleaseDao().AddModel(strategy)
+	}
+	release.Steps = strategy.Steps
+	release.Replicas = strategy.Replicas
+	release.StepInterval = strategy.StepInterval
+	return release, db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release)
+}
+
+// PauseCanaryRelease keep the running canary release on the current step
+func (s *ServiceAction) PauseCanaryRelease(serviceID string) error {
+	release, err := s.getActiveCanaryRelease(serviceID)
+	if err != nil {
+		return err
+	}
+	release.Status = dbmodel.CanaryReleaseStatusPaused.String()
+	release.NextStepTime = nil
+	return db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release)
+}
+
+// PromoteCanaryRelease move the running canary release to the next step, or promote it fully
+func (s *ServiceAction) PromoteCanaryRelease(tenantID, serviceID, eventID string, full bool) error {
+	if _, err := s.getActiveCanaryRelease(serviceID); err != nil {
+		return err
+	}
+	return s.sendCanaryReleaseTask(&model.CanaryReleaseTaskBody{
+		TenantID:  tenantID,
+		ServiceID: serviceID,
+		EventID:   eventID,
+		Action:    model.CanaryReleasePromote,
+		Full:      full,
+	})
+}
+
+// AbortCanaryRelease remove the canary version and send all traffic back to the stable version
+func (s *ServiceAction) AbortCanaryRelease(tenantID, serviceID, eventID string) error {
+	if _, err := s.getActiveCanaryRelease(serviceID); err != nil {
+		return err
+	}
+	return s.sendCanaryReleaseTask(&model.CanaryReleaseTaskBody{
+		TenantID:  tenantID,
+		ServiceID: serviceID,
+		EventID:   eventID,
+		Action:    model.CanaryReleaseAbort,
+	})
+}
+
+func (s *ServiceAction) getActiveCanaryRelease(serviceID string) (*dbmodel.TenantServiceCanaryRelease, error) {
+	release, err := db.GetManager().TenantServiceCanaryReleaseDao().GetByServiceID(serviceID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, bcode.ErrCanaryReleaseNotFound
+		}
+		return nil, err
+	}
+	if !release.IsActive() {
+		return nil, bcode.ErrCanaryReleaseNotActive
+	}
+	return release, nil
+}
+
+func (s *ServiceAction) sendCanaryReleaseTask(body *model.CanaryReleaseTaskBody) error {
+	if err := s.MQClient.SendBuilderTopic(gclient.TaskStruct{
+		TaskType: "canary_release",
+		TaskBody: body,
+		Topic:    gclient.WorkerTopic,
+	}); err != nil {
+		logrus.Errorf("send 'canary_release' task: %v", err)
+		return err
+	}
+	logrus.Infof("service id: %s; successfully send 'canary_release' %s task.", body.ServiceID, body.Action)
+	return nil
+}
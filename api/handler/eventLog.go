@@ -27,6 +27,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/goodrain/rainbond/api/model"
@@ -83,6 +84,10 @@ func (l *LogAction) GetLogList(serviceAlias string) ([]*model.HistoryLogFile, er
 
 	var logFiles []*model.HistoryLogFile
 	for _, file := range fileList {
+		// skip the search index and the files being compressed
+		if file.IsDir() || strings.HasSuffix(file.Name(), ".tmp") {
+			continue
+		}
 		logfile := &model.HistoryLogFile{
 			Filename:     file.Name(),
 			RelativePath: path.Join("logs", serviceAlias, file.Name()),
@@ -32,11 +32,13 @@ import (
 	"github.com/goodrain/rainbond/api/util/bcode"
 	"github.com/goodrain/rainbond/db"
 	"github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
 	"github.com/goodrain/rainbond/mq/client"
 	"github.com/goodrain/rainbond/util"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // GatewayAction -
@@ -128,11 +130,15 @@ func (g *GatewayAction) CreateHTTPRule(tx *gorm.DB, req *apimodel.AddHTTPRuleStr
 	}
 
 	for _, ruleExtension := range req.RuleExtensions {
+		value, err := ruleExtensionValue(ruleExtension.Key, ruleExtension.Value)
+		if err != nil {
+			return err
+		}
 		re := &model.RuleExtension{
 			UUID:   util.NewUUID(),
 			RuleID: httpRule.UUID,
 			Key:    ruleExtension.Key,
-			Value:  ruleExtension.Value,
+			Value:  value,
 		}
 		if err := db.GetManager().RuleExtensionDaoTransactions(tx).AddModel(re); err != nil {
 			return fmt.Errorf("create rule extensions: %v", err)
@@ -142,6 +148,55 @@ func (g *GatewayAction) CreateHTTPRule(tx *gorm.DB, req *apimodel.AddHTTPRuleStr
 	return nil
 }
 
+// ruleExtensionValue returns the value of the rule extension stored in db,
+// the passwords of the basic auth users are stored as bcrypt hashes.
+func ruleExtensionValue(key, value string) (string, error) {
+	if key != string(model.AuthBasicUsers) {
+		return value, nil
+	}
+	users, err := auth.ParseUsers(value)
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var lines []string
+	for _, name := range names {
+		password := users[name]
+		if !isBcryptHash(password) {
+			hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+			if err != nil {
+				return "", fmt.Errorf("hash the password of %s: %v", name, err)
+			}
+			password = string(hash)
+		}
+		lines = append(lines, name+":"+password)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// autoTLSEnabled returns true if the certificate of the http rule is issued automatically
+func autoTLSEnabled(ruleExtensions []*apimodel.RuleExtensionStruct) bool {
+	for _, re := range ruleExtensions {
+		if re.Key == string(model.AutoTLS) && re.Value == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// isBcryptHash returns true if the password is hashed already
+func isBcryptHash(password string) bool {
+	if !strings.HasPrefix(password, "$2a$") && !strings.HasPrefix(password, "$2b$") && !strings.HasPrefix(password, "$2y$") {
+		return false
+	}
+	_, err := bcrypt.Cost([]byte(password))
+	return err == nil
+}
+
 // UpdateHTTPRule updates http rule
 func (g *GatewayAction) UpdateHTTPRule(req *apimodel.UpdateHTTPRuleStruct) error {
 	tx := db.GetManager().Begin()
@@ -195,7 +250,8 @@ func (g *GatewayAction) UpdateHTTPRule(req *apimodel.UpdateHTTPRuleStruct) error
 			return err
 		}
 		rule.CertificateID = req.CertificateID
-	} else {
+	} else if !autoTLSEnabled(req.RuleExtensions) {
+		// the certificate issued by the acme controller of the worker is kept
 		rule.CertificateID = ""
 	}
 	if len(req.RuleExtensions) > 0 {
@@ -206,11 +262,16 @@ func (g *GatewayAction) UpdateHTTPRule(req *apimodel.UpdateHTTPRuleStruct) error
 		}
 		// add new rule extensions
 		for _, ruleExtension := range req.RuleExtensions {
+			value, err := ruleExtensionValue(ruleExtension.Key, ruleExtension.Value)
+			if err != nil {
+				tx.Rollback()
+				return err
+			}
 			re := &model.RuleExtension{
 				UUID:   util.NewUUID(),
 				RuleID: rule.UUID,
 				Key:    ruleExtension.Key,
-				Value:  ruleExtension.Value,
+				Value:  value,
 			}
 			if err := db.GetManager().RuleExtensionDaoTransactions(tx).AddModel(re); err != nil {
 				tx.Rollback()
@@ -387,6 +448,9 @@ func (g *GatewayAction) AddTCPRule(req *apimodel.AddTCPRuleStruct) error {
 
 // CreateTCPRule Create tcp rules through transactions
 func (g *GatewayAction) CreateTCPRule(tx *gorm.DB, req *apimodel.AddTCPRuleStruct) error {
+	if req.IP == "" {
+		req.IP = "0.0.0.0"
+	}
 	// add tcp rule
 	tcpRule := &model.TCPRule{
 		UUID:          req.TCPRuleID,
@@ -958,11 +1022,15 @@ func (g *GatewayAction) SyncHTTPRules(tx *gorm.DB, components []*apimodel.Compon
 			}
 
 			for _, ext := range httpRule.RuleExtensions {
+				value, err := ruleExtensionValue(ext.Key, ext.Value)
+				if err != nil {
+					return err
+				}
 				ruleExtensions = append(ruleExtensions, &model.RuleExtension{
 					UUID:   util.NewUUID(),
 					RuleID: httpRule.HTTPRuleID,
 					Key:    ext.Key,
-					Value:  ext.Value,
+					Value:  value,
 				})
 			}
 		}
@@ -92,6 +92,7 @@ func InitHandle(conf option.Config,
 	defServiceEventHandler = NewServiceEventHandler()
 	defApplicationHandler = NewApplicationHandler(statusCli, prometheusCli, rainbondClient, kubeClient)
 	defRegistryAuthSecretHandler = CreateRegistryAuthSecretManager(dbmanager, mqClient, etcdcli)
+	defAuditHandler = NewAuditHandler()
 	return nil
 }
 
@@ -246,6 +247,13 @@ func GetServiceEventHandler() *ServiceEventHandler {
 	return defServiceEventHandler
 }
 
+var defAuditHandler *AuditHandler
+
+// GetAuditHandler -
+func GetAuditHandler() *AuditHandler {
+	return defAuditHandler
+}
+
 var defRegistryAuthSecretHandler RegistryAuthSecretHandler
 
 // GetRegistryAuthSecretHandler -
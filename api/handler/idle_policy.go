@@ -0,0 +1,107 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	"time"
+
+	api_model "github.com/goodrain/rainbond/api/model"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	gclient "github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// GetIdlePolicy get the idle policy of the component
+func (s *ServiceAction) GetIdlePolicy(serviceID string) (*dbmodel.TenantServiceIdlePolicy, error) {
+	policy, err := db.GetManager().TenantServiceIdlePolicyDao().GetByServiceID(serviceID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &dbmodel.TenantServiceIdlePolicy{
+				ServiceID:   serviceID,
+				IdleTimeout: 1800,
+				WakeTimeout: 60,
+			}, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// UpdateIdlePolicy create or update the idle policy of the component
+func (s *ServiceAction) UpdateIdlePolicy(serviceID string, req *api_model.IdlePolicyReq) (*dbmodel.TenantServiceIdlePolicy, error) {
+	policy, err := db.GetManager().TenantServiceIdlePolicyDao().GetByServiceID(serviceID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	create := policy == nil
+	if create {
+		policy = &dbmodel.TenantServiceIdlePolicy{ServiceID: serviceID}
+	}
+	if req.Enable && !policy.Enable {
+		// count the idle time from now on
+		policy.ActiveTime = time.Now()
+	}
+	policy.Enable = req.Enable
+	policy.IdleTimeout = req.IdleTimeout
+	policy.WakeTimeout = req.WakeTimeout
+	if policy.IdleTimeout <= 0 {
+		policy.IdleTimeout = 1800
+	}
+	if policy.WakeTimeout <= 0 {
+		policy.WakeTimeout = 60
+	}
+	if create {
+		err = db.GetManager().TenantServiceIdlePolicyDao().AddModel(policy)
+	} else {
+		err = db.GetManager().TenantServiceIdlePolicyDao().UpdateModel(policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !policy.Enable && policy.Idle {
+		// the gateway no longer holds the requests, so restore the replicas right now
+		err := s.MQClient.SendBuilderTopic(gclient.TaskStruct{
+			TaskType: "wake_component",
+			TaskBody: model.WakeComponentTaskBody{
+				ServiceID: serviceID,
+			},
+			Topic: gclient.WorkerTopic,
+		})
+		if err != nil {
+			logrus.Warningf("send wake task of component %s: %v", serviceID, err)
+		}
+	}
+	// update the ingresses of the component to hold the requests or not
+	err = s.MQClient.SendBuilderTopic(gclient.TaskStruct{
+		TaskType: "apply_rule",
+		TaskBody: &ComponentIngressTask{
+			ComponentID: serviceID,
+			Action:      "update-idle-policy",
+		},
+		Topic: gclient.WorkerTopic,
+	})
+	if err != nil {
+		logrus.Warningf("send apply rule task of component %s: %v", serviceID, err)
+	}
+	return policy, nil
+}
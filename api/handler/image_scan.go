@@ -0,0 +1,64 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	api_model "github.com/goodrain/rainbond/api/model"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/jinzhu/gorm"
+)
+
+// GetImageScanPolicy get the image scan policy of the tenant, it is disabled by default
+func (t *TenantAction) GetImageScanPolicy(tenantID string) (*dbmodel.TenantImageScanPolicy, error) {
+	policy, err := db.GetManager().TenantImageScanPolicyDao().GetByTenantID(tenantID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &dbmodel.TenantImageScanPolicy{
+				TenantID: tenantID,
+				Severity: "CRITICAL",
+			}, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// UpdateImageScanPolicy create or update the image scan policy of the tenant
+func (t *TenantAction) UpdateImageScanPolicy(tenantID string, req *api_model.ImageScanPolicyReq) (*dbmodel.TenantImageScanPolicy, error) {
+	policy, err := db.GetManager().TenantImageScanPolicyDao().GetByTenantID(tenantID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	create := policy == nil
+	if create {
+		policy = &dbmodel.TenantImageScanPolicy{TenantID: tenantID}
+	}
+	policy.Enable = req.Enable
+	policy.Severity = req.Severity
+	if create {
+		err = db.GetManager().TenantImageScanPolicyDao().AddModel(policy)
+	} else {
+		err = db.GetManager().TenantImageScanPolicyDao().UpdateModel(policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
@@ -0,0 +1,62 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	api_model "github.com/goodrain/rainbond/api/model"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/jinzhu/gorm"
+)
+
+// GetLogRetentionPolicy get the log retention policy of the tenant, the default retention of eventlog is used by default
+func (t *TenantAction) GetLogRetentionPolicy(tenantID string) (*dbmodel.TenantLogRetentionPolicy, error) {
+	policy, err := db.GetManager().TenantLogRetentionPolicyDao().GetByTenantID(tenantID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &dbmodel.TenantLogRetentionPolicy{TenantID: tenantID}, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// UpdateLogRetentionPolicy create or update the log retention policy of the tenant
+func (t *TenantAction) UpdateLogRetentionPolicy(tenantID string, req *api_model.LogRetentionPolicyReq) (*dbmodel.TenantLogRetentionPolicy, error) {
+	policy, err := db.GetManager().TenantLogRetentionPolicyDao().GetByTenantID(tenantID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	create := policy == nil
+	if create {
+		policy = &dbmodel.TenantLogRetentionPolicy{TenantID: tenantID}
+	}
+	policy.MaxAgeDays = req.MaxAgeDays
+	policy.MaxComponentSize = req.MaxComponentSize
+	policy.MaxTotalSize = req.MaxTotalSize
+	if create {
+		err = db.GetManager().TenantLogRetentionPolicyDao().AddModel(policy)
+	} else {
+		err = db.GetManager().TenantLogRetentionPolicyDao().UpdateModel(policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
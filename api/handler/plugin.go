@@ -424,6 +424,8 @@ func (p *PluginAction) batchBuildPlugins(req *api_model.BatchBuildPlugins, plugi
 			TaskType: taskType,
 			TaskBody: taskBody,
 			Topic:    client.BuilderTopic,
+			Priority: client.PriorityLow,
+			TenantID: buildReq.TenantID,
 		})
 		pluginBuildVersion := buildReq.DbModel(reqPluginRel[buildReq.PluginID])
 		if err != nil {
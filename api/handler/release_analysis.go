@@ -0,0 +1,83 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	api_model "github.com/goodrain/rainbond/api/model"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/jinzhu/gorm"
+)
+
+// GetReleaseAnalysis get the post-deploy analysis of the component
+func (s *ServiceAction) GetReleaseAnalysis(serviceID string) (*dbmodel.TenantServiceReleaseAnalysis, error) {
+	analysis, err := db.GetManager().TenantServiceReleaseAnalysisDao().GetByServiceID(serviceID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &dbmodel.TenantServiceReleaseAnalysis{
+				ServiceID: serviceID,
+				Window:    300,
+				Interval:  30,
+			}, nil
+		}
+		return nil, err
+	}
+	return analysis, nil
+}
+
+// UpdateReleaseAnalysis create or update the post-deploy analysis of the component
+func (s *ServiceAction) UpdateReleaseAnalysis(serviceID string, req *api_model.ReleaseAnalysisReq) (*dbmodel.TenantServiceReleaseAnalysis, error) {
+	analysis, err := db.GetManager().TenantServiceReleaseAnalysisDao().GetByServiceID(serviceID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	create := analysis == nil
+	if create {
+		analysis = &dbmodel.TenantServiceReleaseAnalysis{ServiceID: serviceID}
+	}
+	analysis.Enable = req.Enable
+	analysis.Window = req.Window
+	analysis.Interval = req.Interval
+	analysis.ErrorRateThreshold = req.ErrorRateThreshold
+	analysis.P99LatencyThreshold = req.P99LatencyThreshold
+	analysis.RestartThreshold = req.RestartThreshold
+	if analysis.Window <= 0 {
+		analysis.Window = 300
+	}
+	if analysis.Interval <= 0 {
+		analysis.Interval = 30
+	}
+	if create {
+		return analysis, db.GetManager().TenantServiceReleaseAnalysisDao().AddModel(analysis)
+	}
+	return analysis, db.GetManager().TenantServiceReleaseAnalysisDao().UpdateModel(analysis)
+}
+
+// ListReleaseHealthRecords list the health records of the deployed versions of the component
+func (s *ServiceAction) ListReleaseHealthRecords(serviceID string, page, pageSize int) ([]*dbmodel.TenantServiceReleaseHealthRecords, int, error) {
+	records, err := db.GetManager().TenantServiceReleaseHealthRecordsDao().ListByServiceID(serviceID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := db.GetManager().TenantServiceReleaseHealthRecordsDao().CountByServiceID(serviceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, count, nil
+}
@@ -0,0 +1,121 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	api_model "github.com/goodrain/rainbond/api/model"
+	"github.com/goodrain/rainbond/api/util/bcode"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/util"
+	"github.com/goodrain/rainbond/util/cron"
+	"github.com/jinzhu/gorm"
+)
+
+// ListScalingSchedules list the scaling schedules of the component
+func (s *ServiceAction) ListScalingSchedules(serviceID string) ([]*dbmodel.TenantServiceScalingSchedules, error) {
+	return db.GetManager().TenantServiceScalingSchedulesDao().ListByServiceID(serviceID)
+}
+
+// AddScalingSchedule add a scaling schedule to the component
+func (s *ServiceAction) AddScalingSchedule(serviceID string, req *api_model.ScalingScheduleReq) (*dbmodel.TenantServiceScalingSchedules, error) {
+	if err := validateScalingSchedule(req); err != nil {
+		return nil, err
+	}
+	if req.ScheduleID == "" {
+		req.ScheduleID = util.NewUUID()
+	}
+	schedule := &dbmodel.TenantServiceScalingSchedules{
+		ScheduleID: req.ScheduleID,
+		ServiceID:  serviceID,
+		Enable:     req.Enable,
+		Cron:       req.Cron,
+		Timezone:   req.Timezone,
+		Replicas:   req.Replicas,
+		// the schedule is fired from now on
+		LastTime: time.Now(),
+	}
+	if err := db.GetManager().TenantServiceScalingSchedulesDao().AddModel(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// UpdateScalingSchedule update the scaling schedule of the component
+func (s *ServiceAction) UpdateScalingSchedule(serviceID, scheduleID string, req *api_model.ScalingScheduleReq) (*dbmodel.TenantServiceScalingSchedules, error) {
+	if err := validateScalingSchedule(req); err != nil {
+		return nil, err
+	}
+	schedule, err := getScalingSchedule(serviceID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if !schedule.Enable || schedule.Cron != req.Cron || schedule.Timezone != req.Timezone {
+		// the schedule is changed, do not fire the times before now
+		schedule.LastTime = time.Now()
+	}
+	schedule.Enable = req.Enable
+	schedule.Cron = req.Cron
+	schedule.Timezone = req.Timezone
+	schedule.Replicas = req.Replicas
+	if err := db.GetManager().TenantServiceScalingSchedulesDao().UpdateModel(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// DeleteScalingSchedule delete the scaling schedule of the component
+func (s *ServiceAction) DeleteScalingSchedule(serviceID, scheduleID string) error {
+	if _, err := getScalingSchedule(serviceID, scheduleID); err != nil {
+		return err
+	}
+	return db.GetManager().TenantServiceScalingSchedulesDao().DeleteByScheduleID(scheduleID)
+}
+
+func getScalingSchedule(serviceID, scheduleID string) (*dbmodel.TenantServiceScalingSchedules, error) {
+	schedule, err := db.GetManager().TenantServiceScalingSchedulesDao().GetByScheduleID(scheduleID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, bcode.ErrScalingScheduleNotFound
+		}
+		return nil, err
+	}
+	if schedule.ServiceID != serviceID {
+		return nil, bcode.ErrScalingScheduleNotFound
+	}
+	return schedule, nil
+}
+
+func validateScalingSchedule(req *api_model.ScalingScheduleReq) error {
+	if _, err := cron.Parse(req.Cron); err != nil {
+		return bcode.NewBadRequest(fmt.Sprintf("invalid cron expression: %v", err))
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return bcode.NewBadRequest(fmt.Sprintf("invalid timezone: %v", err))
+		}
+	}
+	if req.Replicas < 0 {
+		return bcode.NewBadRequest("replicas can not be negative")
+	}
+	return nil
+}
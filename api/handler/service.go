@@ -57,6 +57,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/util/flushwriter"
 	"k8s.io/client-go/kubernetes"
 )
@@ -2147,6 +2148,43 @@ func (s *ServiceAction) GetMultiServicePods(serviceIDs []string) (*K8sPodInfos,
 	return &re, nil
 }
 
+// GetPodContainerIDs returns the short ids of the containers of the component pod,
+// which identify the containers in the component logs.
+func (s *ServiceAction) GetPodContainerIDs(namespace, serviceID, podName string) ([]string, error) {
+	pod, err := s.kubeClient.CoreV1().Pods(namespace).Get(context.Background(), podName, metav1.GetOptions{})
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return nil, bcode.ErrPodNotFound
+		}
+		return nil, err
+	}
+	if pod.Labels["service_id"] != serviceID {
+		return nil, bcode.ErrPodNotFound
+	}
+	var containerIDs []string
+	statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		ids := []string{status.ContainerID}
+		// the logs of the restarted container are searched too
+		if status.LastTerminationState.Terminated != nil {
+			ids = append(ids, status.LastTerminationState.Terminated.ContainerID)
+		}
+		for _, id := range ids {
+			// the id is in the format of <runtime>://<id>
+			if i := strings.Index(id, "://"); i >= 0 {
+				id = id[i+3:]
+			}
+			if len(id) > 12 {
+				id = id[:12]
+			}
+			if id != "" {
+				containerIDs = append(containerIDs, id)
+			}
+		}
+	}
+	return containerIDs, nil
+}
+
 // GetComponentPodNums get pods
 func (s *ServiceAction) GetComponentPodNums(ctx context.Context, componentIDs []string) (map[string]int32, error) {
 	if logrus.IsLevelEnabled(logrus.DebugLevel) {
@@ -2262,6 +2300,11 @@ func (s *ServiceAction) deleteComponent(tx *gorm.DB, service *dbmodel.TenantServ
 		db.GetManager().ServiceEventDaoTransactions(tx).DelEventByServiceID,
 		db.GetManager().TenantServiceMonitorDaoTransactions(tx).DeleteServiceMonitorByServiceID,
 		db.GetManager().AppConfigGroupServiceDaoTransactions(tx).DeleteEffectiveServiceByServiceID,
+		db.GetManager().TenantServiceScalingSchedulesDaoTransactions(tx).DeleteByServiceID,
+		db.GetManager().TenantServiceIdlePolicyDaoTransactions(tx).DeleteByServiceID,
+		db.GetManager().TenantServiceCanaryReleaseDaoTransactions(tx).DeleteByServiceID,
+		db.GetManager().TenantServiceReleaseAnalysisDaoTransactions(tx).DeleteByServiceID,
+		db.GetManager().TenantServiceReleaseHealthRecordsDaoTransactions(tx).DeleteByServiceID,
 	}
 	if err := GetGatewayHandler().DeleteTCPRuleByServiceIDWithTransaction(service.ServiceID, tx); err != nil {
 		return err
@@ -2414,8 +2457,26 @@ func (s *ServiceAction) EventBuildVersion(serviceID, buildVersion string) (*api_
 	return result, nil
 }
 
+// validateAutoscalerRuleMetrics checks if the metrics can be converted to the metric specs of HPA
+func validateAutoscalerRuleMetrics(metrics []api_model.RuleMetric) error {
+	for _, metric := range metrics {
+		if err := metric.DbModel("").Validate(); err != nil {
+			return bcode.NewBadRequest(err.Error())
+		}
+		if metric.MetricSelector != "" {
+			if _, err := labels.Parse(metric.MetricSelector); err != nil {
+				return bcode.NewBadRequest(fmt.Sprintf("invalid metric selector %s: %v", metric.MetricSelector, err))
+			}
+		}
+	}
+	return nil
+}
+
 // AddAutoscalerRule -
 func (s *ServiceAction) AddAutoscalerRule(req *api_model.AutoscalerRuleReq) error {
+	if err := validateAutoscalerRuleMetrics(req.Metrics); err != nil {
+		return err
+	}
 	tx := db.GetManager().Begin()
 	defer db.GetManager().EnsureEndTransactionFunc()
 
@@ -2433,13 +2494,7 @@ func (s *ServiceAction) AddAutoscalerRule(req *api_model.AutoscalerRuleReq) erro
 	}
 
 	for _, metric := range req.Metrics {
-		m := &dbmodel.TenantServiceAutoscalerRuleMetrics{
-			RuleID:            req.RuleID,
-			MetricsType:       metric.MetricsType,
-			MetricsName:       metric.MetricsName,
-			MetricTargetType:  metric.MetricTargetType,
-			MetricTargetValue: metric.MetricTargetValue,
-		}
+		m := metric.DbModel(req.RuleID)
 		if err := db.GetManager().TenantServceAutoscalerRuleMetricsDaoTransactions(tx).AddModel(m); err != nil {
 			tx.Rollback()
 			return err
@@ -2465,6 +2520,9 @@ func (s *ServiceAction) AddAutoscalerRule(req *api_model.AutoscalerRuleReq) erro
 
 // UpdAutoscalerRule -
 func (s *ServiceAction) UpdAutoscalerRule(req *api_model.AutoscalerRuleReq) error {
+	if err := validateAutoscalerRuleMetrics(req.Metrics); err != nil {
+		return err
+	}
 	rule, err := db.GetManager().TenantServceAutoscalerRulesDao().GetByRuleID(req.RuleID)
 	if err != nil {
 		return err
@@ -2490,13 +2548,7 @@ func (s *ServiceAction) UpdAutoscalerRule(req *api_model.AutoscalerRuleReq) erro
 	}
 
 	for _, metric := range req.Metrics {
-		m := &dbmodel.TenantServiceAutoscalerRuleMetrics{
-			RuleID:            req.RuleID,
-			MetricsType:       metric.MetricsType,
-			MetricsName:       metric.MetricsName,
-			MetricTargetType:  metric.MetricTargetType,
-			MetricTargetValue: metric.MetricTargetValue,
-		}
+		m := metric.DbModel(req.RuleID)
 		if err := db.GetManager().TenantServceAutoscalerRuleMetricsDaoTransactions(tx).AddModel(m); err != nil {
 			tx.Rollback()
 			return err
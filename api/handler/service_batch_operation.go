@@ -69,6 +69,15 @@ func (b *BatchOperationHandler) serviceStartupSequence(serviceIDs []string) map[
 	return startupSeqConfigs
 }
 
+//operationHandlerFor the tasks of an operation on more than one component are sent with a low priority,
+//so that they do not hold up the operations on a single component.
+func (b *BatchOperationHandler) operationHandlerFor(batchOpReqs model.BatchOpRequesters) *OperationHandler {
+	if len(batchOpReqs) > 1 {
+		return b.operationHandler.withPriority(gclient.PriorityLow)
+	}
+	return b.operationHandler
+}
+
 //Build build
 func (b *BatchOperationHandler) Build(ctx context.Context, tenant *dbmodel.Tenants, operator string, batchOpReqs model.BatchOpRequesters) (model.BatchOpResult, error) {
 	if logrus.IsLevelEnabled(logrus.DebugLevel) {
@@ -95,10 +104,11 @@ func (b *BatchOperationHandler) Build(ctx context.Context, tenant *dbmodel.Tenan
 		return nil, err
 	}
 
+	operationHandler := b.operationHandlerFor(validBuilds)
 	for _, build := range validBuilds {
 		build.UpdateConfig("boot_seq_dep_service_ids", strings.Join(startupSeqConfigs[build.GetComponentID()], ","))
 		err := retryutil.Retry(1*time.Microsecond, 1, func() (bool, error) {
-			if err := b.operationHandler.build(build); err != nil {
+			if err := operationHandler.build(build); err != nil {
 				return false, err
 			}
 			return true, nil
@@ -141,11 +151,12 @@ func (b *BatchOperationHandler) Start(ctx context.Context, tenant *dbmodel.Tenan
 		return nil, err
 	}
 
+	operationHandler := b.operationHandlerFor(validRequestes)
 	for _, req := range validRequestes {
 		// startup sequence
 		req.UpdateConfig("boot_seq_dep_service_ids", strings.Join(startupSeqConfigs[req.GetComponentID()], ","))
 		err := retryutil.Retry(1*time.Microsecond, 1, func() (bool, error) {
-			if err := b.operationHandler.Start(req); err != nil {
+			if err := operationHandler.Start(req); err != nil {
 				return false, err
 			}
 			return true, nil
@@ -175,9 +186,10 @@ func (b *BatchOperationHandler) Stop(ctx context.Context, tenant *dbmodel.Tenant
 		return nil, err
 	}
 
+	operationHandler := b.operationHandlerFor(batchOpReqs)
 	for _, req := range batchOpReqs {
 		err := retryutil.Retry(1*time.Microsecond, 1, func() (bool, error) {
-			if err := b.operationHandler.Stop(req); err != nil {
+			if err := operationHandler.Stop(req); err != nil {
 				return false, err
 			}
 			return true, nil
@@ -220,10 +232,11 @@ func (b *BatchOperationHandler) Upgrade(ctx context.Context, tenant *dbmodel.Ten
 		return nil, err
 	}
 
+	operationHandler := b.operationHandlerFor(validUpgrades)
 	for _, upgrade := range validUpgrades {
 		upgrade.UpdateConfig("boot_seq_dep_service_ids", strings.Join(startupSeqConfigs[upgrade.GetComponentID()], ","))
 		err := retryutil.Retry(1*time.Microsecond, 1, func() (bool, error) {
-			if err := b.operationHandler.upgrade(upgrade); err != nil {
+			if err := operationHandler.upgrade(upgrade); err != nil {
 				return false, err
 			}
 			return true, nil
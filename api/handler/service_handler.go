@@ -69,6 +69,7 @@ type ServiceHandler interface {
 	CreateTenandIDAndName(eid string) (string, string, error)
 	GetPods(serviceID string) (*K8sPodInfos, error)
 	GetMultiServicePods(serviceIDs []string) (*K8sPodInfos, error)
+	GetPodContainerIDs(namespace, serviceID, podName string) ([]string, error)
 	GetComponentPodNums(ctx context.Context, componentIDs []string) (map[string]int32, error)
 	TransServieToDelete(ctx context.Context, tenantID, serviceID string) error
 	TenantServiceDeletePluginRelation(tenantID, serviceID, pluginID string) *util.APIHandleError
@@ -86,11 +87,28 @@ type ServiceHandler interface {
 	AddAutoscalerRule(req *api_model.AutoscalerRuleReq) error
 	UpdAutoscalerRule(req *api_model.AutoscalerRuleReq) error
 	ListScalingRecords(serviceID string, page, pageSize int) ([]*dbmodel.TenantServiceScalingRecords, int, error)
+	ListScalingSchedules(serviceID string) ([]*dbmodel.TenantServiceScalingSchedules, error)
+	AddScalingSchedule(serviceID string, req *api_model.ScalingScheduleReq) (*dbmodel.TenantServiceScalingSchedules, error)
+	UpdateScalingSchedule(serviceID, scheduleID string, req *api_model.ScalingScheduleReq) (*dbmodel.TenantServiceScalingSchedules, error)
+	DeleteScalingSchedule(serviceID, scheduleID string) error
 
 	UpdateServiceMonitor(tenantID, serviceID, name string, update api_model.UpdateServiceMonitorRequestStruct) (*dbmodel.TenantServiceMonitor, error)
 	DeleteServiceMonitor(tenantID, serviceID, name string) (*dbmodel.TenantServiceMonitor, error)
 	AddServiceMonitor(tenantID, serviceID string, add api_model.AddServiceMonitorRequestStruct) (*dbmodel.TenantServiceMonitor, error)
 
+	GetCanaryRelease(serviceID string) (*dbmodel.TenantServiceCanaryRelease, error)
+	UpdateCanaryStrategy(serviceID string, req *api_model.CanaryStrategyReq) (*dbmodel.TenantServiceCanaryRelease, error)
+	PauseCanaryRelease(serviceID string) error
+	PromoteCanaryRelease(tenantID, serviceID, eventID string, full bool) error
+	AbortCanaryRelease(tenantID, serviceID, eventID string) error
+
+	GetReleaseAnalysis(serviceID string) (*dbmodel.TenantServiceReleaseAnalysis, error)
+	UpdateReleaseAnalysis(serviceID string, req *api_model.ReleaseAnalysisReq) (*dbmodel.TenantServiceReleaseAnalysis, error)
+	ListReleaseHealthRecords(serviceID string, page, pageSize int) ([]*dbmodel.TenantServiceReleaseHealthRecords, int, error)
+
+	GetIdlePolicy(serviceID string) (*dbmodel.TenantServiceIdlePolicy, error)
+	UpdateIdlePolicy(serviceID string, req *api_model.IdlePolicyReq) (*dbmodel.TenantServiceIdlePolicy, error)
+
 	CreateK8sAttribute(tenantID, componentID string, k8sAttr *api_model.ComponentK8sAttribute) error
 	UpdateK8sAttribute(componentID string, k8sAttributes *api_model.ComponentK8sAttribute) error
 	DeleteK8sAttribute(componentID, name string) error
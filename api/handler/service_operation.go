@@ -36,6 +36,8 @@ import (
 //OperationHandler operation handler
 type OperationHandler struct {
 	mqCli gclient.MQClient
+	//the priority of the tasks sent by the handler
+	priority gclient.TaskPriority
 }
 
 //OperationResult batch operation result
@@ -55,6 +57,11 @@ func CreateOperationHandler(mqCli gclient.MQClient) *OperationHandler {
 	}
 }
 
+//withPriority returns a handler sending the tasks with the priority
+func (o *OperationHandler) withPriority(priority gclient.TaskPriority) *OperationHandler {
+	return &OperationHandler{mqCli: o.mqCli, priority: priority}
+}
+
 //Build service build,will create new version
 //if deploy version not define, will create by time
 func (o *OperationHandler) Build(batchOpReq model.ComponentOpReq) (*model.ComponentOpResult, error) {
@@ -135,6 +142,8 @@ func (o *OperationHandler) Stop(batchOpReq model.ComponentOpReq) error {
 		TaskType: "stop",
 		TaskBody: body,
 		Topic:    gclient.WorkerTopic,
+		Priority: o.priority,
+		TenantID: service.TenantID,
 	})
 	if err != nil {
 		return err
@@ -154,6 +163,8 @@ func (o *OperationHandler) Start(batchOpReq model.ComponentOpReq) error {
 		TaskType: "start",
 		TaskBody: body,
 		Topic:    gclient.WorkerTopic,
+		Priority: o.priority,
+		TenantID: service.TenantID,
 	})
 	if err != nil {
 		return err
@@ -206,6 +217,8 @@ func (o *OperationHandler) upgrade(batchOpReq model.ComponentOpReq) error {
 		TaskBody: body,
 		TaskType: "rolling_upgrade",
 		Topic:    gclient.WorkerTopic,
+		Priority: o.priority,
+		TenantID: component.TenantID,
 	})
 	if err != nil {
 		rollback()
@@ -254,6 +267,7 @@ func (o *OperationHandler) RollBack(rollback model.RollbackInfoRequestStruct) (r
 		},
 		TaskType: "rolling_upgrade",
 		Topic:    gclient.WorkerTopic,
+		TenantID: service.TenantID,
 	})
 	if err != nil {
 		rollbackFunc()
@@ -276,18 +290,20 @@ func (o *OperationHandler) buildFromMarketSlug(r *model.ComponentBuildReq, servi
 	body["service_alias"] = service.ServiceAlias
 	body["slug_info"] = r.SlugInfo
 	body["configs"] = r.Configs
-	return o.sendBuildTopic(service.ServiceID, "build_from_market_slug", body)
+	return o.sendBuildTopic(service, "build_from_market_slug", body)
 }
-func (o *OperationHandler) sendBuildTopic(serviceID, taskType string, body map[string]interface{}) error {
+func (o *OperationHandler) sendBuildTopic(service *dbmodel.TenantServices, taskType string, body map[string]interface{}) error {
 
 	topic := gclient.BuilderTopic
-	if o.isWindowsService(serviceID) {
+	if o.isWindowsService(service.ServiceID) {
 		topic = gclient.WindowsBuilderTopic
 	}
 	return o.mqCli.SendBuilderTopic(gclient.TaskStruct{
 		Topic:    topic,
 		TaskType: taskType,
 		TaskBody: body,
+		Priority: o.priority,
+		TenantID: service.TenantID,
 	})
 }
 
@@ -314,7 +330,7 @@ func (o *OperationHandler) buildFromImage(r *model.ComponentBuildReq, service *d
 		body["password"] = r.ImageInfo.Password
 	}
 	body["configs"] = r.Configs
-	return o.sendBuildTopic(service.ServiceID, "build_from_image", body)
+	return o.sendBuildTopic(service, "build_from_image", body)
 }
 
 func (o *OperationHandler) buildFromSourceCode(r *model.ComponentBuildReq, service *dbmodel.TenantServices) error {
@@ -342,7 +358,7 @@ func (o *OperationHandler) buildFromSourceCode(r *model.ComponentBuildReq, servi
 	}
 	body["expire"] = 180
 	body["configs"] = r.Configs
-	return o.sendBuildTopic(service.ServiceID, "build_from_source_code", body)
+	return o.sendBuildTopic(service, "build_from_source_code", body)
 }
 
 func (o *OperationHandler) isWindowsService(serviceID string) bool {
@@ -50,4 +50,8 @@ type TenantHandler interface {
 	DeleteTenant(ctx context.Context, tenantID string) error
 	GetClusterResource(ctx context.Context) *ClusterResourceStats
 	CheckResourceName(ctx context.Context, namespace string, req *model.CheckResourceNameReq) (*model.CheckResourceNameResp, error)
+	GetImageScanPolicy(tenantID string) (*dbmodel.TenantImageScanPolicy, error)
+	UpdateImageScanPolicy(tenantID string, req *api_model.ImageScanPolicyReq) (*dbmodel.TenantImageScanPolicy, error)
+	GetLogRetentionPolicy(tenantID string) (*dbmodel.TenantLogRetentionPolicy, error)
+	UpdateLogRetentionPolicy(tenantID string, req *api_model.LogRetentionPolicyReq) (*dbmodel.TenantLogRetentionPolicy, error)
 }
@@ -0,0 +1,340 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/goodrain/rainbond/api/handler"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/sirupsen/logrus"
+)
+
+// maxAuditBody the request bodies larger than it are not recorded
+const maxAuditBody = 64 << 10
+
+// maxAuditErrMsg the length of the error message recorded
+const maxAuditErrMsg = 1024
+
+// auditAddTimeout how long a request waits for the audit log to be queued, when too many logs are being saved
+const auditAddTimeout = 5 * time.Second
+
+// auditCleanInterval how often the audit logs out of the retention are deleted
+const auditCleanInterval = time.Hour
+
+// maskedValue replaces the values of the sensitive fields
+const maskedValue = "******"
+
+// sensitiveKeys the fields whose names contain them are masked in the audit logs
+var sensitiveKeys = []string{"password", "passwd", "secret", "token", "credential", "private", "access_key", "accesskey"}
+
+// nameKeys and valueKeys the fields of a name-value pair, e.g. an env {"attr_name":"DB_PASSWORD","attr_value":"..."},
+// the values are masked if the name contains a sensitive key
+var (
+	nameKeys  = []string{"name", "attr_name", "env_name", "key"}
+	valueKeys = []string{"value", "attr_value", "env_value"}
+)
+
+// Auditor records an audit log of every mutating request
+type Auditor struct {
+	logs       chan *dbmodel.AuditLog
+	addTimeout time.Duration
+	retention  time.Duration
+}
+
+// NewAuditor creates an auditor, the audit logs are saved asynchronously until the ctx is done.
+// The audit logs older than the retention are deleted, they are kept forever if the retention is 0.
+func NewAuditor(ctx context.Context, retention time.Duration) *Auditor {
+	a := &Auditor{
+		logs:       make(chan *dbmodel.AuditLog, 1024),
+		addTimeout: auditAddTimeout,
+		retention:  retention,
+	}
+	go a.run(ctx)
+	if retention > 0 {
+		go a.cleanLoop(ctx)
+	}
+	return a
+}
+
+// Audit the middleware recording the audit logs
+func (a *Auditor) Audit(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		if apiExclude(r) || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		start := time.Now()
+		body := readAuditBody(r)
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		errMsg := &limitedBuffer{limit: maxAuditErrMsg}
+		ww.Tee(errMsg)
+		defer func() {
+			log := &dbmodel.AuditLog{
+				Actor:       auditActor(r),
+				Operator:    auditOperator(body),
+				Action:      r.Method,
+				Path:        r.URL.Path,
+				RequestBody: body,
+				StatusCode:  ww.Status(),
+				Result:      dbmodel.AuditResultSuccess,
+				ClientIP:    clientIP(r),
+				Elapsed:     time.Since(start).Milliseconds(),
+			}
+			if log.StatusCode == 0 {
+				log.StatusCode = http.StatusOK
+			}
+			if log.StatusCode >= 400 {
+				log.Result = dbmodel.AuditResultFailure
+				log.ErrMsg = errMsg.String()
+			}
+			// the route context is reused after the request, so the route is read here
+			a.routeOf(r, log)
+			a.add(log)
+		}()
+		next.ServeHTTP(ww, r)
+	}
+	return http.HandlerFunc(fn)
+}
+
+func (a *Auditor) routeOf(r *http.Request, log *dbmodel.AuditLog) {
+	log.Resource = r.URL.Path
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return
+	}
+	if pattern := rctx.RoutePattern(); pattern != "" {
+		log.Resource = pattern
+	}
+	log.TenantName = rctx.URLParam("tenant_name")
+	for i := len(rctx.URLParams.Keys) - 1; i >= 0; i-- {
+		if rctx.URLParams.Keys[i] != "*" {
+			log.ResourceID = rctx.URLParams.Values[i]
+			break
+		}
+	}
+}
+
+// add queues the audit log, the request waits for the queue when too many logs are being saved
+func (a *Auditor) add(log *dbmodel.AuditLog) bool {
+	select {
+	case a.logs <- log:
+		return true
+	default:
+	}
+	timer := time.NewTimer(a.addTimeout)
+	defer timer.Stop()
+	select {
+	case a.logs <- log:
+		return true
+	case <-timer.C:
+		logrus.Errorf("the audit logs are not saved in %s, drop the audit log of %s %s by %s", a.addTimeout, log.Action, log.Path, log.Actor)
+		return false
+	}
+}
+
+func (a *Auditor) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case log := <-a.logs:
+			if log.TenantName != "" {
+				if tenant, err := db.GetManager().TenantDao().GetTenantIDByName(log.TenantName); err == nil {
+					log.TenantID = tenant.UUID
+				}
+			}
+			if err := db.GetManager().AuditLogDao().AddModel(log); err != nil {
+				logrus.Errorf("save the audit log of %s %s by %s: %v", log.Action, log.Path, log.Actor, err)
+			}
+		}
+	}
+}
+
+func (a *Auditor) cleanLoop(ctx context.Context) {
+	ticker := time.NewTicker(auditCleanInterval)
+	defer ticker.Stop()
+	for {
+		a.clean(time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// clean deletes the audit logs out of the retention
+func (a *Auditor) clean(now time.Time) {
+	deleted, err := db.GetManager().AuditLogDao().DeleteAuditLogsBefore(now.Add(-a.retention))
+	if err != nil {
+		logrus.Errorf("delete the audit logs older than %s: %v", a.retention, err)
+		return
+	}
+	if deleted > 0 {
+		logrus.Infof("%d audit logs older than %s deleted", deleted, a.retention)
+	}
+}
+
+// auditActor identifies who sends the request by the client certificate or the token, the token itself is never recorded
+func auditActor(r *http.Request) string {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return "cert:" + r.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	tt := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(tt) != 2 || tt[1] == "" {
+		return "anonymous"
+	}
+	info, ok := handler.GetDefaultTokenMap()[tt[1]]
+	if !ok {
+		return "invalid-token"
+	}
+	if info.EID != "" {
+		return "enterprise:" + info.EID
+	}
+	return "console"
+}
+
+func auditOperator(body string) string {
+	var req struct {
+		Operator interface{} `json:"operator"`
+	}
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		return ""
+	}
+	if operator, ok := req.Operator.(string); ok {
+		return operator
+	}
+	return ""
+}
+
+func clientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// readAuditBody reads the request body with the sensitive fields masked, and sets a new body for the next handlers
+func readAuditBody(r *http.Request) string {
+	if r.Body == nil || r.Body == http.NoBody {
+		return ""
+	}
+	head, err := ioutil.ReadAll(io.LimitReader(r.Body, maxAuditBody+1))
+	// the next handlers read the same data, including the unread part of a large body
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), r.Body), r.Body}
+	if err != nil {
+		logrus.Warningf("error reading request body: %v", err)
+		return ""
+	}
+	if len(head) > maxAuditBody {
+		return fmt.Sprintf("(the body larger than %d bytes is omitted)", maxAuditBody)
+	}
+	return maskBody(head)
+}
+
+// maskBody masks the sensitive fields of a json body, the other bodies are omitted as they can't be masked
+func maskBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return fmt.Sprintf("(the non-json body of %d bytes is omitted)", len(body))
+	}
+	masked, _ := json.Marshal(maskValue(data))
+	return string(masked)
+}
+
+func maskValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if isSensitiveKey(key) {
+				v[key] = maskedValue
+				continue
+			}
+			v[key] = maskValue(val)
+		}
+		if hasSensitiveName(v) {
+			for _, key := range valueKeys {
+				if _, ok := v[key]; ok {
+					v[key] = maskedValue
+				}
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = maskValue(v[i])
+		}
+	}
+	return value
+}
+
+// hasSensitiveName checks if the object is a name-value pair with a sensitive name
+func hasSensitiveName(v map[string]interface{}) bool {
+	for _, key := range nameKeys {
+		if name, ok := v[key].(string); ok && isSensitiveKey(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// limitedBuffer keeps the first limit bytes written to it
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if left := b.limit - b.Len(); left > 0 {
+		if len(p) > left {
+			b.Buffer.Write(p[:left])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,107 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/goodrain/rainbond/db"
+	"github.com/goodrain/rainbond/db/dao"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+)
+
+func TestMaskBody(t *testing.T) {
+	tests := []struct {
+		name, body, want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "plain", body: `{"service_id":"abc","replicas":2}`, want: `{"replicas":2,"service_id":"abc"}`},
+		{name: "nested", body: `{"user":{"Password":"p"},"envs":[{"name":"DB_TOKEN","attr_value":"v","api_token":"t"}]}`,
+			want: `{"envs":[{"api_token":"******","attr_value":"******","name":"DB_TOKEN"}],"user":{"Password":"******"}}`},
+		{name: "name-value", body: `{"envs":[{"attr_name":"SECRET_KEY","attr_value":"s"},{"attr_name":"PORT","attr_value":"80"},{"key":"db_password","value":"p"}]}`,
+			want: `{"envs":[{"attr_name":"SECRET_KEY","attr_value":"******"},{"attr_name":"PORT","attr_value":"80"},{"key":"db_password","value":"******"}]}`},
+		{name: "non-json", body: "a=b", want: "(the non-json body of 3 bytes is omitted)"},
+	}
+	for _, tc := range tests {
+		if got := maskBody([]byte(tc.body)); got != tc.want {
+			t.Errorf("%s: want %s, but got %s", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestReadAuditBody(t *testing.T) {
+	large := `{"operator":"admin","data":"` + strings.Repeat("x", maxAuditBody) + `"}`
+	for _, body := range []string{`{"operator":"admin","secret_key":"s"}`, large} {
+		r := httptest.NewRequest("POST", "/v2/tenants", strings.NewReader(body))
+		got := readAuditBody(r)
+		if body == large {
+			if !strings.Contains(got, "omitted") {
+				t.Errorf("the large body should be omitted, but got %s", got)
+			}
+		} else if got != `{"operator":"admin","secret_key":"******"}` || auditOperator(got) != "admin" {
+			t.Errorf("unexpected audit body %s", got)
+		}
+		read, _ := ioutil.ReadAll(r.Body)
+		if !bytes.Equal(read, []byte(body)) {
+			t.Errorf("the body read by the next handlers is changed")
+		}
+	}
+}
+
+func TestAuditorAdd(t *testing.T) {
+	a := &Auditor{logs: make(chan *dbmodel.AuditLog, 1), addTimeout: 50 * time.Millisecond}
+	if !a.add(&dbmodel.AuditLog{Path: "/1"}) {
+		t.Fatal("want the log queued")
+	}
+	// the request waits for the log being saved
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		<-a.logs
+	}()
+	if !a.add(&dbmodel.AuditLog{Path: "/2"}) {
+		t.Fatal("want the log queued after the queue is drained")
+	}
+	start := time.Now()
+	if a.add(&dbmodel.AuditLog{Path: "/3"}) {
+		t.Fatal("want the log dropped when the queue is full")
+	}
+	if elapsed := time.Since(start); elapsed < a.addTimeout {
+		t.Errorf("want the log dropped after %s, but dropped after %s", a.addTimeout, elapsed)
+	}
+}
+
+func TestAuditorClean(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	manager := db.NewMockManager(ctrl)
+	db.SetTestManager(manager)
+	auditLogDao := dao.NewMockAuditLogDao(ctrl)
+	manager.EXPECT().AuditLogDao().Return(auditLogDao)
+
+	now := time.Now()
+	a := &Auditor{retention: 24 * time.Hour}
+	auditLogDao.EXPECT().DeleteAuditLogsBefore(now.Add(-24*time.Hour)).Return(int64(3), nil)
+	a.clean(now)
+}
@@ -24,32 +24,22 @@ import dbmodel "github.com/goodrain/rainbond/db/model"
 type AutoscalerRuleReq struct {
 	RuleID      string `json:"rule_id" validate:"rule_id|required"`
 	ServiceID   string
-	Enable      bool   `json:"enable" validate:"enable|required"`
-	XPAType     string `json:"xpa_type" validate:"xpa_type|required"`
-	MinReplicas int    `json:"min_replicas" validate:"min_replicas|required"`
-	MaxReplicas int    `json:"max_replicas" validate:"min_replicas|required"`
-	Metrics     []struct {
-		MetricsType       string `json:"metric_type"`
-		MetricsName       string `json:"metric_name"`
-		MetricTargetType  string `json:"metric_target_type"`
-		MetricTargetValue int    `json:"metric_target_value"`
-	} `json:"metrics"`
+	Enable      bool         `json:"enable" validate:"enable|required"`
+	XPAType     string       `json:"xpa_type" validate:"xpa_type|required"`
+	MinReplicas int          `json:"min_replicas" validate:"min_replicas|required"`
+	MaxReplicas int          `json:"max_replicas" validate:"min_replicas|required"`
+	Metrics     []RuleMetric `json:"metrics"`
 }
 
 // AutoscalerRuleResp -
 type AutoscalerRuleResp struct {
-	RuleID      string `json:"rule_id"`
-	ServiceID   string `json:"service_id"`
-	Enable      bool   `json:"enable"`
-	XPAType     string `json:"xpa_type"`
-	MinReplicas int    `json:"min_replicas"`
-	MaxReplicas int    `json:"max_replicas"`
-	Metrics     []struct {
-		MetricsType       string `json:"metric_type"`
-		MetricsName       string `json:"metric_name"`
-		MetricTargetType  string `json:"metric_target_type"`
-		MetricTargetValue int    `json:"metric_target_value"`
-	} `json:"metrics"`
+	RuleID      string       `json:"rule_id"`
+	ServiceID   string       `json:"service_id"`
+	Enable      bool         `json:"enable"`
+	XPAType     string       `json:"xpa_type"`
+	MinReplicas int          `json:"min_replicas"`
+	MaxReplicas int          `json:"max_replicas"`
+	Metrics     []RuleMetric `json:"metrics"`
 }
 
 // AutoScalerRule -
@@ -80,6 +70,10 @@ type RuleMetric struct {
 	MetricsName       string `json:"metric_name"`
 	MetricTargetType  string `json:"metric_target_type"`
 	MetricTargetValue int    `json:"metric_target_value"`
+	// the label selector of pods, object and external metrics, such as queue=orders
+	MetricSelector string `json:"metric_selector"`
+	// the object of object metrics, [apiVersion/]kind/name
+	DescribedObject string `json:"described_object"`
 }
 
 // DbModel return database model
@@ -90,5 +84,18 @@ func (r RuleMetric) DbModel(ruleID string) *dbmodel.TenantServiceAutoscalerRuleM
 		MetricsName:       r.MetricsName,
 		MetricTargetType:  r.MetricTargetType,
 		MetricTargetValue: r.MetricTargetValue,
+		MetricSelector:    r.MetricSelector,
+		DescribedObject:   r.DescribedObject,
 	}
 }
+
+// ScalingScheduleReq -
+type ScalingScheduleReq struct {
+	ScheduleID string `json:"schedule_id"`
+	Enable     bool   `json:"enable"`
+	// the cron expression, such as '0 8 * * 1-5' or '@daily'
+	Cron string `json:"cron" validate:"cron|required"`
+	// the location of the cron expression, such as Asia/Shanghai, default is UTC
+	Timezone string `json:"timezone"`
+	Replicas int    `json:"replicas"`
+}
@@ -0,0 +1,43 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// CanaryStrategyReq the canary strategy of a component
+type CanaryStrategyReq struct {
+	// the comma separated traffic percentages of the canary version, such as 10,30,50
+	// in: body
+	// required: false
+	Steps string `json:"steps"`
+	// the replicas of the canary version
+	// in: body
+	// required: false
+	Replicas int `json:"replicas" validate:"replicas|numeric_between:0,100"`
+	// seconds to wait before moving to the next step automatically, 0 means manual promotion
+	// in: body
+	// required: false
+	StepInterval int `json:"step_interval" validate:"step_interval|numeric_between:0,86400"`
+}
+
+// PromoteCanaryReq -
+type PromoteCanaryReq struct {
+	// promote the canary version directly, skipping the remaining steps
+	// in: body
+	// required: false
+	Full bool `json:"full"`
+}
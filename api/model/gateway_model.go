@@ -102,11 +102,15 @@ type AddTCPRuleStruct struct {
 
 // DbModel return database model
 func (a *AddTCPRuleStruct) DbModel(serviceID string) *dbmodel.TCPRule {
+	ip := a.IP
+	if ip == "" {
+		ip = "0.0.0.0"
+	}
 	return &dbmodel.TCPRule{
 		UUID:          a.TCPRuleID,
 		ServiceID:     serviceID,
 		ContainerPort: a.ContainerPort,
-		IP:            a.IP,
+		IP:            ip,
 		Port:          a.Port,
 	}
 }
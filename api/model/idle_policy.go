@@ -0,0 +1,16 @@
+package model
+
+// IdlePolicyReq the policy to scale the component to zero when it is idle
+type IdlePolicyReq struct {
+	// in: body
+	// required: false
+	Enable bool `json:"enable"`
+	// seconds without any request before the component is scaled to zero
+	// in: body
+	// required: false
+	IdleTimeout int `json:"idle_timeout" validate:"idle_timeout|numeric_between:0,604800"`
+	// seconds the gateway holds a request while the component is scaling up
+	// in: body
+	// required: false
+	WakeTimeout int `json:"wake_timeout" validate:"wake_timeout|numeric_between:0,600"`
+}
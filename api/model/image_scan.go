@@ -0,0 +1,12 @@
+package model
+
+// ImageScanPolicyReq the policy to block the deploys of the images which have vulnerabilities
+type ImageScanPolicyReq struct {
+	// in: body
+	// required: false
+	Enable bool `json:"enable"`
+	// the images which have vulnerabilities of the severity or above are blocked
+	// in: body
+	// required: true
+	Severity string `json:"severity" validate:"severity|required|in:LOW,MEDIUM,HIGH,CRITICAL"`
+}
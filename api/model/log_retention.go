@@ -0,0 +1,18 @@
+package model
+
+// LogRetentionPolicyReq how long and how much the container logs of the tenant components are retained,
+// 0 means the default retention of eventlog is used.
+type LogRetentionPolicyReq struct {
+	// the days to retain the logs
+	// in: body
+	// required: false
+	MaxAgeDays int `json:"max_age_days" validate:"max_age_days|numeric_between:0,3650"`
+	// the max size(MB) of the logs of a component
+	// in: body
+	// required: false
+	MaxComponentSize int `json:"max_component_size" validate:"max_component_size|numeric_between:0,1048576"`
+	// the max size(MB) of the logs of all components of the tenant, 0 means unlimited
+	// in: body
+	// required: false
+	MaxTotalSize int `json:"max_total_size" validate:"max_total_size|numeric_between:0,10485760"`
+}
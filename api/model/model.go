@@ -1968,6 +1968,18 @@ func (u *UpdateAppRequest) NeedUpdateHelmApp() bool {
 	return len(u.Overrides) > 0 || u.Version != "" || u.Revision != 0
 }
 
+// UpdateAppTracingReq the tracing of the build-in service mesh, disable it by an empty driver.
+type UpdateAppTracingReq struct {
+	// in: body
+	// required: false
+	// enum: zipkin,otlp
+	Driver string `json:"driver"`
+	// host:port or url of the collector, e.g. http://zipkin.default:9411/api/v2/spans
+	Collector string `json:"collector"`
+	// sampling percentage of the requests, 0~100, default 100
+	Sampling float64 `json:"sampling"`
+}
+
 // BindServiceRequest -
 type BindServiceRequest struct {
 	ServiceIDs []string `json:"service_ids"`
@@ -0,0 +1,28 @@
+package model
+
+// ReleaseAnalysisReq the post-deploy analysis of a component, a zero threshold disables the check
+type ReleaseAnalysisReq struct {
+	// in: body
+	// required: false
+	Enable bool `json:"enable"`
+	// seconds to analyze the new version after it is ready
+	// in: body
+	// required: false
+	Window int `json:"window" validate:"window|numeric_between:0,86400"`
+	// seconds between two evaluations
+	// in: body
+	// required: false
+	Interval int `json:"interval" validate:"interval|numeric_between:0,3600"`
+	// the max percentage of 5xx responses
+	// in: body
+	// required: false
+	ErrorRateThreshold float64 `json:"error_rate_threshold"`
+	// the max p99 latency in milliseconds
+	// in: body
+	// required: false
+	P99LatencyThreshold float64 `json:"p99_latency_threshold"`
+	// the container restarts which trigger the rollback
+	// in: body
+	// required: false
+	RestartThreshold int `json:"restart_threshold"`
+}
@@ -104,6 +104,8 @@ func (m *Manager) SetMiddleware() {
 	} else {
 		r.Use(middleware.DefaultLogger)
 	}
+	//Records the mutating requests, including the ones rejected or panicked
+	r.Use(apimiddleware.NewAuditor(m.ctx, c.AuditLogRetention).Audit)
 	//Gracefully absorb panics and prints the stack trace
 	r.Use(middleware.Recoverer)
 	//request time out
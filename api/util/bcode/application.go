@@ -24,6 +24,12 @@ var (
 	ErrInvaildK8sApp = newByMessage(400, 11010, "invalid k8s app name")
 	// ErrK8sAppExists -
 	ErrK8sAppExists = newByMessage(400, 11011, "k8s app name exists")
+	// ErrInvalidTracingDriver -
+	ErrInvalidTracingDriver = newByMessage(400, 11012, "invalid tracing driver")
+	// ErrInvalidTracingCollector -
+	ErrInvalidTracingCollector = newByMessage(400, 11013, "invalid tracing collector")
+	// ErrInvalidTracingSampling -
+	ErrInvalidTracingSampling = newByMessage(400, 11014, "tracing sampling must be between 0 and 100")
 )
 
 // app config group 11100~11199
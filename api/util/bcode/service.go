@@ -14,4 +14,12 @@ var (
 	ErrHorizontalDueToNoChange = newByMessage(400, 10104, "The number of components has not changed, no need to scale")
 	ErrPodNotFound             = newByMessage(404, 10105, "pod not found")
 	ErrK8sComponentNameExists  = newByMessage(400, 10106, "k8s component name exists")
+	// ErrCanaryReleaseNotFound -
+	ErrCanaryReleaseNotFound = newByMessage(404, 10107, "canary release not found")
+	// ErrCanaryReleaseNotActive -
+	ErrCanaryReleaseNotActive = newByMessage(400, 10108, "no canary release is running")
+	// ErrInvalidCanarySteps -
+	ErrInvalidCanarySteps = newByMessage(400, 10109, "invalid canary steps")
+	// ErrScalingScheduleNotFound -
+	ErrScalingScheduleNotFound = newByMessage(404, 10110, "scaling schedule not found")
 )
@@ -0,0 +1,71 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/goodrain/rainbond/builder/buildcache"
+	httputil "github.com/goodrain/rainbond/util/http"
+	"github.com/sirupsen/logrus"
+)
+
+// ListBuildCache lists the build cache archives of the tenant
+func ListBuildCache(w http.ResponseWriter, r *http.Request) {
+	cache := buildcache.GetBuildCache()
+	if cache == nil {
+		httputil.ReturnError(r, w, 400, "build cache is not enabled")
+		return
+	}
+	tenantID := strings.TrimSpace(chi.URLParam(r, "tenantID"))
+	if !buildcache.ValidTenantID(tenantID) {
+		httputil.ReturnError(r, w, 400, buildcache.ErrInvalidTenantID.Error())
+		return
+	}
+	entries, err := cache.List(tenantID)
+	if err != nil {
+		logrus.Errorf("list build cache of tenant %s: %v", tenantID, err)
+		httputil.ReturnError(r, w, 500, err.Error())
+		return
+	}
+	httputil.ReturnSuccess(r, w, entries)
+}
+
+// PurgeBuildCache deletes the build cache archive of the language in the query, or all archives of the tenant
+func PurgeBuildCache(w http.ResponseWriter, r *http.Request) {
+	cache := buildcache.GetBuildCache()
+	if cache == nil {
+		httputil.ReturnError(r, w, 400, "build cache is not enabled")
+		return
+	}
+	tenantID := strings.TrimSpace(chi.URLParam(r, "tenantID"))
+	if !buildcache.ValidTenantID(tenantID) {
+		httputil.ReturnError(r, w, 400, buildcache.ErrInvalidTenantID.Error())
+		return
+	}
+	deleted, err := cache.Purge(tenantID, r.URL.Query().Get("lang"))
+	if err != nil {
+		logrus.Errorf("purge build cache of tenant %s: %v", tenantID, err)
+		httputil.ReturnError(r, w, 500, err.Error())
+		return
+	}
+	httputil.ReturnSuccess(r, w, map[string]int{"deleted": deleted})
+}
@@ -57,6 +57,10 @@ func APIServer() *chi.Mux {
 			r.Get("/service/{serviceID}", controller.GetVersionByServiceID)
 			r.Delete("/service/{eventID}", controller.DeleteVersionByEventID)
 		})
+		r.Route("/cache", func(r chi.Router) {
+			r.Get("/tenant/{tenantID}", controller.ListBuildCache)
+			r.Delete("/tenant/{tenantID}", controller.PurgeBuildCache)
+		})
 		r.Route("/event", func(r chi.Router) {
 			r.Get("/", controller.GetEventsByIds)
 		})
@@ -49,6 +49,10 @@ func init() {
 	buildcreaters[code.Nodejs] = slugBuilder
 	buildcreaters[code.Golang] = slugBuilder
 	buildcreaters[code.OSS] = slugBuilder
+	buildcreaters[code.Rust] = rustBuilder
+	buildcreaters[code.DotNet] = dotnetBuilder
+	buildcreaters[code.Deno] = denoBuilder
+	buildcreaters[code.CNB] = cnbBuilder
 }
 
 var buildcreaters map[code.Lang]CreaterBuild
@@ -81,33 +85,36 @@ type Response struct {
 
 //Request build input
 type Request struct {
-	KanikoImage   string
-	RbdNamespace  string
-	GRDataPVCName string
-	CachePVCName  string
-	CacheMode     string
-	CachePath     string
-	TenantID      string
-	SourceDir     string
-	CacheDir      string
-	TGZDir        string
-	RepositoryURL string
-	CodeSouceInfo sources.CodeSourceInfo
-	Branch        string
-	ServiceAlias  string
-	ServiceID     string
-	DeployVersion string
-	Runtime       string
-	ServerType    string
-	Commit        Commit
-	Lang          code.Lang
-	BuildEnvs     map[string]string
-	Logger        event.Logger
-	ImageClient   sources.ImageClient
-	KubeClient    kubernetes.Interface
-	ExtraHosts    []string
-	HostAlias     []HostAlias
-	Ctx           context.Context
+	KanikoImage     string
+	CNBBuilderImage string
+	RbdNamespace    string
+	GRDataPVCName   string
+	CachePVCName    string
+	CacheMode       string
+	CachePath       string
+	TenantID        string
+	SourceDir       string
+	CacheDir        string
+	TGZDir          string
+	RepositoryURL   string
+	CodeSouceInfo   sources.CodeSourceInfo
+	Branch          string
+	ServiceAlias    string
+	ServiceID       string
+	DeployVersion   string
+	Runtime         string
+	ServerType      string
+	Commit          Commit
+	Lang            code.Lang
+	BuildEnvs       map[string]string
+	Logger          event.Logger
+	ImageClient     sources.ImageClient
+	KubeClient      kubernetes.Interface
+	ExtraHosts      []string
+	HostAlias       []HostAlias
+	Ctx             context.Context
+	// the image the built image is pushed to, it is the image of the version by default
+	ImageName string
 }
 
 // HostAlias holds the mapping between IP and hostnames that will be injected as an entry in the
@@ -134,6 +141,23 @@ func GetBuild(lang code.Lang) (Build, error) {
 	return slugBuilder()
 }
 
+//UseBuildCache returns true if the build of the language mounts the build cache dir
+func UseBuildCache(lang code.Lang) bool {
+	switch lang {
+	case code.Dockerfile, code.Docker, code.NetCore, code.Rust, code.DotNet, code.Deno, code.CNB:
+		return false
+	}
+	return true
+}
+
+//GetImageName returns the image the built image is pushed to
+func (r *Request) GetImageName() string {
+	if r.ImageName != "" {
+		return r.ImageName
+	}
+	return CreateImageName(r.ServiceID, r.DeployVersion)
+}
+
 //CreateImageName create image name
 func CreateImageName(serviceID, deployversion string) string {
 	imageName := strings.ToLower(fmt.Sprintf("%s/%s:%s", builder.REGISTRYDOMAIN, serviceID, deployversion))
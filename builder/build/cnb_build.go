@@ -0,0 +1,208 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/eapache/channels"
+	"github.com/goodrain/rainbond/builder"
+	jobc "github.com/goodrain/rainbond/builder/job"
+	"github.com/goodrain/rainbond/builder/sources"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// cnbPlatformAPI the platform api of the lifecycle, the insecure registry is supported since 0.12
+var cnbPlatformAPI = "0.12"
+
+// cnbScript writes the build envs of the component to the platform dir, so that they are visible to the buildpacks,
+// then gives the source code to the cnb user and runs the creator of the lifecycle with the args.
+var cnbScript = `mkdir -p /platform/env
+for name in $CNB_PLATFORM_ENVS; do printf '%s' "$(printenv "CNB_PLATFORM_ENV_$name")" > "/platform/env/$name"; done
+chown -R "${CNB_USER_ID:-1000}:${CNB_GROUP_ID:-1000}" /workspace
+exec /cnb/lifecycle/creator "$@"`
+
+// cnbReservedEnvs the build envs used by the builder, they are not passed to the buildpacks
+var cnbReservedEnvs = map[string]bool{
+	"BUILD_MODE":          true,
+	"BUILD_BUILD_MODE":    true,
+	"CNB_BUILDER":         true,
+	"BUILD_CNB_BUILDER":   true,
+	"CNB_RUN_IMAGE":       true,
+	"BUILD_CNB_RUN_IMAGE": true,
+	"REPARSE":             true,
+	"NO_CACHE":            true,
+}
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+//UseCNB returns true if the component chooses to build the code by Cloud Native Buildpacks,
+//the build env BUILD_MODE is cnb. It works for the code of any language.
+func UseCNB(envs map[string]string) bool {
+	return strings.ToLower(buildEnv(envs, "BUILD_MODE", "")) == "cnb"
+}
+
+// cnbBuild runs the lifecycle of Cloud Native Buildpacks(detect, build and export) in the builder image,
+// the image is exported to the image registry directly.
+type cnbBuild struct{}
+
+func cnbBuilder() (Build, error) {
+	return &cnbBuild{}, nil
+}
+
+func (c *cnbBuild) Build(re *Request) (*Response, error) {
+	builderImage := buildEnv(re.BuildEnvs, "CNB_BUILDER", re.CNBBuilderImage)
+	if builderImage == "" {
+		return nil, fmt.Errorf("the builder image of Cloud Native Buildpacks is not specified")
+	}
+	imageName := re.GetImageName()
+	re.Logger.Info(fmt.Sprintf("start building the source code by Cloud Native Buildpacks with builder %s", builderImage), map[string]string{"step": "builder-exector"})
+	if err := runCNBJob(re, builderImage, imageName); err != nil {
+		re.Logger.Error(fmt.Sprintf("build image %s by Cloud Native Buildpacks failure, find log in rbd-chaos", imageName), map[string]string{"step": "builder-exector", "status": "failure"})
+		logrus.Errorf("build image by cnb error: %s", err.Error())
+		return nil, err
+	}
+	re.Logger.Info("push image to push local image registry success", map[string]string{"step": "builder-exector"})
+	return &Response{
+		MediumType: ImageMediumType,
+		MediumPath: imageName,
+	}, nil
+}
+
+func runCNBJob(re *Request, builderImage, imageName string) error {
+	// the layers cache of buildpacks is kept in the build cache dir of the component
+	cacheDir := path.Join(re.CacheDir, "cnb")
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return fmt.Errorf("create cnb cache dir: %v", err)
+	}
+	name := fmt.Sprintf("%s-%s-cnb", re.ServiceID, re.DeployVersion)
+	job := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: re.RbdNamespace,
+			Labels: map[string]string{
+				"service": re.ServiceID,
+				"job":     "codebuild",
+			},
+		},
+	}
+	sourceVolume, sourceMount := builderCacheVolume(re, "source", re.SourceDir, "/workspace")
+	cacheVolume, cacheMount := builderCacheVolume(re, "cnb-cache", cacheDir, "/cache")
+	volumes := []corev1.Volume{
+		sourceVolume,
+		cacheVolume,
+		{Name: "layers", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: "platform", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{
+			Name: "registry-auth",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: "rbd-hub-credentials",
+					Items: []corev1.KeyToPath{
+						{
+							Key:  ".dockerconfigjson",
+							Path: "config.json",
+						},
+					},
+				},
+			},
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		sourceMount,
+		cacheMount,
+		{Name: "layers", MountPath: "/layers"},
+		{Name: "platform", MountPath: "/platform"},
+		{Name: "registry-auth", MountPath: "/cnb-docker"},
+	}
+	var root int64
+	container := corev1.Container{
+		Name:         "cnb",
+		Image:        builderImage,
+		Command:      append([]string{"/bin/sh", "-c", cnbScript, "creator"}, cnbCreatorArgs(re, imageName)...),
+		Env:          cnbEnvs(re.BuildEnvs),
+		VolumeMounts: mounts,
+		// the lifecycle drops the privileges to the cnb user after it prepares the dirs
+		SecurityContext: &corev1.SecurityContext{RunAsUser: &root},
+	}
+	podSpec := corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+		Volumes:       volumes,
+		Containers:    []corev1.Container{container},
+	}
+	for _, ha := range re.HostAlias {
+		podSpec.HostAliases = append(podSpec.HostAliases, corev1.HostAlias{IP: ha.IP, Hostnames: ha.Hostnames})
+	}
+	job.Spec = podSpec
+	(&slugBuild{re: re}).setImagePullSecretsForPod(&job)
+
+	writer := re.Logger.GetWriter("build-code", "info")
+	reChan := channels.NewRingChannel(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logrus.Debugf("create cnb job[name: %s; namespace: %s]", job.Name, job.Namespace)
+	if err := jobc.GetJobController().ExecJob(ctx, &job, writer, reChan); err != nil {
+		return fmt.Errorf("create cnb job %s: %v", name, err)
+	}
+	defer jobc.GetJobController().DeleteJob(job.Name)
+	return sources.WaitingComplete(reChan)
+}
+
+func cnbCreatorArgs(re *Request, imageName string) []string {
+	args := []string{
+		"-app=/workspace",
+		"-layers=/layers",
+		"-platform=/platform",
+		"-cache-dir=/cache",
+		"-insecure-registry=" + builder.REGISTRYDOMAIN,
+	}
+	if runImage := buildEnv(re.BuildEnvs, "CNB_RUN_IMAGE", ""); runImage != "" {
+		args = append(args, "-run-image="+runImage)
+	}
+	return append(args, imageName)
+}
+
+// cnbEnvs the envs of the lifecycle, the build envs of the component are prefixed with CNB_PLATFORM_ENV_
+// so that they do not affect the lifecycle, and their names are listed in CNB_PLATFORM_ENVS.
+func cnbEnvs(buildEnvs map[string]string) []corev1.EnvVar {
+	envs := []corev1.EnvVar{
+		{Name: "CNB_PLATFORM_API", Value: cnbPlatformAPI},
+		{Name: "DOCKER_CONFIG", Value: "/cnb-docker"},
+	}
+	var names []string
+	for name := range buildEnvs {
+		if cnbReservedEnvs[name] || !envNameRegexp.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		envs = append(envs, corev1.EnvVar{Name: "CNB_PLATFORM_ENV_" + name, Value: buildEnvs[name]})
+	}
+	return append(envs, corev1.EnvVar{Name: "CNB_PLATFORM_ENVS", Value: strings.Join(names, " ")})
+}
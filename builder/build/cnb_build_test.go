@@ -0,0 +1,63 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"testing"
+)
+
+func TestUseCNB(t *testing.T) {
+	tests := []struct {
+		envs map[string]string
+		want bool
+	}{
+		{envs: map[string]string{"BUILD_MODE": "cnb"}, want: true},
+		{envs: map[string]string{"BUILD_BUILD_MODE": "CNB"}, want: true},
+		{envs: map[string]string{"BUILD_MODE": "slug"}},
+		{envs: nil},
+	}
+	for _, tc := range tests {
+		if got := UseCNB(tc.envs); got != tc.want {
+			t.Errorf("UseCNB(%v): expected %v, but got %v", tc.envs, tc.want, got)
+		}
+	}
+}
+
+func TestCNBEnvs(t *testing.T) {
+	envs := cnbEnvs(map[string]string{
+		"BP_JVM_VERSION": "17",
+		"BUILD_MODE":     "cnb",
+		"CNB_BUILDER":    "paketobuildpacks/builder-jammy-full",
+		"BAD-NAME":       "x",
+		"BP_LOG_LEVEL":   "DEBUG",
+	})
+	got := make(map[string]string, len(envs))
+	for _, env := range envs {
+		got[env.Name] = env.Value
+	}
+	if got["CNB_PLATFORM_ENVS"] != "BP_JVM_VERSION BP_LOG_LEVEL" {
+		t.Errorf("unexpected platform envs %q", got["CNB_PLATFORM_ENVS"])
+	}
+	if got["CNB_PLATFORM_ENV_BP_JVM_VERSION"] != "17" || got["CNB_PLATFORM_ENV_BP_LOG_LEVEL"] != "DEBUG" {
+		t.Errorf("unexpected envs %v", got)
+	}
+	if _, ok := got["BP_JVM_VERSION"]; ok {
+		t.Errorf("the build envs should not be set to the lifecycle directly")
+	}
+}
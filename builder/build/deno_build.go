@@ -0,0 +1,64 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/goodrain/rainbond/builder/parser/code"
+)
+
+var denoDockerfileTmpl = `
+FROM denoland/deno:${DENO_VERSION}
+WORKDIR /app
+COPY . .
+RUN ${DENO_CACHE}
+ENTRYPOINT ["deno"]
+CMD ${DENO_CMD}
+`
+
+func denoBuilder() (Build, error) {
+	return &templateBuild{dockerfile: denoDockerfile}, nil
+}
+
+// denoDockerfile runs the start task of deno.json or the entry file, the dependencies of the entry are cached in the image.
+func denoDockerfile(re *Request) (string, error) {
+	args := code.GetDenoStartArgs(re.SourceDir)
+	if startArgs := buildEnv(re.BuildEnvs, "DENO_START_ARGS", ""); startArgs != "" {
+		args = strings.Fields(startArgs)
+	}
+	if len(args) == 0 {
+		return "", fmt.Errorf("no start task or entry file is found")
+	}
+	cmd, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	cache := "true"
+	if entry := code.GetDenoEntry(re.SourceDir); entry != "" {
+		cache = "deno cache " + entry
+	}
+	return renderDockerfile(denoDockerfileTmpl, map[string]string{
+		"DENO_VERSION": buildEnv(re.BuildEnvs, "RUNTIMES", "latest"),
+		"DENO_CACHE":   cache,
+		"DENO_CMD":     string(cmd),
+	}), nil
+}
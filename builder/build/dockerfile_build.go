@@ -29,6 +29,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"path"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -48,7 +50,7 @@ func (d *dockerfileBuild) Build(re *Request) (*Response, error) {
 		re.Logger.Error(fmt.Sprintf("Parse dockerfile error"), map[string]string{"step": "builder-exector"})
 		return nil, err
 	}
-	buildImageName := CreateImageName(re.ServiceID, re.DeployVersion)
+	buildImageName := re.GetImageName()
 	if err := d.stopPreBuildJob(re); err != nil {
 		logrus.Errorf("stop pre build job for service %s failure %s", re.ServiceID, err.Error())
 	}
@@ -103,6 +105,15 @@ func (d *dockerfileBuild) runBuildJob(re *Request, buildImageName string) error
 		StdinOnce: true,
 		Args:      []string{"--context=dir:///workspace", fmt.Sprintf("--destination=%s", buildImageName), "--skip-tls-verify"},
 	}
+	args := GetARGs(re.BuildEnvs)
+	var argNames []string
+	for name := range args {
+		argNames = append(argNames, name)
+	}
+	sort.Strings(argNames)
+	for _, name := range argNames {
+		container.Args = append(container.Args, fmt.Sprintf("--build-arg=%s=%s", name, *args[name]))
+	}
 	container.VolumeMounts = mounts
 	podSpec.Containers = append(podSpec.Containers, container)
 	job.Spec = podSpec
@@ -124,6 +135,18 @@ func (d *dockerfileBuild) runBuildJob(re *Request, buildImageName string) error
 	return nil
 }
 
+//GetARGs returns the build args of the dockerfile from the build envs prefixed with ARG_
+func GetARGs(buildEnvs map[string]string) map[string]*string {
+	args := make(map[string]*string)
+	for k, v := range buildEnvs {
+		if name := strings.TrimPrefix(k, "ARG_"); name != k && name != "" {
+			v := v
+			args[name] = &v
+		}
+	}
+	return args
+}
+
 func (d *dockerfileBuild) createVolumeAndMount(re *Request) (volumes []corev1.Volume, volumeMounts []corev1.VolumeMount) {
 	hostPathType := corev1.HostPathDirectoryOrCreate
 	hostsFilePathType := corev1.HostPathFile
@@ -0,0 +1,74 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goodrain/rainbond/builder/parser/code"
+)
+
+var dotnetDockerfileTmpl = `
+FROM mcr.microsoft.com/dotnet/sdk:${DOTNET_VERSION} AS builder
+WORKDIR /src
+COPY . .
+RUN ${DOTNET_RESTORE_PRE} && dotnet publish "${DOTNET_PROJECT}" -c Release -o /out
+
+FROM mcr.microsoft.com/dotnet/${DOTNET_RUNTIME}:${DOTNET_VERSION}
+WORKDIR /app
+COPY --from=builder /out/ .
+ENTRYPOINT ["dotnet", "${DOTNET_ASSEMBLY}.dll"]
+`
+
+func dotnetBuilder() (Build, error) {
+	return &templateBuild{dockerfile: dotnetDockerfile}, nil
+}
+
+// dotnetDockerfile publishes the startup project, it can be specified by the build env DOTNET_PROJECT
+func dotnetDockerfile(re *Request) (string, error) {
+	var project *code.DotNetProject
+	projects := code.ListDotNetProjects(re.SourceDir)
+	if projectPath := buildEnv(re.BuildEnvs, "DOTNET_PROJECT", ""); projectPath != "" {
+		for _, p := range projects {
+			if p.Path == strings.TrimPrefix(projectPath, "./") {
+				project = p
+			}
+		}
+		if project == nil {
+			return "", fmt.Errorf("project %s of .NET 5 and later is not found", projectPath)
+		}
+	} else {
+		project = code.GetDotNetStartupProject(re.SourceDir)
+		if project == nil {
+			return "", fmt.Errorf("no project of .NET 5 and later is found")
+		}
+	}
+	runtime := "runtime"
+	if project.IsWeb() {
+		runtime = "aspnet"
+	}
+	return renderDockerfile(dotnetDockerfileTmpl, map[string]string{
+		"DOTNET_VERSION":     buildEnv(re.BuildEnvs, "RUNTIMES", project.Version()),
+		"DOTNET_PROJECT":     project.Path,
+		"DOTNET_RUNTIME":     runtime,
+		"DOTNET_ASSEMBLY":    project.AssemblyName,
+		"DOTNET_RESTORE_PRE": buildEnv(re.BuildEnvs, "DOTNET_RESTORE_PRE", "true"),
+	}), nil
+}
@@ -62,7 +62,7 @@ func (d *netcoreBuild) Build(re *Request) (*Response, error) {
 	d.logger = re.Logger
 	d.serviceID = re.ServiceID
 	d.sourceDir = re.SourceDir
-	d.imageName = CreateImageName(re.ServiceID, re.DeployVersion)
+	d.imageName = re.GetImageName()
 	d.imageClient = re.ImageClient
 
 	re.Logger.Info("start compiling the source code", map[string]string{"step": "builder-exector"})
@@ -21,7 +21,6 @@ package build
 import (
 	"testing"
 
-	"github.com/docker/docker/client"
 	"github.com/goodrain/rainbond/builder/parser/code"
 	"github.com/goodrain/rainbond/event"
 )
@@ -31,7 +30,6 @@ func TestBuildNetCore(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	dockerCli, _ := client.NewEnvClient()
 	req := &Request{
 		SourceDir:     "/Users/qingguo/goodrain/dotnet-docker/samples/aspnetapp/test",
 		CacheDir:      "/Users/qingguo/goodrain/dotnet-docker/samples/aspnetapp/test/cache",
@@ -41,7 +39,6 @@ func TestBuildNetCore(t *testing.T) {
 		Commit:        Commit{User: "barnett"},
 		Lang:          code.NetCore,
 		Logger:        event.GetTestLogger(),
-		DockerClient:  dockerCli,
 	}
 	res, err := build.Build(req)
 	if err != nil {
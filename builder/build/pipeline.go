@@ -0,0 +1,196 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eapache/channels"
+	jobc "github.com/goodrain/rainbond/builder/job"
+	"github.com/goodrain/rainbond/builder/parser/code"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//StageRequest the request to run a stage of the build pipeline
+type StageRequest struct {
+	*Request
+	Stage *code.PipelineStage
+	// the image built by the build stage, empty before the build stage
+	BuildImage string
+}
+
+//RunStage runs the script of the pipeline stage in a builder job, the source code is mounted at /app.
+//The logs of the job are written to the step of the stage.
+func RunStage(re *StageRequest) error {
+	name := fmt.Sprintf("%s-%s-%s", re.ServiceID, re.DeployVersion, re.Stage.Name)
+	job := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: re.RbdNamespace,
+			Labels: map[string]string{
+				"service": re.ServiceID,
+				"job":     "codebuild",
+				"stage":   re.Stage.Name,
+			},
+		},
+	}
+	volume, mount := stageSourceVolume(re.Request)
+	container := corev1.Container{
+		Name:         "stage",
+		Image:        re.Stage.Image,
+		Command:      []string{"/bin/sh", "-c", re.Stage.Script},
+		WorkingDir:   mount.MountPath,
+		Env:          stageEnvs(re),
+		VolumeMounts: []corev1.VolumeMount{mount},
+	}
+	podSpec := corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+		Volumes:       []corev1.Volume{volume},
+		Containers:    []corev1.Container{container},
+	}
+	for _, ha := range re.HostAlias {
+		podSpec.HostAliases = append(podSpec.HostAliases, corev1.HostAlias{IP: ha.IP, Hostnames: ha.Hostnames})
+	}
+	job.Spec = podSpec
+	(&slugBuild{re: re.Request}).setImagePullSecretsForPod(&job)
+
+	writer := re.Logger.GetWriter(StageStep(re.Stage), "info")
+	reChan := channels.NewRingChannel(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logrus.Debugf("create stage job[name: %s; namespace: %s]", job.Name, job.Namespace)
+	if err := jobc.GetJobController().ExecJob(ctx, &job, writer, reChan); err != nil {
+		return fmt.Errorf("create stage job %s: %v", name, err)
+	}
+	defer jobc.GetJobController().DeleteJob(job.Name)
+	return waitingStageComplete(re.Stage, reChan)
+}
+
+//StageStep the step of the stage in the event log
+func StageStep(stage *code.PipelineStage) string {
+	if stage.Type == code.StageTypeBuild {
+		return "build-code"
+	}
+	return "stage-" + stage.Name
+}
+
+// stageSourceVolume mounts the source code of the build, it is in the cache dir of the builder
+func stageSourceVolume(re *Request) (corev1.Volume, corev1.VolumeMount) {
+	return builderCacheVolume(re, "source", re.SourceDir, "/app")
+}
+
+// builderCacheVolume mounts the dir which is in the cache dir of the builder to the job
+func builderCacheVolume(re *Request, name, dir, mountPath string) (corev1.Volume, corev1.VolumeMount) {
+	subPath := strings.TrimPrefix(dir, "/cache/")
+	mount := corev1.VolumeMount{
+		Name:      name,
+		MountPath: mountPath,
+	}
+	if re.CacheMode == "hostpath" {
+		hostPathType := corev1.HostPathDirectory
+		return corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: path.Join(re.CachePath, subPath),
+					Type: &hostPathType,
+				},
+			},
+		}, mount
+	}
+	mount.SubPath = subPath
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: re.CachePVCName,
+			},
+		},
+	}, mount
+}
+
+func stageEnvs(re *StageRequest) []corev1.EnvVar {
+	envs := []corev1.EnvVar{
+		{Name: "SLUG_VERSION", Value: re.DeployVersion},
+		{Name: "SERVICE_ID", Value: re.ServiceID},
+		{Name: "TENANT_ID", Value: re.TenantID},
+		{Name: "CODE_COMMIT_HASH", Value: re.Commit.Hash},
+		{Name: "CODE_COMMIT_USER", Value: re.Commit.User},
+		{Name: "CODE_COMMIT_MESSAGE", Value: re.Commit.Message},
+		{Name: "LANGUAGE", Value: re.Lang.String()},
+		{Name: "STAGE_NAME", Value: re.Stage.Name},
+		{Name: "STAGE_TYPE", Value: string(re.Stage.Type)},
+	}
+	if re.BuildImage != "" {
+		envs = append(envs, corev1.EnvVar{Name: "BUILD_IMAGE", Value: re.BuildImage})
+	}
+	// the envs of the stage take precedence over the build envs of the component
+	custom := make(map[string]string, len(re.BuildEnvs)+len(re.Stage.Envs))
+	for k, v := range re.BuildEnvs {
+		custom[k] = v
+	}
+	for k, v := range re.Stage.Envs {
+		custom[k] = v
+	}
+	keys := make([]string, 0, len(custom))
+	for k := range custom {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		envs = append(envs, corev1.EnvVar{Name: k, Value: custom[k]})
+	}
+	return envs
+}
+
+func waitingStageComplete(stage *code.PipelineStage, reChan *channels.RingChannel) (err error) {
+	var logComplete = false
+	var jobComplete = false
+	timeout := time.NewTimer(time.Duration(stage.Timeout) * time.Second)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-timeout.C:
+			return fmt.Errorf("stage %s time out (more than %d seconds)", stage.Name, stage.Timeout)
+		case jobStatus := <-reChan.Out():
+			switch jobStatus.(string) {
+			case "complete":
+				jobComplete = true
+			case "failed":
+				jobComplete = true
+				err = fmt.Errorf("stage %s job exec failure", stage.Name)
+			case "cancel":
+				jobComplete = true
+				err = fmt.Errorf("stage %s job is canceled", stage.Name)
+			case "logcomplete":
+				logComplete = true
+			}
+			if jobComplete && logComplete {
+				return err
+			}
+		}
+	}
+}
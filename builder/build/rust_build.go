@@ -0,0 +1,62 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"fmt"
+
+	"github.com/goodrain/rainbond/builder/parser/code"
+)
+
+var rustDockerfileTmpl = `
+FROM rust:${RUST_VERSION}-slim AS builder
+WORKDIR /app
+COPY . .
+RUN ${RUST_BUILD_PRE} && cargo build --release --bin ${RUST_BINARY}
+
+FROM ${RUST_RUNTIME_IMAGE}
+RUN apt-get update && apt-get install -y --no-install-recommends ca-certificates && rm -rf /var/lib/apt/lists/*
+WORKDIR /app
+COPY --from=builder /app/target/release/${RUST_BINARY} /app/${RUST_BINARY}
+CMD ["/app/${RUST_BINARY}"]
+`
+
+func rustBuilder() (Build, error) {
+	return &templateBuild{dockerfile: rustDockerfile}, nil
+}
+
+func rustDockerfile(re *Request) (string, error) {
+	binary := buildEnv(re.BuildEnvs, "RUST_BINARY", "")
+	if binary == "" {
+		manifest, err := code.ReadCargoManifest(re.SourceDir)
+		if err != nil {
+			return "", fmt.Errorf("read Cargo.toml: %v", err)
+		}
+		binary = manifest.Binary()
+	}
+	if binary == "" {
+		return "", fmt.Errorf("no binary is defined in Cargo.toml")
+	}
+	return renderDockerfile(rustDockerfileTmpl, map[string]string{
+		"RUST_VERSION":       buildEnv(re.BuildEnvs, "RUNTIMES", "1"),
+		"RUST_BINARY":        binary,
+		"RUST_BUILD_PRE":     buildEnv(re.BuildEnvs, "RUST_BUILD_PRE", "true"),
+		"RUST_RUNTIME_IMAGE": buildEnv(re.BuildEnvs, "RUST_RUNTIME_IMAGE", "debian:bookworm-slim"),
+	}), nil
+}
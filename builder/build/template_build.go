@@ -0,0 +1,83 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/goodrain/rainbond/builder/sources"
+	"github.com/goodrain/rainbond/util"
+	"github.com/sirupsen/logrus"
+)
+
+// templateBuild builds the image with the multi-stage dockerfile generated for the language of the code
+type templateBuild struct {
+	// dockerfile generates the dockerfile from the code and the build envs
+	dockerfile func(re *Request) (string, error)
+}
+
+func (t *templateBuild) Build(re *Request) (*Response, error) {
+	dockerfile, err := t.dockerfile(re)
+	if err != nil {
+		re.Logger.Error(fmt.Sprintf("generate dockerfile of %s failure: %v", re.Lang, err), map[string]string{"step": "builder-exector", "status": "failure"})
+		return nil, err
+	}
+	dfpath := path.Join(re.SourceDir, "Dockerfile")
+	logrus.Debugf("dest: %s; write dockerfile: %s", dfpath, dockerfile)
+	if err := ioutil.WriteFile(dfpath, []byte(dockerfile), 0644); err != nil {
+		return nil, fmt.Errorf("write default dockerfile error:%s", err.Error())
+	}
+	defer os.Remove(dfpath)
+
+	re.Logger.Info("start compiling the source code", map[string]string{"step": "builder-exector"})
+	imageName := re.GetImageName()
+	err = sources.ImageBuild(re.SourceDir, re.RbdNamespace, re.ServiceID, re.DeployVersion, re.Logger, "run-build", "", re.KanikoImage)
+	if err != nil {
+		re.Logger.Error(fmt.Sprintf("build image %s failure, find log in rbd-chaos", imageName), map[string]string{"step": "builder-exector", "status": "failure"})
+		logrus.Errorf("build image error: %s", err.Error())
+		return nil, err
+	}
+	re.Logger.Info("push image to push local image registry success", map[string]string{"step": "builder-exector"})
+	if err := re.ImageClient.ImageRemove(imageName); err != nil {
+		logrus.Errorf("remove image %s failure %s", imageName, err.Error())
+	}
+	return &Response{
+		MediumType: ImageMediumType,
+		MediumPath: imageName,
+	}, nil
+}
+
+// buildEnv returns the value of the build env, the envs detected from the code are prefixed with BUILD_
+func buildEnv(envs map[string]string, key, def string) string {
+	if value := envs[key]; value != "" {
+		return value
+	}
+	if value := envs["BUILD_"+key]; value != "" {
+		return value
+	}
+	return def
+}
+
+// renderDockerfile replaces the variables of the template, all variables should be given.
+func renderDockerfile(tmpl string, vars map[string]string) string {
+	return util.ParseVariable(tmpl, vars)
+}
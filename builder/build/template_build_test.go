@@ -0,0 +1,84 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package build
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestTemplateDockerfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		envs       map[string]string
+		dockerfile func(re *Request) (string, error)
+		contains   []string
+	}{
+		{
+			name:       "rust",
+			files:      map[string]string{"Cargo.toml": "[package]\nname = \"demo\"\n\n[[bin]]\nname = \"server\"\npath = \"src/main.rs\"\n"},
+			envs:       map[string]string{"BUILD_RUNTIMES": "1.75"},
+			dockerfile: rustDockerfile,
+			contains:   []string{"FROM rust:1.75-slim AS builder", "cargo build --release --bin server", `CMD ["/app/server"]`},
+		},
+		{
+			name:       "dotnet",
+			files:      map[string]string{"src/Api/Api.csproj": `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>net8.0</TargetFramework></PropertyGroup></Project>`},
+			dockerfile: dotnetDockerfile,
+			contains:   []string{"FROM mcr.microsoft.com/dotnet/sdk:8.0 AS builder", `dotnet publish "src/Api/Api.csproj"`, "FROM mcr.microsoft.com/dotnet/aspnet:8.0", `ENTRYPOINT ["dotnet", "Api.dll"]`},
+		},
+		{
+			name:       "deno",
+			files:      map[string]string{"deno.json": `{}`, "main.ts": ""},
+			dockerfile: denoDockerfile,
+			contains:   []string{"FROM denoland/deno:latest", "RUN deno cache main.ts", `CMD ["run","--allow-all","main.ts"]`},
+		},
+	}
+	for _, tc := range tests {
+		dir, err := ioutil.TempDir("", "template")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		for name, content := range tc.files {
+			if err := os.MkdirAll(path.Dir(path.Join(dir, name)), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(path.Join(dir, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		dockerfile, err := tc.dockerfile(&Request{SourceDir: dir, BuildEnvs: tc.envs})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if strings.Contains(dockerfile, "${") {
+			t.Errorf("%s: unresolved variables in dockerfile:\n%s", tc.name, dockerfile)
+		}
+		for _, c := range tc.contains {
+			if !strings.Contains(dockerfile, c) {
+				t.Errorf("%s: expected dockerfile contains %q, but got:\n%s", tc.name, c, dockerfile)
+			}
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package buildcache
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/goodrain/rainbond/builder/cloudos"
+	"github.com/goodrain/rainbond/util"
+	"github.com/sirupsen/logrus"
+)
+
+// keyPrefix the prefix of the keys of the cache archives in the storage
+const keyPrefix = "build-cache"
+
+// BackendLocal stores the cache archives in a local directory, such as a directory of the shared grdata
+const BackendLocal = "local"
+
+var buildCache *BuildCache
+
+// ErrInvalidTenantID the tenant id is not a uuid, it is part of the path of the cache archives
+var ErrInvalidTenantID = errors.New("invalid tenant id")
+
+var tenantIDRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+
+// ValidTenantID returns true if the tenant id is a uuid
+func ValidTenantID(tenantID string) bool {
+	return tenantIDRegexp.MatchString(tenantID)
+}
+
+// Config the configuration of the build cache
+type Config struct {
+	// Backend local, s3 or alioss, the build cache is disabled if it is empty
+	Backend string
+	// LocalDir the directory to store the cache archives of the local backend
+	LocalDir string
+	// the object storage of s3 or alioss backend
+	Endpoint   string
+	AccessKey  string
+	SecretKey  string
+	BucketName string
+}
+
+// Entry is a cache archive of the language of the tenant
+type Entry struct {
+	TenantID   string    `json:"tenant_id"`
+	Lang       string    `json:"lang"`
+	Size       int64     `json:"size"`
+	UpdateTime time.Time `json:"update_time"`
+}
+
+// BuildCache restores and saves the build cache of the components,
+// the cache is shared by the components in the same language of a tenant.
+type BuildCache struct {
+	storage cloudos.CloudOSer
+}
+
+// InitBuildCache init the build cache, the build cache is disabled if no backend is configured
+func InitBuildCache(cfg *Config) error {
+	if cfg.Backend == "" {
+		return nil
+	}
+	var storage cloudos.CloudOSer
+	if cfg.Backend == BackendLocal {
+		if err := util.CheckAndCreateDir(cfg.LocalDir); err != nil {
+			return fmt.Errorf("create build cache dir %s: %v", cfg.LocalDir, err)
+		}
+		storage = newLocalDir(cfg.LocalDir)
+	} else {
+		provider, err := cloudos.Str2S3Provider(cfg.Backend)
+		if err != nil {
+			return fmt.Errorf("unsupported build cache backend %s", cfg.Backend)
+		}
+		storage, err = cloudos.New(&cloudos.Config{
+			ProviderType: provider,
+			Endpoint:     cfg.Endpoint,
+			AccessKey:    cfg.AccessKey,
+			SecretKey:    cfg.SecretKey,
+			BucketName:   cfg.BucketName,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	buildCache = &BuildCache{storage: storage}
+	logrus.Infof("build cache is enabled, backend: %s", cfg.Backend)
+	return nil
+}
+
+// GetBuildCache returns the build cache, nil means the build cache is disabled
+func GetBuildCache() *BuildCache {
+	return buildCache
+}
+
+var invalidLangChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+func langKey(lang string) string {
+	return invalidLangChars.ReplaceAllString(strings.ToLower(lang), "-")
+}
+
+func objectKey(tenantID, lang string) string {
+	return path.Join(keyPrefix, tenantID, langKey(lang)+".tgz")
+}
+
+// archivePath the temporary archive is next to the cache dir, which has enough space for the cache
+func archivePath(dir string) string {
+	return path.Join(path.Dir(dir), fmt.Sprintf(".%s-%d.tgz", path.Base(dir), time.Now().UnixNano()))
+}
+
+// Restore extracts the cache archive into the dir if the dir is empty, it returns true if the cache is restored.
+func (b *BuildCache) Restore(tenantID, lang, dir string) (bool, error) {
+	if !ValidTenantID(tenantID) {
+		return false, ErrInvalidTenantID
+	}
+	if !util.DirIsEmpty(dir) {
+		return false, nil
+	}
+	key := objectKey(tenantID, lang)
+	if _, err := b.storage.StatObject(key); err != nil {
+		if err == cloudos.ErrObjectNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("stat cache archive: %v", err)
+	}
+	if err := util.CheckAndCreateDir(dir); err != nil {
+		return false, err
+	}
+	archive := archivePath(dir)
+	defer os.Remove(archive)
+	if err := b.storage.GetObject(key, archive); err != nil {
+		return false, fmt.Errorf("download cache archive: %v", err)
+	}
+	if err := util.UnTar(archive, dir, true); err != nil {
+		return false, fmt.Errorf("extract cache archive: %v", err)
+	}
+	return true, nil
+}
+
+// Save archives the dir and uploads it as the cache of the language of the tenant
+func (b *BuildCache) Save(tenantID, lang, dir string) error {
+	if !ValidTenantID(tenantID) {
+		return ErrInvalidTenantID
+	}
+	if util.DirIsEmpty(dir) {
+		return nil
+	}
+	archive := archivePath(dir)
+	defer os.Remove(archive)
+	cmd := exec.Command("tar", "-czf", archive, "-C", dir, ".")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("archive cache dir: %v, %s", err, string(out))
+	}
+	if err := b.storage.PutObject(objectKey(tenantID, lang), archive); err != nil {
+		return fmt.Errorf("upload cache archive: %v", err)
+	}
+	return nil
+}
+
+// List lists the cache archives of the tenant
+func (b *BuildCache) List(tenantID string) ([]*Entry, error) {
+	if !ValidTenantID(tenantID) {
+		return nil, ErrInvalidTenantID
+	}
+	objects, err := b.storage.ListObjects(path.Join(keyPrefix, tenantID) + "/")
+	if err != nil {
+		return nil, err
+	}
+	entries := []*Entry{}
+	for _, obj := range objects {
+		name := path.Base(obj.Key)
+		if !strings.HasSuffix(name, ".tgz") {
+			continue
+		}
+		entries = append(entries, &Entry{
+			TenantID:   tenantID,
+			Lang:       strings.TrimSuffix(name, ".tgz"),
+			Size:       obj.Size,
+			UpdateTime: obj.LastModified,
+		})
+	}
+	return entries, nil
+}
+
+// Purge deletes the cache archive of the language of the tenant, or all of the tenant if lang is empty.
+// It returns the number of the deleted archives.
+func (b *BuildCache) Purge(tenantID, lang string) (int, error) {
+	if !ValidTenantID(tenantID) {
+		return 0, ErrInvalidTenantID
+	}
+	if lang != "" {
+		key := objectKey(tenantID, lang)
+		if _, err := b.storage.StatObject(key); err != nil {
+			if err == cloudos.ErrObjectNotFound {
+				return 0, nil
+			}
+			return 0, err
+		}
+		return 1, b.storage.DeleteObject(key)
+	}
+	entries, err := b.List(tenantID)
+	if err != nil {
+		return 0, err
+	}
+	for i, entry := range entries {
+		if err := b.storage.DeleteObject(objectKey(tenantID, entry.Lang)); err != nil {
+			return i, err
+		}
+	}
+	return len(entries), nil
+}
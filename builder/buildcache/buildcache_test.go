@@ -0,0 +1,113 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package buildcache
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestBuildCache(t *testing.T) {
+	root, err := ioutil.TempDir("", "buildcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	cache := &BuildCache{storage: newLocalDir(path.Join(root, "storage"))}
+	tenantID := "6d0a4b1f53a94a8e9a1c3e8f2b7d4c5a"
+
+	src := path.Join(root, "src")
+	if err := ioutil.WriteFile(path.Join(mustDir(t, path.Join(src, "repository")), "a.jar"), []byte("jar"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Save(tenantID, "Java-maven", src); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := cache.List(tenantID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Lang != "java-maven" {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+
+	dst := path.Join(root, "dst")
+	restored, err := cache.Restore(tenantID, "Java-maven", dst)
+	if err != nil || !restored {
+		t.Fatalf("expected the cache is restored, but got %v %v", restored, err)
+	}
+	data, err := ioutil.ReadFile(path.Join(dst, "repository", "a.jar"))
+	if err != nil || string(data) != "jar" {
+		t.Fatalf("unexpected restored file %s %v", data, err)
+	}
+	// the cache dir is not empty
+	if restored, _ := cache.Restore(tenantID, "Java-maven", dst); restored {
+		t.Fatal("expected the existing cache dir is not restored")
+	}
+	if restored, err := cache.Restore(tenantID, "Python", path.Join(root, "python")); err != nil || restored {
+		t.Fatalf("expected no cache of python, but got %v %v", restored, err)
+	}
+	if deleted, err := cache.Purge(tenantID, "Python"); err != nil || deleted != 0 {
+		t.Fatalf("expected no cache of python is purged, but got %d %v", deleted, err)
+	}
+
+	deleted, err := cache.Purge(tenantID, "")
+	if err != nil || deleted != 1 {
+		t.Fatalf("expected 1 cache is purged, but got %d %v", deleted, err)
+	}
+	if entries, _ := cache.List(tenantID); len(entries) != 0 {
+		t.Fatalf("expected no entries, but got %+v", entries)
+	}
+}
+
+func mustDir(t *testing.T, dir string) string {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestValidTenantID(t *testing.T) {
+	tests := []struct {
+		tenantID string
+		want     bool
+	}{
+		{"6d0a4b1f53a94a8e9a1c3e8f2b7d4c5a", true},
+		{"6d0a4b1f-53a9-4a8e-9a1c-3e8f2b7d4c5a", true},
+		{"", false},
+		{"..", false},
+		{"../../etc", false},
+		{"6d0a4b1f53a94a8e9a1c3e8f2b7d4c5a/..", false},
+		{"tenant", false},
+	}
+	for _, tc := range tests {
+		if got := ValidTenantID(tc.tenantID); got != tc.want {
+			t.Errorf("%q: expected %v, but got %v", tc.tenantID, tc.want, got)
+		}
+	}
+	cache := &BuildCache{storage: newLocalDir(os.TempDir())}
+	if _, err := cache.List("../../etc"); err != ErrInvalidTenantID {
+		t.Errorf("expected ErrInvalidTenantID, but got %v", err)
+	}
+	if _, err := cache.Purge("..", ""); err != ErrInvalidTenantID {
+		t.Errorf("expected ErrInvalidTenantID, but got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package buildcache
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/goodrain/rainbond/builder/cloudos"
+	"github.com/goodrain/rainbond/util"
+)
+
+// localDir stores the objects as the files in a directory
+type localDir struct {
+	dir string
+}
+
+func newLocalDir(dir string) cloudos.CloudOSer {
+	return &localDir{dir: dir}
+}
+
+func (l *localDir) PutObject(objkey, filePath string) error {
+	target := path.Join(l.dir, objkey)
+	if err := util.CheckAndCreateDir(path.Dir(target)); err != nil {
+		return err
+	}
+	// copy to a temporary file first, so that the restoring never reads a partial archive
+	tmp := target + ".tmp"
+	os.Remove(tmp)
+	if err := util.CopyFile(filePath, tmp); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, target)
+}
+
+func (l *localDir) GetObject(objkey, filePath string) error {
+	return util.CopyFile(path.Join(l.dir, objkey), filePath)
+}
+
+func (l *localDir) DeleteObject(objkey string) error {
+	err := os.Remove(path.Join(l.dir, objkey))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (l *localDir) StatObject(objkey string) (*cloudos.ObjectInfo, error) {
+	info, err := os.Stat(path.Join(l.dir, objkey))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, cloudos.ErrObjectNotFound
+		}
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, cloudos.ErrObjectNotFound
+	}
+	return &cloudos.ObjectInfo{
+		Key:          objkey,
+		Size:         info.Size(),
+		LastModified: info.ModTime(),
+	}, nil
+}
+
+func (l *localDir) ListObjects(prefix string) ([]cloudos.ObjectInfo, error) {
+	var objects []cloudos.ObjectInfo
+	err := filepath.Walk(l.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(p, ".tmp") {
+			return nil
+		}
+		key, err := filepath.Rel(l.dir, p)
+		if err != nil {
+			return err
+		}
+		key = filepath.ToSlash(key)
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, cloudos.ObjectInfo{
+				Key:          key,
+				Size:         info.Size(),
+				LastModified: info.ModTime(),
+			})
+		}
+		return nil
+	})
+	return objects, err
+}
@@ -2,6 +2,10 @@ package cloudos
 
 import (
 	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 )
 
@@ -68,6 +72,63 @@ func (a *aliOSS) DeleteObject(objkey string) error {
 	return bucket.DeleteObject(objkey)
 }
 
+func (a *aliOSS) ListObjects(prefix string) ([]ObjectInfo, error) {
+	bucket, err := a.Bucket(a.BucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to gets the bucket instance: %v", err)
+	}
+
+	var objects []ObjectInfo
+	marker := oss.Marker("")
+	for {
+		res, err := bucket.ListObjects(oss.Prefix(prefix), marker)
+		if err != nil {
+			svcErr, ok := err.(oss.ServiceError)
+			if !ok {
+				return nil, err
+			}
+			return nil, svcErrToS3SDKError(svcErr)
+		}
+		for _, obj := range res.Objects {
+			objects = append(objects, ObjectInfo{
+				Key:          obj.Key,
+				Size:         obj.Size,
+				LastModified: obj.LastModified,
+			})
+		}
+		if !res.IsTruncated {
+			return objects, nil
+		}
+		marker = oss.Marker(res.NextMarker)
+	}
+}
+
+func (a *aliOSS) StatObject(objkey string) (*ObjectInfo, error) {
+	bucket, err := a.Bucket(a.BucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to gets the bucket instance: %v", err)
+	}
+
+	meta, err := bucket.GetObjectDetailedMeta(objkey)
+	if err != nil {
+		svcErr, ok := err.(oss.ServiceError)
+		if !ok {
+			return nil, err
+		}
+		if svcErr.StatusCode == http.StatusNotFound {
+			return nil, ErrObjectNotFound
+		}
+		return nil, svcErrToS3SDKError(svcErr)
+	}
+	size, _ := strconv.ParseInt(meta.Get("Content-Length"), 10, 64)
+	lastModified, _ := time.Parse(http.TimeFormat, meta.Get("Last-Modified"))
+	return &ObjectInfo{
+		Key:          objkey,
+		Size:         size,
+		LastModified: lastModified,
+	}, nil
+}
+
 func svcErrToS3SDKError(svcErr oss.ServiceError) S3SDKError {
 	return S3SDKError{
 		Code:       svcErr.Code,
@@ -2,11 +2,14 @@ package cloudos
 
 import (
 	"errors"
+	"time"
 )
 
 var (
 	// ErrUnsupportedS3Provider -
 	ErrUnsupportedS3Provider = errors.New("unsupported s3 provider")
+	// ErrObjectNotFound -
+	ErrObjectNotFound = errors.New("object not found")
 )
 
 // S3Provider -
@@ -40,6 +43,16 @@ type CloudOSer interface {
 	PutObject(objkey, filepath string) error
 	GetObject(objectKey, filePath string) error
 	DeleteObject(objkey string) error
+	ListObjects(prefix string) ([]ObjectInfo, error)
+	// StatObject returns the information of the object, or ErrObjectNotFound if it does not exist
+	StatObject(objkey string) (*ObjectInfo, error)
+}
+
+// ObjectInfo is the information of an object in the cloud object storage.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
 }
 
 // New returns a new CloudOSer.
@@ -2,10 +2,12 @@ package cloudos
 
 import (
 	"io/ioutil"
+	"net/http"
 	"os"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -69,6 +71,24 @@ func (s *s3Driver) GetObject(objkey, filePath string) error {
 	return nil
 }
 
+func (s *s3Driver) ListObjects(prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	err := s.s3.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s.BucketName),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			objects = append(objects, ObjectInfo{
+				Key:          aws.StringValue(obj.Key),
+				Size:         aws.Int64Value(obj.Size),
+				LastModified: aws.TimeValue(obj.LastModified),
+			})
+		}
+		return true
+	})
+	return objects, err
+}
+
 func (s *s3Driver) DeleteObject(objkey string) error {
 	_, err := s.s3.DeleteObject(&s3.DeleteObjectInput{
 		Bucket: aws.String(s.BucketName),
@@ -76,3 +96,21 @@ func (s *s3Driver) DeleteObject(objkey string) error {
 	})
 	return err
 }
+
+func (s *s3Driver) StatObject(objkey string) (*ObjectInfo, error) {
+	resp, err := s.s3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(s.BucketName),
+		Key:    aws.String(objkey),
+	})
+	if err != nil {
+		if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return &ObjectInfo{
+		Key:          objkey,
+		Size:         aws.Int64Value(resp.ContentLength),
+		LastModified: aws.TimeValue(resp.LastModified),
+	}, nil
+}
@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/goodrain/rainbond/builder/exector"
 	"github.com/goodrain/rainbond/cmd/builder/option"
 	"github.com/goodrain/rainbond/mq/api/grpc/pb"
@@ -46,6 +47,7 @@ type TaskManager struct {
 	client                 client.MQClient
 	exec                   exector.Manager
 	callbackChan           chan *pb.TaskMessage
+	leases                 *client.LeaseKeeper
 }
 
 //NewTaskManager return *TaskManager
@@ -66,11 +68,13 @@ func NewTaskManager(c option.Config, client client.MQClient, exec exector.Manage
 		callbackChan:   callbackChan,
 	}
 	exec.SetReturnTaskChan(taskManager.callback)
+	exec.SetTaskDoneFunc(taskManager.ack)
 	return taskManager
 }
 
 //Start 启动
 func (t *TaskManager) Start(errChan chan error) error {
+	t.leases = client.NewLeaseKeeper(t.ctx, t.client, t.config.Topic)
 	go t.Do(errChan)
 	logrus.Info("start discover success.")
 	return nil
@@ -78,16 +82,29 @@ func (t *TaskManager) Start(errChan chan error) error {
 func (t *TaskManager) callback(task *pb.TaskMessage) {
 	ctx, cancel := context.WithCancel(t.ctx)
 	defer cancel()
+	// the task is given back but not failed, so it is not counted as a delivery
+	returned := proto.Clone(task).(*pb.TaskMessage)
+	returned.DeliveryCount = 0
 	_, err := t.client.Enqueue(ctx, &pb.EnqueueRequest{
 		Topic:   client.BuilderTopic,
-		Message: task,
+		Message: returned,
 	})
 	if err != nil {
 		logrus.Errorf("callback task to mq failure %s", err.Error())
+		// the leased task will be redelivered
+		return
 	}
+	t.ack(task)
 	logrus.Infof("The build controller returns an indigestible task(%s) to the messaging system", task.TaskId)
 }
 
+//ack acknowledges the leased task so that it will not be redelivered
+func (t *TaskManager) ack(task *pb.TaskMessage) {
+	if err := t.leases.Ack(task.TaskId); err != nil {
+		logrus.Warningf("ack task %s failure %s", task.TaskId, err.Error())
+	}
+}
+
 //Do do
 func (t *TaskManager) Do(errChan chan error) {
 	hostName, _ := os.Hostname()
@@ -97,7 +114,7 @@ func (t *TaskManager) Do(errChan chan error) {
 			return
 		default:
 			ctx, cancel := context.WithCancel(t.discoverCtx)
-			data, err := t.client.Dequeue(ctx, &pb.DequeueRequest{Topic: t.config.Topic, ClientHost: hostName + "-builder"})
+			data, err := t.leases.Lease(ctx, hostName+"-builder")
 			cancel()
 			if err != nil {
 				if grpc1.ErrorDesc(err) == context.DeadlineExceeded.Error() {
@@ -124,8 +141,10 @@ func (t *TaskManager) Do(errChan chan error) {
 			}
 			err = t.exec.AddTask(data)
 			if err != nil {
-				t.callbackChan <- data
 				logrus.Error("add task error:", err.Error())
+				if err := t.leases.Nack(data.TaskId, err.Error()); err != nil {
+					logrus.Warningf("nack task %s failure %s", data.TaskId, err.Error())
+				}
 			}
 		}
 	}
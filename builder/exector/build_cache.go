@@ -0,0 +1,59 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package exector
+
+import (
+	"github.com/goodrain/rainbond/builder/build"
+	"github.com/goodrain/rainbond/builder/buildcache"
+	"github.com/goodrain/rainbond/builder/parser/code"
+	"github.com/sirupsen/logrus"
+)
+
+// restoreBuildCache restores the cache dir of the component from the build cache of the language,
+// it only happens when the cache dir is empty, such as the builder is rescheduled to another node.
+func (i *SourceCodeBuildItem) restoreBuildCache() {
+	cache := buildcache.GetBuildCache()
+	if cache == nil || !build.UseBuildCache(code.Lang(i.Lang)) {
+		return
+	}
+	if _, ok := i.BuildEnvs["NO_CACHE"]; ok {
+		return
+	}
+	restored, err := cache.Restore(i.TenantID, i.Lang, i.CacheDir)
+	if err != nil {
+		logrus.Warningf("restore build cache of component %s: %v", i.ServiceID, err)
+		return
+	}
+	if restored {
+		i.Logger.Info("restore the build cache successfully", map[string]string{"step": "build-exector"})
+	}
+}
+
+// saveBuildCache saves the cache dir of the component after it is built successfully
+func (i *SourceCodeBuildItem) saveBuildCache() {
+	cache := buildcache.GetBuildCache()
+	if cache == nil || !build.UseBuildCache(code.Lang(i.Lang)) {
+		return
+	}
+	if err := cache.Save(i.TenantID, i.Lang, i.CacheDir); err != nil {
+		logrus.Warningf("save build cache of component %s: %v", i.ServiceID, err)
+		return
+	}
+	logrus.Debugf("save build cache of component %s successfully", i.ServiceID)
+}
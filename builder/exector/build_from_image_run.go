@@ -80,6 +80,11 @@ func (i *ImageBuildItem) Run(timeout time.Duration) error {
 		i.Logger.Error(fmt.Sprintf("获取指定镜像: %s失败", i.Image), map[string]string{"step": "builder-exector", "status": "failure"})
 		return err
 	}
+	status, report, err := gateImage(i.ImageClient, i.ServiceID, i.Image, i.Logger)
+	saveScanResult(i.ServiceID, i.DeployVersion, status, report)
+	if err != nil {
+		return err
+	}
 	localImageURL := build.CreateImageName(i.ServiceID, i.DeployVersion)
 	if err := i.ImageClient.ImageTag(i.Image, localImageURL, i.Logger, 1); err != nil {
 		logrus.Errorf("change image tag error: %s", err.Error())
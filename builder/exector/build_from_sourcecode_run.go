@@ -46,35 +46,36 @@ import (
 
 //SourceCodeBuildItem SouceCodeBuildItem
 type SourceCodeBuildItem struct {
-	Namespace     string       `json:"namespace"`
-	TenantName    string       `json:"tenant_name"`
-	GRDataPVCName string       `json:"gr_data_pvc_name"`
-	CachePVCName  string       `json:"cache_pvc_name"`
-	CacheMode     string       `json:"cache_mode"`
-	CachePath     string       `json:"cache_path"`
-	ServiceAlias  string       `json:"service_alias"`
-	Action        string       `json:"action"`
-	DestImage     string       `json:"dest_image"`
-	Logger        event.Logger `json:"logger"`
-	EventID       string       `json:"event_id"`
-	CacheDir      string       `json:"cache_dir"`
-	TGZDir        string       `json:"tgz_dir"`
-	ImageClient   sources.ImageClient
-	KanikoImage   string
-	KubeClient    kubernetes.Interface
-	RbdNamespace  string
-	RbdRepoName   string
-	TenantID      string
-	ServiceID     string
-	DeployVersion string
-	Lang          string
-	Runtime       string
-	BuildEnvs     map[string]string
-	CodeSouceInfo sources.CodeSourceInfo
-	RepoInfo      *sources.RepostoryBuildInfo
-	commit        Commit
-	Configs       map[string]gjson.Result `json:"configs"`
-	Ctx           context.Context
+	Namespace       string       `json:"namespace"`
+	TenantName      string       `json:"tenant_name"`
+	GRDataPVCName   string       `json:"gr_data_pvc_name"`
+	CachePVCName    string       `json:"cache_pvc_name"`
+	CacheMode       string       `json:"cache_mode"`
+	CachePath       string       `json:"cache_path"`
+	ServiceAlias    string       `json:"service_alias"`
+	Action          string       `json:"action"`
+	DestImage       string       `json:"dest_image"`
+	Logger          event.Logger `json:"logger"`
+	EventID         string       `json:"event_id"`
+	CacheDir        string       `json:"cache_dir"`
+	TGZDir          string       `json:"tgz_dir"`
+	ImageClient     sources.ImageClient
+	KanikoImage     string
+	CNBBuilderImage string
+	KubeClient      kubernetes.Interface
+	RbdNamespace    string
+	RbdRepoName     string
+	TenantID        string
+	ServiceID       string
+	DeployVersion   string
+	Lang            string
+	Runtime         string
+	BuildEnvs       map[string]string
+	CodeSouceInfo   sources.CodeSourceInfo
+	RepoInfo        *sources.RepostoryBuildInfo
+	commit          Commit
+	Configs         map[string]gjson.Result `json:"configs"`
+	Ctx             context.Context
 }
 
 //Commit code Commit
@@ -273,9 +274,20 @@ func (i *SourceCodeBuildItem) Run(timeout time.Duration) error {
 		}
 		i.Lang = string(lang)
 	}
+	if build.UseCNB(i.BuildEnvs) {
+		i.Lang = code.CNB.String()
+	}
+
+	stages, err := i.getPipeline()
+	if err != nil {
+		logrus.Errorf("read build pipeline error %s", err.Error())
+		i.Logger.Error("The pipeline of rainbondfile is invalid, "+err.Error(), map[string]string{"step": "builder-exector", "status": "failure"})
+		return err
+	}
 
 	i.Logger.Info("pull or clone code successfully, start code build", map[string]string{"step": "codee-version"})
-	res, err := i.codeBuild()
+	i.restoreBuildCache()
+	res, err := i.runPipeline(stages)
 	if err != nil {
 		if err.Error() == context.DeadlineExceeded.Error() {
 			i.Logger.Error("Build app version from source code timeout, the maximum time is 60 minutes", map[string]string{"step": "builder-exector", "status": "failure"})
@@ -287,51 +299,63 @@ func (i *SourceCodeBuildItem) Run(timeout time.Duration) error {
 	if err := i.UpdateBuildVersionInfo(res); err != nil {
 		return err
 	}
+	i.saveBuildCache()
 	return nil
 }
 
-func (i *SourceCodeBuildItem) codeBuild() (*build.Response, error) {
+// codeBuild builds the code, the image is pushed to the image of the version if imageName is empty
+func (i *SourceCodeBuildItem) codeBuild(imageName string) (*build.Response, error) {
 	codeBuild, err := build.GetBuild(code.Lang(i.Lang))
 	if err != nil {
 		logrus.Errorf("get code build error: %s lang %s", err.Error(), i.Lang)
 		i.Logger.Error(util.Translation("No way of compiling to support this source type was found"), map[string]string{"step": "builder-exector", "status": "failure"})
 		return nil, err
 	}
+	buildReq, err := i.buildRequest()
+	if err != nil {
+		return nil, err
+	}
+	buildReq.ImageName = imageName
+	res, err := codeBuild.Build(buildReq)
+	return res, err
+}
+
+func (i *SourceCodeBuildItem) buildRequest() (*build.Request, error) {
 	hostAlias, err := i.getHostAlias()
 	if err != nil {
 		i.Logger.Error(util.Translation("get rbd-repo ip failure"), map[string]string{"step": "builder-exector", "status": "failure"})
 		return nil, err
 	}
 	buildReq := &build.Request{
-		KanikoImage:   i.KanikoImage,
-		RbdNamespace:  i.RbdNamespace,
-		SourceDir:     i.RepoInfo.GetCodeBuildAbsPath(),
-		CacheDir:      i.CacheDir,
-		TGZDir:        i.TGZDir,
-		RepositoryURL: i.RepoInfo.RepostoryURL,
-		CodeSouceInfo: i.CodeSouceInfo,
-		ServiceAlias:  i.ServiceAlias,
-		ServiceID:     i.ServiceID,
-		TenantID:      i.TenantID,
-		ServerType:    i.CodeSouceInfo.ServerType,
-		Runtime:       i.Runtime,
-		Branch:        i.CodeSouceInfo.Branch,
-		DeployVersion: i.DeployVersion,
-		Commit:        build.Commit{User: i.commit.Author, Message: i.commit.Message, Hash: i.commit.Hash},
-		Lang:          code.Lang(i.Lang),
-		BuildEnvs:     i.BuildEnvs,
-		Logger:        i.Logger,
-		ImageClient:   i.ImageClient,
-		KubeClient:    i.KubeClient,
-		HostAlias:     hostAlias,
-		Ctx:           i.Ctx,
-		GRDataPVCName: i.GRDataPVCName,
-		CachePVCName:  i.CachePVCName,
-		CacheMode:     i.CacheMode,
-		CachePath:     i.CachePath,
+		KanikoImage:     i.KanikoImage,
+		CNBBuilderImage: i.CNBBuilderImage,
+		RbdNamespace:    i.RbdNamespace,
+		SourceDir:       i.RepoInfo.GetCodeBuildAbsPath(),
+		CacheDir:        i.CacheDir,
+		TGZDir:          i.TGZDir,
+		RepositoryURL:   i.RepoInfo.RepostoryURL,
+		CodeSouceInfo:   i.CodeSouceInfo,
+		ServiceAlias:    i.ServiceAlias,
+		ServiceID:       i.ServiceID,
+		TenantID:        i.TenantID,
+		ServerType:      i.CodeSouceInfo.ServerType,
+		Runtime:         i.Runtime,
+		Branch:          i.CodeSouceInfo.Branch,
+		DeployVersion:   i.DeployVersion,
+		Commit:          build.Commit{User: i.commit.Author, Message: i.commit.Message, Hash: i.commit.Hash},
+		Lang:            code.Lang(i.Lang),
+		BuildEnvs:       i.BuildEnvs,
+		Logger:          i.Logger,
+		ImageClient:     i.ImageClient,
+		KubeClient:      i.KubeClient,
+		HostAlias:       hostAlias,
+		Ctx:             i.Ctx,
+		GRDataPVCName:   i.GRDataPVCName,
+		CachePVCName:    i.CachePVCName,
+		CacheMode:       i.CacheMode,
+		CachePath:       i.CachePath,
 	}
-	res, err := codeBuild.Build(buildReq)
-	return res, err
+	return buildReq, nil
 }
 
 func (i *SourceCodeBuildItem) getHostAlias() (hostAliasList []build.HostAlias, err error) {
@@ -62,6 +62,7 @@ type Manager interface {
 	GetCurrentConcurrentTask() float64
 	AddTask(*pb.TaskMessage) error
 	SetReturnTaskChan(func(*pb.TaskMessage))
+	SetTaskDoneFunc(func(*pb.TaskMessage))
 	Start() error
 	Stop() error
 	GetImageClient() sources.ImageClient
@@ -136,6 +137,7 @@ type exectorManager struct {
 	EtcdCli           *clientv3.Client
 	tasks             chan *pb.TaskMessage
 	callback          func(*pb.TaskMessage)
+	done              func(*pb.TaskMessage)
 	maxConcurrentTask int
 	mqClient          mqclient.MQClient
 	ctx               context.Context
@@ -169,6 +171,19 @@ func (e *exectorManager) SetReturnTaskChan(re func(*pb.TaskMessage)) {
 	e.callback = re
 }
 
+//SetTaskDoneFunc the func is called when a task is completed, whether it is succeeded or not
+func (e *exectorManager) SetTaskDoneFunc(done func(*pb.TaskMessage)) {
+	e.done = done
+}
+
+func (e *exectorManager) taskDone(task *pb.TaskMessage) {
+	e.runningTask.Delete(task.TaskId)
+	if e.done != nil {
+		e.done(task)
+	}
+	logrus.Infof("Build task %s is completed", task.TaskId)
+}
+
 //TaskType:
 //build_from_image build app from docker image
 //build_from_source_code build app from source code
@@ -208,8 +223,7 @@ func (e *exectorManager) runTask(f func(task *pb.TaskMessage), task *pb.TaskMess
 		defer func() { <-e.tasks }()
 	}
 	f(task)
-	e.runningTask.Delete(task.TaskId)
-	logrus.Infof("Build task %s is completed", task.TaskId)
+	e.taskDone(task)
 }
 func (e *exectorManager) runTaskWithErr(f func(task *pb.TaskMessage) error, task *pb.TaskMessage, concurrencyControl bool) {
 	logrus.Infof("Build task %s in progress", task.TaskId)
@@ -223,8 +237,7 @@ func (e *exectorManager) runTaskWithErr(f func(task *pb.TaskMessage) error, task
 	if err := f(task); err != nil {
 		logrus.Errorf("run builder task failure %s", err.Error())
 	}
-	e.runningTask.Delete(task.TaskId)
-	logrus.Infof("Build task %s is completed", task.TaskId)
+	e.taskDone(task)
 }
 func (e *exectorManager) RunTask(task *pb.TaskMessage) {
 	switch task.TaskType {
@@ -301,7 +314,7 @@ func (e *exectorManager) buildFromImage(task *pb.TaskMessage) {
 		err := i.Run(time.Minute * 30)
 		if err != nil {
 			logrus.Errorf("build from image error: %s", err.Error())
-			if n < 1 {
+			if n < 1 && !isBlockedByScanPolicy(err) {
 				i.Logger.Error("The application task to build from the mirror failed to execute，will try", map[string]string{"step": "build-exector", "status": "failure"})
 			} else {
 				MetricErrorTaskNum++
@@ -309,6 +322,7 @@ func (e *exectorManager) buildFromImage(task *pb.TaskMessage) {
 				if err := i.UpdateVersionInfo("failure"); err != nil {
 					logrus.Debugf("update version Info error: %s", err.Error())
 				}
+				break
 			}
 		} else {
 			var configs = make(map[string]string, len(i.Configs))
@@ -338,6 +352,7 @@ func (e *exectorManager) buildFromSourceCode(task *pb.TaskMessage) {
 	i := NewSouceCodeBuildItem(task.TaskBody)
 	i.ImageClient = e.imageClient
 	i.KanikoImage = e.KanikoImage
+	i.CNBBuilderImage = e.cfg.CNBBuilderImage
 	i.KubeClient = e.KubeClient
 	i.RbdNamespace = e.cfg.RbdNamespace
 	i.RbdRepoName = e.cfg.RbdRepoName
@@ -490,6 +505,7 @@ func (e *exectorManager) sendAction(tenantID, serviceID, eventID, newVersion, ac
 			Topic:    mqclient.WorkerTopic,
 			TaskType: "rolling_upgrade", // TODO(huangrh 20190816): Separate from build
 			TaskBody: body,
+			TenantID: tenantID,
 		}); err != nil {
 			return err
 		}
@@ -560,12 +576,13 @@ func (e *exectorManager) imageShare(task *pb.TaskMessage) {
 		err := i.ShareService()
 		if err != nil {
 			logrus.Errorf("image share error: %s", err.Error())
-			if n < 1 {
+			if n < 1 && !isBlockedByScanPolicy(err) {
 				i.Logger.Error("应用分享失败，开始重试", map[string]string{"step": "builder-exector", "status": "failure"})
 			} else {
 				MetricErrorTaskNum++
 				i.Logger.Error("分享应用任务执行失败", map[string]string{"step": "builder-exector", "status": "failure"})
 				status = "failure"
+				break
 			}
 		} else {
 			status = "success"
@@ -0,0 +1,142 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package exector
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/goodrain/rainbond/builder/scanner"
+	"github.com/goodrain/rainbond/builder/sources"
+	"github.com/goodrain/rainbond/db"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/util"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// the scan status of the build version
+var (
+	scanStatusPassed  = "passed"
+	scanStatusBlocked = "blocked"
+	scanStatusFailure = "failure"
+)
+
+// scanBlockedError the image has vulnerabilities which exceed the image scan policy of the tenant
+type scanBlockedError struct {
+	severity string
+	report   *scanner.Report
+}
+
+func (e *scanBlockedError) Error() string {
+	return fmt.Sprintf("the image %s has vulnerabilities of severity %s or above, it is blocked by the image scan policy", e.report.Image, e.severity)
+}
+
+func isBlockedByScanPolicy(err error) bool {
+	_, ok := err.(*scanBlockedError)
+	return ok
+}
+
+// gateImage scans the image which is in the local image store, and checks the report with the image scan policy
+// of the tenant that the component belongs to. It returns the scan status, empty if the scanner is disabled.
+// The image is not blocked if it fails to be scanned.
+func gateImage(imageClient sources.ImageClient, serviceID, image string, logger event.Logger) (string, *scanner.Report, error) {
+	s := scanner.GetScanner()
+	if s == nil {
+		return "", nil, nil
+	}
+	logger.Info(fmt.Sprintf("start scanning the vulnerabilities of image %s", image), map[string]string{"step": "image-scan"})
+	archive := path.Join(os.TempDir(), fmt.Sprintf("scan-%s.tar", util.NewUUID()))
+	defer os.Remove(archive)
+	if err := imageClient.ImageSave(image, archive); err != nil {
+		logrus.Warningf("save image %s to scan: %v", image, err)
+		logger.Error(fmt.Sprintf("save image %s failure, skip scanning", image), map[string]string{"step": "image-scan"})
+		return scanStatusFailure, nil, nil
+	}
+	report, err := s.ScanArchive(image, archive)
+	if err != nil {
+		logrus.Warningf("scan image %s: %v", image, err)
+		logger.Error(fmt.Sprintf("scan image %s failure: %v, skip scanning", image, err), map[string]string{"step": "image-scan"})
+		return scanStatusFailure, nil, nil
+	}
+	logger.Info(report.String(), map[string]string{"step": "image-scan"})
+	if err := checkScanPolicy(serviceID, report); err != nil {
+		logger.Error(err.Error(), map[string]string{"step": "image-scan", "status": "failure"})
+		return scanStatusBlocked, report, err
+	}
+	return scanStatusPassed, report, nil
+}
+
+// checkScanPolicy returns a scanBlockedError if the report exceeds the image scan policy of the tenant
+func checkScanPolicy(serviceID string, report *scanner.Report) error {
+	component, err := db.GetManager().TenantServiceDao().GetServiceByID(serviceID)
+	if err != nil {
+		logrus.Warningf("get component %s to check the image scan policy: %v", serviceID, err)
+		return nil
+	}
+	policy, err := db.GetManager().TenantImageScanPolicyDao().GetByTenantID(component.TenantID)
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logrus.Warningf("get image scan policy of tenant %s: %v", component.TenantID, err)
+		}
+		return nil
+	}
+	if !policy.Enable {
+		return nil
+	}
+	if report.Exceeds(scanner.ParseSeverity(policy.Severity)) {
+		return &scanBlockedError{severity: policy.Severity, report: report}
+	}
+	return nil
+}
+
+// saveScanResult stores the scan status and the report in the build version
+func saveScanResult(serviceID, deployVersion, status string, report *scanner.Report) {
+	if status == "" {
+		return
+	}
+	version, err := db.GetManager().VersionInfoDao().GetVersionByDeployVersion(deployVersion, serviceID)
+	if err != nil {
+		logrus.Warningf("get build version %s of component %s: %v", deployVersion, serviceID, err)
+		return
+	}
+	version.ScanStatus = status
+	version.ScanReport = ""
+	if report != nil {
+		body, err := json.Marshal(report)
+		if err != nil {
+			logrus.Warningf("marshal scan report: %v", err)
+		} else {
+			version.ScanReport = string(body)
+		}
+	}
+	if err := db.GetManager().VersionInfoDao().UpdateModel(version); err != nil {
+		logrus.Warningf("save scan result of build version %s: %v", deployVersion, err)
+	}
+}
+
+// scanBuildImage scans the image built from the source code which is in the local image store,
+// and saves the result in the build version. It is scanned before it is pushed as the image of the version.
+func (i *SourceCodeBuildItem) scanBuildImage(image string) error {
+	status, report, err := gateImage(i.ImageClient, i.ServiceID, image, i.Logger)
+	saveScanResult(i.ServiceID, i.DeployVersion, status, report)
+	return err
+}
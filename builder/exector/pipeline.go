@@ -0,0 +1,174 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package exector
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/goodrain/rainbond/builder"
+	"github.com/goodrain/rainbond/builder/build"
+	"github.com/goodrain/rainbond/builder/parser/code"
+	"github.com/goodrain/rainbond/builder/scanner"
+	"github.com/goodrain/rainbond/builder/sources"
+	"github.com/goodrain/rainbond/builder/sources/registry"
+	"github.com/sirupsen/logrus"
+)
+
+// getPipeline reads the build pipeline from the rainbondfile of the code.
+// Only the code of git and svn can run the pipeline, it is built directly otherwise.
+func (i *SourceCodeBuildItem) getPipeline() ([]*code.PipelineStage, error) {
+	buildOnly := []*code.PipelineStage{{Name: string(code.StageTypeBuild), Type: code.StageTypeBuild}}
+	if i.CodeSouceInfo.ServerType == "oss" || i.CodeSouceInfo.ServerType == "pkg" {
+		return buildOnly, nil
+	}
+	rbdfile, err := code.ReadRainbondFile(i.RepoInfo.GetCodeBuildAbsPath())
+	if err != nil {
+		if err == code.ErrRainbondFileNotFound {
+			return buildOnly, nil
+		}
+		return nil, err
+	}
+	return rbdfile.GetPipeline()
+}
+
+// runPipeline runs the stages in order. The new version is only delivered if all gating stages pass,
+// a failed stage which allows failure does not stop the pipeline.
+// If there are gating stages after the build stage or the image scanner is enabled, the image is built with
+// a staging image, and it is tagged with the version only after all of them pass.
+// The staging image is deleted from the registry once it is promoted or rejected.
+func (i *SourceCodeBuildItem) runPipeline(stages []*code.PipelineStage) (*build.Response, error) {
+	var res *build.Response
+	image := build.CreateImageName(i.ServiceID, i.DeployVersion)
+	var staging string
+	if hasGateAfterBuild(stages) || scanner.GetScanner() != nil {
+		staging = stagingImageName(i.ServiceID, i.DeployVersion)
+		defer deleteStagingImage(staging)
+	}
+	for _, stage := range stages {
+		step := build.StageStep(stage)
+		if stage.Type == code.StageTypeBuild {
+			var err error
+			res, err = i.codeBuild(staging)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		i.Logger.Info(fmt.Sprintf("start %s stage %s", stage.Type, stage.Name), map[string]string{"step": step, "status": "starting"})
+		buildReq, err := i.buildRequest()
+		if err != nil {
+			return nil, err
+		}
+		stageReq := &build.StageRequest{
+			Request: buildReq,
+			Stage:   stage,
+		}
+		if res != nil {
+			stageReq.BuildImage = res.MediumPath
+		}
+		if err := build.RunStage(stageReq); err != nil {
+			logrus.Warningf("component %s version %s: %v", i.ServiceID, i.DeployVersion, err)
+			if stage.AllowFailure {
+				i.Logger.Error(fmt.Sprintf("stage %s failure, it is allowed to fail and the pipeline continues", stage.Name), map[string]string{"step": step, "status": "failure"})
+				continue
+			}
+			i.Logger.Error(fmt.Sprintf("stage %s failure, the new version will not be delivered", stage.Name), map[string]string{"step": step, "status": "failure"})
+			return nil, err
+		}
+		i.Logger.Info(fmt.Sprintf("stage %s success", stage.Name), map[string]string{"step": step, "status": "success"})
+	}
+	if res != nil && res.MediumType == build.ImageMediumType && res.MediumPath == staging {
+		if err := i.promoteImage(staging, image); err != nil {
+			return nil, err
+		}
+		res.MediumPath = image
+	}
+	return res, nil
+}
+
+// hasGateAfterBuild returns true if there is a stage after the build stage which must pass
+func hasGateAfterBuild(stages []*code.PipelineStage) bool {
+	built := false
+	for _, stage := range stages {
+		if stage.Type == code.StageTypeBuild {
+			built = true
+			continue
+		}
+		if built && !stage.AllowFailure {
+			return true
+		}
+	}
+	return false
+}
+
+// stagingImageName the image which is built before the gating stages pass, it is never delivered.
+// It is in a repository of its own, so deleting its manifest keeps the image of the version which shares the manifest.
+func stagingImageName(serviceID, deployVersion string) string {
+	return build.CreateImageName(serviceID+"-staging", deployVersion)
+}
+
+// deleteStagingImage deletes the staging image from the registry, it is not found if the build failed
+func deleteStagingImage(staging string) {
+	imageInfo := sources.ImageNameHandle(staging)
+	reg, err := registry.NewInsecure(imageInfo.Host, builder.REGISTRYUSER, builder.REGISTRYPASS)
+	if err != nil {
+		logrus.Warningf("new registry client to delete staging image %s: %v", staging, err)
+		return
+	}
+	digest, err := reg.ManifestDigestV2(imageInfo.Name, imageInfo.Tag)
+	if err != nil {
+		if !errors.Is(err, registry.ErrManifestNotFound) {
+			logrus.Warningf("get the digest of staging image %s: %v", staging, err)
+		}
+		return
+	}
+	if err := reg.DeleteManifest(imageInfo.Name, digest); err != nil {
+		logrus.Warningf("delete staging image %s: %v", staging, err)
+		return
+	}
+	logrus.Infof("staging image %s deleted", staging)
+}
+
+// promoteImage scans the staging image, then tags it with the image of the version and pushes it
+func (i *SourceCodeBuildItem) promoteImage(staging, image string) error {
+	if _, err := i.ImageClient.ImagePull(staging, builder.REGISTRYUSER, builder.REGISTRYPASS, i.Logger, 30); err != nil {
+		return fmt.Errorf("pull staging image %s: %v", staging, err)
+	}
+	defer func() {
+		for _, name := range []string{staging, image} {
+			if err := i.ImageClient.ImageRemove(name); err != nil {
+				logrus.Warningf("remove image %s: %v", name, err)
+			}
+		}
+	}()
+	if scanner.GetScanner() != nil {
+		if err := i.scanBuildImage(staging); err != nil {
+			return err
+		}
+	}
+	if err := i.ImageClient.ImageTag(staging, image, i.Logger, 1); err != nil {
+		return fmt.Errorf("tag image %s: %v", image, err)
+	}
+	if err := i.ImageClient.ImagePush(image, builder.REGISTRYUSER, builder.REGISTRYPASS, i.Logger, 30); err != nil {
+		return fmt.Errorf("push image %s: %v", image, err)
+	}
+	i.Logger.Info(fmt.Sprintf("all the gates pass, push image %s success", image), map[string]string{"step": "builder-exector"})
+	return nil
+}
@@ -84,6 +84,13 @@ func (i *ImageShareItem) ShareService() error {
 		i.Logger.Error(fmt.Sprintf("拉取应用镜像: %s失败", i.LocalImageName), map[string]string{"step": "builder-exector", "status": "failure"})
 		return err
 	}
+	// the tag of the local image is the deploy version of the component
+	status, report, err := gateImage(i.ImageClient, i.ServiceID, i.LocalImageName, i.Logger)
+	saveScanResult(i.ServiceID, sources.ImageNameHandle(i.LocalImageName).Tag, status, report)
+	if err != nil {
+		i.Logger.Error("the image is blocked by the image scan policy, it is not shared", map[string]string{"step": "builder-exector", "status": "failure"})
+		return err
+	}
 	if err := i.ImageClient.ImageTag(i.LocalImageName, i.ImageName, i.Logger, 1); err != nil {
 		logrus.Errorf("change image tag error: %s", err.Error())
 		i.Logger.Error(fmt.Sprintf("修改镜像tag: %s -> %s 失败", i.LocalImageName, i.ImageName), map[string]string{"step": "builder-exector", "status": "failure"})
@@ -0,0 +1,83 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package code
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/goodrain/rainbond/util"
+)
+
+var denoConfigFiles = []string{"deno.json", "deno.jsonc"}
+
+var denoEntryFiles = []string{"main.ts", "main.js", "mod.ts", "server.ts", "index.ts"}
+
+func deno(homepath string) Lang {
+	for _, name := range denoConfigFiles {
+		if ok, _ := util.FileExists(path.Join(homepath, name)); ok {
+			return Deno
+		}
+	}
+	return NO
+}
+
+//GetDenoStartArgs the args of deno to start the app, the start task of deno.json or the entry file.
+//It returns nil if neither of them is found.
+func GetDenoStartArgs(buildPath string) []string {
+	for _, name := range denoConfigFiles {
+		body, err := ioutil.ReadFile(path.Join(buildPath, name))
+		if err != nil {
+			continue
+		}
+		// deno.jsonc may contain comments, so the start task is searched instead of being decoded
+		if strings.Contains(string(body), `"start"`) && strings.Contains(string(body), `"tasks"`) {
+			return []string{"task", "start"}
+		}
+	}
+	if entry := GetDenoEntry(buildPath); entry != "" {
+		return []string{"run", "--allow-all", entry}
+	}
+	return nil
+}
+
+//GetDenoEntry the entry file of the deno app
+func GetDenoEntry(buildPath string) string {
+	for _, name := range denoEntryFiles {
+		if ok, _ := util.FileExists(path.Join(buildPath, name)); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// readDenoVersion read the version in .dvmrc or .deno-version
+func readDenoVersion(buildPath string) string {
+	for _, name := range []string{".dvmrc", ".deno-version"} {
+		body, err := ioutil.ReadFile(path.Join(buildPath, name))
+		if err != nil {
+			continue
+		}
+		if version := strings.TrimPrefix(strings.TrimSpace(string(body)), "v"); version != "" {
+			return version
+		}
+	}
+	return ""
+}
@@ -48,6 +48,13 @@ func CheckDependencies(buildPath string, lang Lang) bool {
 
 	case JaveWar, JavaJar:
 		return true
+	case Rust:
+		if ok, _ := util.FileExists(path.Join(buildPath, "Cargo.toml")); ok {
+			return true
+		}
+		return false
+	case DotNet:
+		return len(ListDotNetProjects(buildPath)) > 0
 	case Nodejs:
 		if ok, _ := util.FileExists(path.Join(buildPath, "package.json ")); ok {
 			return true
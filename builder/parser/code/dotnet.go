@@ -0,0 +1,159 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package code
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//DotNetProject a SDK-style project file of .NET
+type DotNetProject struct {
+	// the path relative to the build path
+	Path         string
+	Sdk          string
+	AssemblyName string
+	OutputType   string
+	// such as net8.0
+	TargetFramework string
+}
+
+type dotnetProjectFile struct {
+	Sdk            string `xml:"Sdk,attr"`
+	PropertyGroups []struct {
+		AssemblyName     string `xml:"AssemblyName"`
+		OutputType       string `xml:"OutputType"`
+		TargetFramework  string `xml:"TargetFramework"`
+		TargetFrameworks string `xml:"TargetFrameworks"`
+	} `xml:"PropertyGroup"`
+}
+
+// dotnetFrameworkRegexp the target frameworks of .NET 5 and later, such as net8.0,
+// but not the ones of .NET Core (netcoreapp3.1) or .NET Framework (net48)
+var dotnetFrameworkRegexp = regexp.MustCompile(`^net\d+\.\d+`)
+
+// dotnetProjectDepth the depth to search the project files, the projects of a solution are usually in the sub directories
+const dotnetProjectDepth = 2
+
+//ListDotNetProjects list the SDK-style projects which target .NET 5 and later,
+//the projects of .NET Core are built as .NetCore
+func ListDotNetProjects(buildPath string) []*DotNetProject {
+	var projects []*DotNetProject
+	for _, file := range findProjectFiles(buildPath, dotnetProjectDepth) {
+		body, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		var pf dotnetProjectFile
+		if err := xml.Unmarshal(body, &pf); err != nil || !strings.HasPrefix(pf.Sdk, "Microsoft.NET.Sdk") {
+			continue
+		}
+		rel, _ := filepath.Rel(buildPath, file)
+		project := &DotNetProject{
+			Path:         filepath.ToSlash(rel),
+			Sdk:          pf.Sdk,
+			AssemblyName: strings.TrimSuffix(path.Base(file), path.Ext(file)),
+		}
+		for _, pg := range pf.PropertyGroups {
+			if pg.AssemblyName != "" {
+				project.AssemblyName = pg.AssemblyName
+			}
+			if pg.OutputType != "" {
+				project.OutputType = pg.OutputType
+			}
+			if pg.TargetFramework != "" {
+				project.TargetFramework = pg.TargetFramework
+			} else if pg.TargetFrameworks != "" && project.TargetFramework == "" {
+				project.TargetFramework = strings.Split(pg.TargetFrameworks, ";")[0]
+			}
+		}
+		if dotnetFrameworkRegexp.MatchString(project.TargetFramework) {
+			projects = append(projects, project)
+		}
+	}
+	return projects
+}
+
+//GetDotNetStartupProject the project to publish, the web project is preferred
+func GetDotNetStartupProject(buildPath string) *DotNetProject {
+	projects := ListDotNetProjects(buildPath)
+	for _, project := range projects {
+		if project.IsWeb() {
+			return project
+		}
+	}
+	for _, project := range projects {
+		if strings.EqualFold(project.OutputType, "Exe") {
+			return project
+		}
+	}
+	if len(projects) > 0 {
+		return projects[0]
+	}
+	return nil
+}
+
+//IsWeb the project is an ASP.NET Core project
+func (p *DotNetProject) IsWeb() bool {
+	return strings.HasPrefix(p.Sdk, "Microsoft.NET.Sdk.Web")
+}
+
+//Version the version of .NET, such as 8.0
+func (p *DotNetProject) Version() string {
+	return strings.TrimPrefix(p.TargetFramework, "net")
+}
+
+func findProjectFiles(dir string, depth int) []string {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var files, subdirs []string
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() {
+			if depth > 0 && !strings.HasPrefix(name, ".") && name != "bin" && name != "obj" {
+				subdirs = append(subdirs, name)
+			}
+			continue
+		}
+		if info.Mode()&os.ModeType == 0 && (path.Ext(name) == ".csproj" || path.Ext(name) == ".fsproj") {
+			files = append(files, path.Join(dir, name))
+		}
+	}
+	sort.Strings(files)
+	for _, sub := range subdirs {
+		files = append(files, findProjectFiles(path.Join(dir, sub), depth-1)...)
+	}
+	return files
+}
+
+//dotnet the SDK-style projects of .NET 5 and later, the others are .NetCore
+func dotnet(homepath string) Lang {
+	if len(ListDotNetProjects(homepath)) > 0 {
+		return DotNet
+	}
+	return NO
+}
@@ -27,6 +27,9 @@ import (
 
 func init() {
 	checkFuncList = append(checkFuncList, dockerfile)
+	checkFuncList = append(checkFuncList, cnb)
+	checkFuncList = append(checkFuncList, rust)
+	checkFuncList = append(checkFuncList, deno)
 	checkFuncList = append(checkFuncList, javaJar)
 	checkFuncList = append(checkFuncList, javaWar)
 	checkFuncList = append(checkFuncList, javaMaven)
@@ -41,6 +44,7 @@ func init() {
 	checkFuncList = append(checkFuncList, gradle)
 	checkFuncList = append(checkFuncList, grails)
 	checkFuncList = append(checkFuncList, scala)
+	checkFuncList = append(checkFuncList, dotnet)
 	checkFuncList = append(checkFuncList, netcore)
 }
 
@@ -115,6 +119,18 @@ var Grails Lang = "Grails"
 //NetCore Lang
 var NetCore Lang = ".NetCore"
 
+//DotNet Lang, the SDK-style projects of .NET 5 and later
+var DotNet Lang = ".NET"
+
+//Rust Lang
+var Rust Lang = "Rust"
+
+//Deno Lang
+var Deno Lang = "Deno"
+
+//CNB Lang, the code is built by Cloud Native Buildpacks, whatever the language of it is
+var CNB Lang = "CNB"
+
 //OSS Lang
 var OSS Lang = "OSS"
 
@@ -148,6 +164,14 @@ func dockerfile(homepath string) Lang {
 	}
 	return Dockerfile
 }
+
+//cnb the project descriptor of Cloud Native Buildpacks
+func cnb(homepath string) Lang {
+	if ok, _ := util.FileExists(path.Join(homepath, "project.toml")); ok {
+		return CNB
+	}
+	return NO
+}
 func python(homepath string) Lang {
 	if ok, _ := util.FileExists(path.Join(homepath, "requirements.txt")); ok {
 		return Python
@@ -0,0 +1,144 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package code
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "lang")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(path.Dir(path.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestGetLangType(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		lang     Lang
+		runtimes string
+		err      error
+	}{
+		{
+			name: "rust",
+			files: map[string]string{
+				"Cargo.toml":          "[package]\nname = \"demo\"\nrust-version = \"1.70\"\n",
+				"rust-toolchain.toml": "[toolchain]\nchannel = \"1.75.0\"\n",
+			},
+			lang:     Rust,
+			runtimes: "1.75.0",
+		},
+		{
+			name:     "rust without toolchain",
+			files:    map[string]string{"Cargo.toml": "[package]\nname = \"demo\"\nrust-version = \"1.70\"\n"},
+			lang:     Rust,
+			runtimes: "1.70",
+		},
+		{
+			name: "deno before node",
+			files: map[string]string{
+				"deno.json":    `{"tasks": {"start": "deno run -A main.ts"}}`,
+				"package.json": `{}`,
+				".dvmrc":       "v1.40.0\n",
+			},
+			lang:     Deno,
+			runtimes: "1.40.0",
+		},
+		{
+			name: ".NET 8 solution",
+			files: map[string]string{
+				"demo.sln":                 "",
+				"src/Api/Api.csproj":       `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>net8.0</TargetFramework></PropertyGroup></Project>`,
+				"src/Lib/Lib.csproj":       `<Project Sdk="Microsoft.NET.Sdk"><PropertyGroup><TargetFramework>net8.0</TargetFramework></PropertyGroup></Project>`,
+				"tests/Api.Tests/a.csproj": `<Project Sdk="Microsoft.NET.Sdk"><PropertyGroup><TargetFrameworks>net8.0;net6.0</TargetFrameworks></PropertyGroup></Project>`,
+			},
+			lang:     DotNet,
+			runtimes: "8.0",
+		},
+		{
+			name: "buildpacks project descriptor",
+			files: map[string]string{
+				"project.toml": "[_]\nschema-version = \"0.2\"\n",
+				"mix.exs":      "",
+			},
+			lang: CNB,
+		},
+		{
+			name:  "legacy .NET Core",
+			files: map[string]string{"demo.csproj": `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>netcoreapp2.2</TargetFramework></PropertyGroup></Project>`},
+			lang:  NetCore,
+		},
+		{
+			name:     ".NET 5",
+			files:    map[string]string{"demo.csproj": `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>net5.0</TargetFramework></PropertyGroup></Project>`},
+			lang:     DotNet,
+			runtimes: "5.0",
+		},
+		{
+			name:     ".NET 9",
+			files:    map[string]string{"demo.csproj": `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>net9.0</TargetFramework></PropertyGroup></Project>`},
+			lang:     DotNet,
+			runtimes: "9.0",
+		},
+		{
+			name:  ".NET without the official images",
+			files: map[string]string{"demo.csproj": `<Project Sdk="Microsoft.NET.Sdk.Web"><PropertyGroup><TargetFramework>net10.0</TargetFramework></PropertyGroup></Project>`},
+			lang:  DotNet,
+			err:   ErrRuntimeNotSupport,
+		},
+	}
+	for _, tc := range tests {
+		dir := writeFiles(t, tc.files)
+		lang, err := GetLangType(dir)
+		if err != nil || lang != tc.lang {
+			t.Errorf("%s: expected lang %s, but got %s %v", tc.name, tc.lang, lang, err)
+		}
+		runtime, err := CheckRuntime(dir, lang)
+		if err != tc.err || runtime["RUNTIMES"] != tc.runtimes {
+			t.Errorf("%s: expected runtimes %q, but got %v %v", tc.name, tc.runtimes, runtime, err)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGetDotNetStartupProject(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"Worker/Worker.csproj": `<Project Sdk="Microsoft.NET.Sdk.Worker"><PropertyGroup><OutputType>Exe</OutputType><TargetFramework>net8.0</TargetFramework><AssemblyName>worker</AssemblyName></PropertyGroup></Project>`,
+		"Lib/Lib.csproj":       `<Project Sdk="Microsoft.NET.Sdk"><PropertyGroup><TargetFramework>net8.0</TargetFramework></PropertyGroup></Project>`,
+	})
+	defer os.RemoveAll(dir)
+	project := GetDotNetStartupProject(dir)
+	if project == nil || project.Path != "Worker/Worker.csproj" || project.AssemblyName != "worker" || project.IsWeb() {
+		t.Fatalf("unexpected startup project %+v", project)
+	}
+}
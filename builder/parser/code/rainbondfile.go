@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path"
+	"regexp"
 
 	"github.com/goodrain/rainbond/util"
 	"github.com/sirupsen/logrus"
@@ -36,6 +37,95 @@ type RainbondFileConfig struct {
 	Envs      map[string]interface{} `yaml:"envs"`
 	Cmd       string                 `yaml:"cmd"`
 	Services  []*Service             `yaml:"services"`
+	// Pipeline the ordered stages of the build, the code is only built by default
+	Pipeline []*PipelineStage `yaml:"pipeline"`
+}
+
+//StageType the type of the pipeline stage
+type StageType string
+
+//StageTypeTest run the unit tests
+var StageTypeTest StageType = "test"
+
+//StageTypeLint lint the code
+var StageTypeLint StageType = "lint"
+
+//StageTypeBuild build the code into the image, it is the build of the component language
+var StageTypeBuild StageType = "build"
+
+//StageTypeScan scan the built image, the name of the image is in the env BUILD_IMAGE
+var StageTypeScan StageType = "scan"
+
+//StageTypeScript run a custom script
+var StageTypeScript StageType = "script"
+
+//PipelineStage a stage of the build pipeline, it runs as a builder job except the build stage
+type PipelineStage struct {
+	Name string    `yaml:"name"`
+	Type StageType `yaml:"type"`
+	// the image to run the script
+	Image  string            `yaml:"image"`
+	Script string            `yaml:"script"`
+	Envs   map[string]string `yaml:"envs"`
+	// seconds, default 1800
+	Timeout int `yaml:"timeout"`
+	// the failure of the stage does not stop the pipeline, so the stage is not a gate
+	AllowFailure bool `yaml:"allow_failure"`
+}
+
+//DefaultStageTimeout the default timeout seconds of a pipeline stage
+var DefaultStageTimeout = 1800
+
+var stageNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+//GetPipeline check the pipeline and return the ordered stages.
+//The build stage is appended if it is not defined.
+func (r *RainbondFileConfig) GetPipeline() ([]*PipelineStage, error) {
+	var stages []*PipelineStage
+	names := make(map[string]bool)
+	var built bool
+	for _, stage := range r.Pipeline {
+		if !stageNameRegexp.MatchString(stage.Name) || len(stage.Name) > 32 {
+			return nil, fmt.Errorf("invalid stage name %q, it should be a lowercase RFC 1123 label no longer than 32", stage.Name)
+		}
+		if names[stage.Name] {
+			return nil, fmt.Errorf("duplicate stage name %s", stage.Name)
+		}
+		names[stage.Name] = true
+		switch stage.Type {
+		case StageTypeBuild:
+			if built {
+				return nil, fmt.Errorf("stage %s: only one build stage is allowed", stage.Name)
+			}
+			if stage.AllowFailure {
+				return nil, fmt.Errorf("stage %s: the build stage can not allow failure", stage.Name)
+			}
+			built = true
+		case StageTypeScan:
+			if !built {
+				return nil, fmt.Errorf("stage %s: the scan stage should be after the build stage", stage.Name)
+			}
+			fallthrough
+		case StageTypeTest, StageTypeLint, StageTypeScript:
+			if stage.Image == "" || stage.Script == "" {
+				return nil, fmt.Errorf("stage %s: image and script are required", stage.Name)
+			}
+		default:
+			return nil, fmt.Errorf("stage %s: unknown stage type %q", stage.Name, stage.Type)
+		}
+		if stage.Timeout <= 0 {
+			stage.Timeout = DefaultStageTimeout
+		}
+		stages = append(stages, stage)
+	}
+	if !built {
+		name := string(StageTypeBuild)
+		if names[name] {
+			return nil, fmt.Errorf("stage name build is reserved for the build stage")
+		}
+		stages = append(stages, &PipelineStage{Name: name, Type: StageTypeBuild})
+	}
+	return stages, nil
 }
 
 // Service contains
@@ -29,3 +29,44 @@ func TestReadRainbondFile(t *testing.T) {
 	}
 	t.Log(rbdfile)
 }
+
+func TestGetPipeline(t *testing.T) {
+	rbdfile, err := ReadRainbondFile("./")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stages, err := rbdfile.GetPipeline()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stages) != 4 || stages[2].Type != StageTypeBuild || stages[0].Timeout != DefaultStageTimeout {
+		t.Fatalf("unexpected stages %+v", stages)
+	}
+
+	tests := []struct {
+		name     string
+		pipeline []*PipelineStage
+		stages   int
+		wantErr  bool
+	}{
+		{name: "no pipeline", stages: 1},
+		{name: "append build", pipeline: []*PipelineStage{{Name: "test", Type: StageTypeTest, Image: "golang", Script: "go test ./..."}}, stages: 2},
+		{name: "scan before build", pipeline: []*PipelineStage{{Name: "scan", Type: StageTypeScan, Image: "trivy", Script: "trivy"}}, wantErr: true},
+		{name: "two builds", pipeline: []*PipelineStage{{Name: "a", Type: StageTypeBuild}, {Name: "b", Type: StageTypeBuild}}, wantErr: true},
+		{name: "duplicate name", pipeline: []*PipelineStage{{Name: "a", Type: StageTypeBuild}, {Name: "a", Type: StageTypeLint, Image: "alpine", Script: "true"}}, wantErr: true},
+		{name: "invalid name", pipeline: []*PipelineStage{{Name: "Unit Test", Type: StageTypeTest, Image: "golang", Script: "go test ./..."}}, wantErr: true},
+		{name: "no script", pipeline: []*PipelineStage{{Name: "lint", Type: StageTypeLint, Image: "alpine"}}, wantErr: true},
+		{name: "unknown type", pipeline: []*PipelineStage{{Name: "deploy", Type: "deploy"}}, wantErr: true},
+	}
+	for _, tc := range tests {
+		rbdfile := &RainbondFileConfig{Pipeline: tc.pipeline}
+		stages, err := rbdfile.GetPipeline()
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", tc.name, tc.wantErr, err)
+			continue
+		}
+		if err == nil && len(stages) != tc.stages {
+			t.Errorf("%s: expected %d stages, but got %d", tc.name, tc.stages, len(stages))
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path"
+	"regexp"
 	"strings"
 
 	simplejson "github.com/bitly/go-simplejson"
@@ -52,6 +53,12 @@ func CheckRuntime(buildPath string, lang Lang) (map[string]string, error) {
 		return runtime, nil
 	case Static:
 		return map[string]string{"RUNTIMES_SERVER": "nginx"}, nil
+	case Rust:
+		return readRustRuntimeInfo(buildPath)
+	case DotNet:
+		return readDotNetRuntimeInfo(buildPath)
+	case Deno:
+		return readDenoRuntimeInfo(buildPath)
 	default:
 		return nil, nil
 	}
@@ -152,6 +159,52 @@ func readJavaRuntimeInfo(buildPath string) (map[string]string, error) {
 	return runtimeInfo, nil
 }
 
+//rustVersionRegexp the version of stable rust, such as 1.75 or 1.75.0
+var rustVersionRegexp = regexp.MustCompile(`^1\.\d+(\.\d+)?$`)
+
+func readRustRuntimeInfo(buildPath string) (map[string]string, error) {
+	var runtimeInfo = make(map[string]string, 1)
+	version := readRustToolchain(buildPath)
+	if version == "" {
+		if manifest, err := ReadCargoManifest(buildPath); err == nil {
+			version = manifest.Package.RustVersion
+		}
+	}
+	if version == "" || version == "stable" {
+		return runtimeInfo, nil
+	}
+	// only the stable releases have the official images
+	if !rustVersionRegexp.MatchString(version) {
+		return nil, ErrRuntimeNotSupport
+	}
+	runtimeInfo["RUNTIMES"] = version
+	return runtimeInfo, nil
+}
+
+//DotNetVersions the supported versions of .NET, which have the sdk and runtime images in mcr.microsoft.com/dotnet
+var DotNetVersions = []string{"5.0", "6.0", "7.0", "8.0", "9.0"}
+
+func readDotNetRuntimeInfo(buildPath string) (map[string]string, error) {
+	var runtimeInfo = make(map[string]string, 1)
+	project := GetDotNetStartupProject(buildPath)
+	if project == nil {
+		return runtimeInfo, nil
+	}
+	if !util.StringArrayContains(DotNetVersions, project.Version()) {
+		return nil, ErrRuntimeNotSupport
+	}
+	runtimeInfo["RUNTIMES"] = project.Version()
+	return runtimeInfo, nil
+}
+
+func readDenoRuntimeInfo(buildPath string) (map[string]string, error) {
+	var runtimeInfo = make(map[string]string, 1)
+	if version := readDenoVersion(buildPath); version != "" {
+		runtimeInfo["RUNTIMES"] = version
+	}
+	return runtimeInfo, nil
+}
+
 func readNodeRuntimeInfo(buildPath string) (map[string]string, error) {
 	var runtimeInfo = make(map[string]string, 1)
 	if ok, _ := util.FileExists(path.Join(buildPath, "package.json")); !ok {
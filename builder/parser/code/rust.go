@@ -0,0 +1,90 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package code
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/goodrain/rainbond/util"
+)
+
+//CargoManifest the fields of Cargo.toml used by the build
+type CargoManifest struct {
+	Package struct {
+		Name        string `toml:"name"`
+		RustVersion string `toml:"rust-version"`
+	} `toml:"package"`
+	Bins []struct {
+		Name string `toml:"name"`
+	} `toml:"bin"`
+}
+
+//ReadCargoManifest read Cargo.toml in the build path
+func ReadCargoManifest(buildPath string) (*CargoManifest, error) {
+	body, err := ioutil.ReadFile(path.Join(buildPath, "Cargo.toml"))
+	if err != nil {
+		return nil, err
+	}
+	var manifest CargoManifest
+	if err := toml.Unmarshal(body, &manifest); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+//Binary the name of the binary to run, the first [[bin]] or the package name
+func (c *CargoManifest) Binary() string {
+	if len(c.Bins) > 0 && c.Bins[0].Name != "" {
+		return c.Bins[0].Name
+	}
+	return c.Package.Name
+}
+
+func rust(homepath string) Lang {
+	if ok, _ := util.FileExists(path.Join(homepath, "Cargo.toml")); ok {
+		return Rust
+	}
+	return NO
+}
+
+// readRustToolchain read the channel of rust-toolchain.toml or rust-toolchain,
+// rust-toolchain is a plain channel name in the legacy format.
+func readRustToolchain(buildPath string) string {
+	for _, name := range []string{"rust-toolchain.toml", "rust-toolchain"} {
+		body, err := ioutil.ReadFile(path.Join(buildPath, name))
+		if err != nil {
+			continue
+		}
+		var toolchain struct {
+			Toolchain struct {
+				Channel string `toml:"channel"`
+			} `toml:"toolchain"`
+		}
+		if err := toml.Unmarshal(body, &toolchain); err == nil && toolchain.Toolchain.Channel != "" {
+			return toolchain.Toolchain.Channel
+		}
+		if channel := strings.TrimSpace(string(body)); channel != "" && !strings.Contains(channel, "\n") {
+			return channel
+		}
+	}
+	return ""
+}
@@ -47,6 +47,8 @@ func init() {
 	specification[NodeJSStatic] = nodeCheck
 	specification[Nodejs] = nodeCheck
 	specification[Golang] = golangCheck
+	specification[Rust] = rustCheck
+	specification[Deno] = denoCheck
 }
 
 //CheckCodeSpecification 检查语言规范
@@ -180,3 +182,30 @@ func nodeCheck(buildPath string) Specification {
 func golangCheck(buildPath string) Specification {
 	return common()
 }
+
+func rustCheck(buildPath string) Specification {
+	manifest, err := ReadCargoManifest(buildPath)
+	if err != nil {
+		return Specification{
+			Conform:   false,
+			Noconform: map[string]string{"识别为Rust语言，Cargo.toml文件格式有误": "检查Cargo.toml文件是否可以被cargo正常解析"},
+		}
+	}
+	if manifest.Binary() == "" {
+		return Specification{
+			Conform:   false,
+			Noconform: map[string]string{"识别为Rust语言，Cargo.toml未定义可执行程序": "在[package]中定义name，或使用[[bin]]定义可执行程序；workspace项目请在子项目目录构建"},
+		}
+	}
+	return common()
+}
+
+func denoCheck(buildPath string) Specification {
+	if GetDenoStartArgs(buildPath) == nil {
+		return Specification{
+			Conform:   false,
+			Noconform: map[string]string{"识别为Deno语言，未发现启动方式": "在deno.json中定义start任务，或提供main.ts等入口文件"},
+		}
+	}
+	return common()
+}
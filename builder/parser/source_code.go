@@ -312,14 +312,14 @@ func (d *SourceCodeParse) Parse() ParseErrorList {
 			} else if err == code.ErrCodeNotExist {
 				d.errappend(ErrorAndSolve(FatalError, "仓库中代码不存在", "请提交代码到仓库"))
 			} else {
-				d.errappend(ErrorAndSolve(FatalError, "代码无法识别语言类型", "请参考文档查看平台语言支持规范"))
+				d.errappend(ErrorAndSolve(FatalError, "代码无法识别语言类型", "请参考文档查看平台语言支持规范，或在rainbondfile中指定language为CNB，使用Cloud Native Buildpacks构建"))
 			}
 			return d.errors
 		}
 	}
 	d.Lang = lang
 	if lang == code.NO {
-		d.errappend(ErrorAndSolve(FatalError, "代码无法识别语言类型", "请参考文档查看平台语言支持规范"))
+		d.errappend(ErrorAndSolve(FatalError, "代码无法识别语言类型", "请参考文档查看平台语言支持规范，或在rainbondfile中指定language为CNB，使用Cloud Native Buildpacks构建"))
 		return d.errors
 	}
 	//check code Specification
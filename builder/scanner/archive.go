@@ -0,0 +1,276 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package scanner
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// the files of the image which tell the os and the installed packages
+var (
+	osReleaseFiles = []string{"etc/os-release", "usr/lib/os-release"}
+	dpkgStatusFile = "var/lib/dpkg/status"
+	// the status files of the distroless images
+	dpkgStatusDir     = "var/lib/dpkg/status.d/"
+	apkInstalledFile  = "lib/apk/db/installed"
+	maxBufferedEntry  = int64(4 << 20)
+	whiteoutPrefix    = ".wh."
+	whiteoutOpaqueDir = ".wh..wh..opq"
+)
+
+//ImageContent the os and the packages installed in the image
+type ImageContent struct {
+	OS        string
+	OSVersion string
+	Packages  []*Package
+}
+
+// layer the files of interest and the whiteouts in an image layer
+type layer struct {
+	files     map[string][]byte
+	whiteouts []string
+	opaques   []string
+}
+
+//ReadImageArchive reads the os and the packages from the image archive,
+//both the format of docker save(manifest.json) and the OCI image layout(index.json) are supported.
+//The layers are applied in order, the files deleted by the upper layers are ignored.
+func ReadImageArchive(archive string) (*ImageContent, error) {
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	// the metadata of the archive, such as manifest.json, index.json and the blobs of manifests
+	metadata := make(map[string][]byte)
+	layers := make(map[string]*layer)
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read image archive: %v", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(hdr.Name)
+		if hdr.Size <= maxBufferedEntry {
+			body, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			if json.Valid(body) {
+				metadata[name] = body
+				continue
+			}
+			if l, ok := readLayer(bytes.NewReader(body)); ok {
+				layers[name] = l
+			}
+			continue
+		}
+		if l, ok := readLayer(tr); ok {
+			layers[name] = l
+		}
+	}
+	order, err := layerOrder(metadata)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	for _, name := range order {
+		l, ok := layers[path.Clean(name)]
+		if !ok {
+			return nil, fmt.Errorf("layer %s is not found in the image archive", name)
+		}
+		l.applyTo(files)
+	}
+	return parseImageContent(files), nil
+}
+
+// readLayer reads the files of interest of the layer, the layer may be compressed by gzip.
+// It returns false if the reader is not a layer.
+func readLayer(r io.Reader) (*layer, bool) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, false
+		}
+		defer gr.Close()
+		r = gr
+	} else {
+		r = br
+	}
+	l := &layer{files: make(map[string][]byte)}
+	tr := tar.NewReader(r)
+	for i := 0; ; i++ {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return l, i > 0
+		}
+		if err != nil {
+			// the upper layers are still useful even if the layer is broken
+			return l, i > 0
+		}
+		name := strings.TrimPrefix(path.Clean("/"+hdr.Name), "/")
+		dir, base := path.Split(name)
+		if base == whiteoutOpaqueDir {
+			l.opaques = append(l.opaques, dir)
+			continue
+		}
+		if strings.HasPrefix(base, whiteoutPrefix) {
+			l.whiteouts = append(l.whiteouts, dir+strings.TrimPrefix(base, whiteoutPrefix))
+			continue
+		}
+		if hdr.Typeflag != tar.TypeReg || !interestedFile(name) {
+			continue
+		}
+		body, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return l, true
+		}
+		l.files[name] = body
+	}
+}
+
+func interestedFile(name string) bool {
+	for _, f := range osReleaseFiles {
+		if name == f {
+			return true
+		}
+	}
+	return name == dpkgStatusFile || name == apkInstalledFile ||
+		(strings.HasPrefix(name, dpkgStatusDir) && !strings.HasSuffix(name, ".md5sums"))
+}
+
+func (l *layer) applyTo(files map[string][]byte) {
+	for name := range files {
+		for _, dir := range l.opaques {
+			if strings.HasPrefix(name, dir) {
+				delete(files, name)
+			}
+		}
+		for _, removed := range l.whiteouts {
+			if name == removed || strings.HasPrefix(name, removed+"/") {
+				delete(files, name)
+			}
+		}
+	}
+	for name, body := range l.files {
+		files[name] = body
+	}
+}
+
+type dockerManifest struct {
+	Layers []string `json:"Layers"`
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+	} `json:"platform,omitempty"`
+}
+
+type ociIndex struct {
+	Manifests []ociDescriptor `json:"manifests"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// layerOrder the layers of the image from the bottom to the top
+func layerOrder(metadata map[string][]byte) ([]string, error) {
+	if body, ok := metadata["manifest.json"]; ok {
+		var manifests []dockerManifest
+		if err := json.Unmarshal(body, &manifests); err == nil && len(manifests) > 0 {
+			return manifests[0].Layers, nil
+		}
+	}
+	body, ok := metadata["index.json"]
+	// the index may be nested, the manifest of the linux image is used
+	for depth := 0; ok && depth < 3; depth++ {
+		var index ociIndex
+		if err := json.Unmarshal(body, &index); err != nil {
+			return nil, fmt.Errorf("parse oci index: %v", err)
+		}
+		if len(index.Layers) > 0 {
+			var order []string
+			for _, desc := range index.Layers {
+				order = append(order, blobPath(desc.Digest))
+			}
+			return order, nil
+		}
+		if len(index.Manifests) == 0 {
+			break
+		}
+		desc := index.Manifests[0]
+		for _, m := range index.Manifests {
+			if m.Platform != nil && m.Platform.OS == "linux" && m.Platform.Architecture == "amd64" {
+				desc = m
+				break
+			}
+		}
+		body, ok = metadata[blobPath(desc.Digest)]
+	}
+	return nil, fmt.Errorf("the manifest of the image is not found in the archive")
+}
+
+func blobPath(digest string) string {
+	return path.Join("blobs", strings.Replace(digest, ":", "/", 1))
+}
+
+func parseImageContent(files map[string][]byte) *ImageContent {
+	content := &ImageContent{}
+	for _, name := range osReleaseFiles {
+		if body, ok := files[name]; ok {
+			content.OS, content.OSVersion = parseOSRelease(body)
+			break
+		}
+	}
+	if body, ok := files[apkInstalledFile]; ok {
+		content.Packages = append(content.Packages, parseApkInstalled(body)...)
+	}
+	if body, ok := files[dpkgStatusFile]; ok {
+		content.Packages = append(content.Packages, parseDpkgStatus(body)...)
+	}
+	for name, body := range files {
+		if strings.HasPrefix(name, dpkgStatusDir) {
+			content.Packages = append(content.Packages, parseDpkgStatus(body)...)
+		}
+	}
+	sort.Slice(content.Packages, func(i, j int) bool {
+		return content.Packages[i].Name < content.Packages[j].Name
+	})
+	return content
+}
@@ -0,0 +1,117 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//Advisory a vulnerability of a package in the database.
+//The package is vulnerable if it is older than the fixed version, or no fixed version is given.
+type Advisory struct {
+	ID string `json:"id"`
+	// the id of the os-release: debian, ubuntu, alpine...
+	OS string `json:"os"`
+	// the version of the os, the advisory applies to all versions of the os if it is empty
+	OSVersion    string   `json:"os_version,omitempty"`
+	Package      string   `json:"package"`
+	FixedVersion string   `json:"fixed_version,omitempty"`
+	Severity     Severity `json:"severity"`
+	Title        string   `json:"title,omitempty"`
+}
+
+//Database the vulnerability advisories indexed by the os and the package
+type Database struct {
+	advisories map[string][]*Advisory
+	count      int
+}
+
+//LoadDatabase loads the advisories from a json file, or all json files of a directory.
+//Every file is an array of advisories, so the database can be updated offline by replacing the files.
+func LoadDatabase(dbPath string) (*Database, error) {
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{dbPath}
+	if info.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(dbPath, "*.json")); err != nil {
+			return nil, err
+		}
+	}
+	db := &Database{advisories: make(map[string][]*Advisory)}
+	for _, file := range files {
+		body, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var advisories []*Advisory
+		if err := json.Unmarshal(body, &advisories); err != nil {
+			return nil, fmt.Errorf("parse %s: %v", file, err)
+		}
+		for _, adv := range advisories {
+			if adv.ID == "" || adv.OS == "" || adv.Package == "" {
+				continue
+			}
+			key := advisoryKey(adv.OS, adv.Package)
+			db.advisories[key] = append(db.advisories[key], adv)
+			db.count++
+		}
+	}
+	return db, nil
+}
+
+//Len the number of the advisories
+func (d *Database) Len() int {
+	return d.count
+}
+
+// find the advisories of the package or its source package
+func (d *Database) find(os, osVersion string, pkg *Package) []*Advisory {
+	names := []string{pkg.Name}
+	if pkg.Source != "" && pkg.Source != pkg.Name {
+		names = append(names, pkg.Source)
+	}
+	var result []*Advisory
+	seen := make(map[string]bool)
+	for _, name := range names {
+		for _, adv := range d.advisories[advisoryKey(os, name)] {
+			if seen[adv.ID] || !matchOSVersion(adv.OSVersion, osVersion) {
+				continue
+			}
+			seen[adv.ID] = true
+			result = append(result, adv)
+		}
+	}
+	return result
+}
+
+func advisoryKey(os, pkg string) string {
+	return strings.ToLower(os) + "/" + pkg
+}
+
+// matchOSVersion the version 3.18 of the advisory matches the os version 3.18.4
+func matchOSVersion(want, got string) bool {
+	return want == "" || want == got || strings.HasPrefix(got, want+".")
+}
@@ -0,0 +1,117 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+//Package a package installed in the image
+type Package struct {
+	Name    string
+	Version string
+	// the source package of debian, the origin of alpine
+	Source string
+}
+
+// parseOSRelease returns the ID and the VERSION_ID of the os-release
+func parseOSRelease(body []byte) (id, version string) {
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		kv := strings.SplitN(strings.TrimSpace(scanner.Text()), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := strings.Trim(kv[1], `"'`)
+		switch kv[0] {
+		case "ID":
+			id = value
+		case "VERSION_ID":
+			version = value
+		}
+	}
+	return
+}
+
+// parseDpkgStatus parses the packages which are installed in the status file of dpkg
+func parseDpkgStatus(body []byte) []*Package {
+	var packages []*Package
+	for _, paragraph := range strings.Split(string(body), "\n\n") {
+		fields := make(map[string]string)
+		for _, line := range strings.Split(paragraph, "\n") {
+			// skip the continuation lines of the multi-line fields
+			if line == "" || line[0] == ' ' || line[0] == '\t' {
+				continue
+			}
+			kv := strings.SplitN(line, ":", 2)
+			if len(kv) == 2 {
+				fields[kv[0]] = strings.TrimSpace(kv[1])
+			}
+		}
+		if fields["Package"] == "" || fields["Version"] == "" {
+			continue
+		}
+		// the status file of distroless images has no Status field
+		if status, ok := fields["Status"]; ok && !strings.HasSuffix(status, " installed") {
+			continue
+		}
+		pkg := &Package{Name: fields["Package"], Version: fields["Version"]}
+		// Source: name (version), the version is given if it differs from the binary package
+		if source := strings.Fields(fields["Source"]); len(source) > 0 {
+			pkg.Source = source[0]
+		}
+		packages = append(packages, pkg)
+	}
+	return packages
+}
+
+// parseApkInstalled parses the installed database of apk
+func parseApkInstalled(body []byte) []*Package {
+	var packages []*Package
+	pkg := &Package{}
+	flush := func() {
+		if pkg.Name != "" && pkg.Version != "" {
+			packages = append(packages, pkg)
+		}
+		pkg = &Package{}
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		if len(line) < 2 || line[1] != ':' {
+			continue
+		}
+		switch line[0] {
+		case 'P':
+			pkg.Name = line[2:]
+		case 'V':
+			pkg.Version = line[2:]
+		case 'o':
+			pkg.Source = line[2:]
+		}
+	}
+	flush()
+	return packages
+}
@@ -0,0 +1,244 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package scanner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//Severity the severity of the vulnerability
+type Severity string
+
+var (
+	//SeverityUnknown the severity is not given by the database
+	SeverityUnknown Severity = "UNKNOWN"
+	//SeverityLow -
+	SeverityLow Severity = "LOW"
+	//SeverityMedium -
+	SeverityMedium Severity = "MEDIUM"
+	//SeverityHigh -
+	SeverityHigh Severity = "HIGH"
+	//SeverityCritical -
+	SeverityCritical Severity = "CRITICAL"
+)
+
+var severityRanks = map[Severity]int{
+	SeverityUnknown:  0,
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+//ParseSeverity parses the severity case-insensitively, the unknown one is SeverityUnknown
+func ParseSeverity(s string) Severity {
+	severity := Severity(strings.ToUpper(strings.TrimSpace(s)))
+	if _, ok := severityRanks[severity]; !ok {
+		return SeverityUnknown
+	}
+	return severity
+}
+
+//AtLeast returns true if the severity is not lower than the other
+func (s Severity) AtLeast(other Severity) bool {
+	return severityRanks[s] >= severityRanks[other]
+}
+
+//Vulnerability a vulnerability found in a package of the image
+type Vulnerability struct {
+	ID               string   `json:"id"`
+	Package          string   `json:"package"`
+	InstalledVersion string   `json:"installed_version"`
+	FixedVersion     string   `json:"fixed_version,omitempty"`
+	Severity         Severity `json:"severity"`
+	Title            string   `json:"title,omitempty"`
+}
+
+//Report the result of the image scanning
+type Report struct {
+	Image           string           `json:"image"`
+	OS              string           `json:"os"`
+	OSVersion       string           `json:"os_version"`
+	Packages        int              `json:"packages"`
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities"`
+	Summary         map[Severity]int `json:"summary"`
+	ScanTime        time.Time        `json:"scan_time"`
+}
+
+//Exceeds returns true if there is a vulnerability of the severity or above
+func (r *Report) Exceeds(threshold Severity) bool {
+	for _, v := range r.Vulnerabilities {
+		if v.Severity.AtLeast(threshold) {
+			return true
+		}
+	}
+	return false
+}
+
+//String the summary of the report
+func (r *Report) String() string {
+	var counts []string
+	for _, severity := range []Severity{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityUnknown} {
+		if n := r.Summary[severity]; n > 0 {
+			counts = append(counts, fmt.Sprintf("%s: %d", severity, n))
+		}
+	}
+	if len(counts) == 0 {
+		return fmt.Sprintf("no vulnerability is found in %d packages of %s %s", r.Packages, r.OS, r.OSVersion)
+	}
+	return fmt.Sprintf("%d vulnerabilities are found in %d packages of %s %s (%s)", len(r.Vulnerabilities), r.Packages, r.OS, r.OSVersion, strings.Join(counts, ", "))
+}
+
+//Scanner scans the packages of the image against the vulnerability database
+type Scanner struct {
+	dbPath   string
+	lock     sync.Mutex
+	db       *Database
+	modified time.Time
+}
+
+var defaultScanner *Scanner
+
+//Init enables the scanner with the vulnerability database in the path, it is a json file or a directory of them.
+//The scanner is disabled if the path is empty.
+func Init(dbPath string) error {
+	if dbPath == "" {
+		logrus.Info("the vulnerability database is not configured, image scanning is disabled")
+		return nil
+	}
+	s := &Scanner{dbPath: dbPath}
+	if _, err := s.database(); err != nil {
+		return err
+	}
+	defaultScanner = s
+	return nil
+}
+
+//GetScanner returns nil if the scanner is disabled
+func GetScanner() *Scanner {
+	return defaultScanner
+}
+
+// database reloads the database if it is updated offline
+func (s *Scanner) database() (*Database, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	modified, err := lastModified(s.dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("stat vulnerability database: %v", err)
+	}
+	if s.db != nil && !modified.After(s.modified) {
+		return s.db, nil
+	}
+	db, err := LoadDatabase(s.dbPath)
+	if err != nil {
+		if s.db != nil {
+			logrus.Warningf("reload vulnerability database: %v, keep using the old one", err)
+			return s.db, nil
+		}
+		return nil, err
+	}
+	logrus.Infof("load %d vulnerability advisories from %s", db.Len(), s.dbPath)
+	s.db, s.modified = db, modified
+	return db, nil
+}
+
+//ScanArchive scans the image archive which is saved by docker or containerd
+func (s *Scanner) ScanArchive(image, archive string) (*Report, error) {
+	db, err := s.database()
+	if err != nil {
+		return nil, err
+	}
+	content, err := ReadImageArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+	report := db.Match(content)
+	report.Image = image
+	return report, nil
+}
+
+// Match finds the vulnerabilities of the packages
+func (d *Database) Match(content *ImageContent) *Report {
+	report := &Report{
+		OS:        content.OS,
+		OSVersion: content.OSVersion,
+		Packages:  len(content.Packages),
+		Summary:   make(map[Severity]int),
+		ScanTime:  time.Now(),
+	}
+	for _, pkg := range content.Packages {
+		for _, adv := range d.find(content.OS, content.OSVersion, pkg) {
+			if adv.FixedVersion != "" && CompareVersion(pkg.Version, adv.FixedVersion) >= 0 {
+				continue
+			}
+			report.Vulnerabilities = append(report.Vulnerabilities, &Vulnerability{
+				ID:               adv.ID,
+				Package:          pkg.Name,
+				InstalledVersion: pkg.Version,
+				FixedVersion:     adv.FixedVersion,
+				Severity:         ParseSeverity(string(adv.Severity)),
+				Title:            adv.Title,
+			})
+		}
+	}
+	sort.SliceStable(report.Vulnerabilities, func(i, j int) bool {
+		vi, vj := report.Vulnerabilities[i], report.Vulnerabilities[j]
+		if vi.Severity != vj.Severity {
+			return !vj.Severity.AtLeast(vi.Severity)
+		}
+		if vi.ID != vj.ID {
+			return vi.ID < vj.ID
+		}
+		return vi.Package < vj.Package
+	})
+	for _, v := range report.Vulnerabilities {
+		report.Summary[v.Severity]++
+	}
+	return report
+}
+
+func lastModified(dbPath string) (time.Time, error) {
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !info.IsDir() {
+		return info.ModTime(), nil
+	}
+	modified := info.ModTime()
+	files, err := filepath.Glob(filepath.Join(dbPath, "*.json"))
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil && info.ModTime().After(modified) {
+			modified = info.ModTime()
+		}
+	}
+	return modified, nil
+}
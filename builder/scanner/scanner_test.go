@@ -0,0 +1,154 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package scanner
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.0", b: "1.0", want: 0},
+		{a: "1.0", b: "1.1", want: -1},
+		{a: "1.10", b: "1.9", want: 1},
+		{a: "1:1.0", b: "2.0", want: 1},
+		{a: "1.0~rc1", b: "1.0", want: -1},
+		{a: "1.0-1", b: "1.0-1+deb12u1", want: -1},
+		{a: "3.0.11-1~deb12u2", b: "3.0.11-1~deb12u1", want: 1},
+		{a: "1.2.3-r4", b: "1.2.3-r10", want: -1},
+		{a: "1.0a", b: "1.0", want: 1},
+		{a: "007", b: "7", want: 0},
+	}
+	for _, tc := range tests {
+		if got := CompareVersion(tc.a, tc.b); got != tc.want {
+			t.Errorf("CompareVersion(%q, %q): expected %d, but got %d", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
+
+func tarball(t *testing.T, gz bool, files map[string]string) []byte {
+	var buf bytes.Buffer
+	var tw *tar.Writer
+	var gw *gzip.Writer
+	if gz {
+		gw = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(gw)
+	} else {
+		tw = tar.NewWriter(&buf)
+	}
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if gw != nil {
+		if err := gw.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+var dpkgStatus = `Package: libssl3
+Status: install ok installed
+Source: openssl (3.0.9-1)
+Version: 3.0.9-1
+Description: Secure Sockets Layer toolkit
+ multi-line description
+
+Package: zlib1g
+Status: install ok installed
+Source: zlib
+Version: 1:1.2.13.dfsg-1
+
+Package: removed
+Status: deinstall ok config-files
+Version: 1.0
+`
+
+func TestScanArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scanner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	base := tarball(t, true, map[string]string{
+		"etc/os-release":       "ID=debian\nVERSION_ID=\"12\"\n",
+		"var/lib/dpkg/status":  dpkgStatus,
+		"lib/apk/db/installed": "P:busybox\nV:1.36.1-r0\n",
+	})
+	// the upper layer removes the apk database
+	upper := tarball(t, false, map[string]string{
+		"lib/apk/db/.wh.installed": "",
+	})
+	archive := path.Join(dir, "image.tar")
+	if err := ioutil.WriteFile(archive, tarball(t, false, map[string]string{
+		"manifest.json":   `[{"Config":"config.json","Layers":["base/layer.tar","upper/layer.tar"]}]`,
+		"base/layer.tar":  string(base),
+		"upper/layer.tar": string(upper),
+	}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dbFile := path.Join(dir, "db.json")
+	if err := ioutil.WriteFile(dbFile, []byte(`[
+		{"id": "CVE-2023-0001", "os": "debian", "os_version": "12", "package": "openssl", "fixed_version": "3.0.11-1~deb12u1", "severity": "high"},
+		{"id": "CVE-2023-0002", "os": "debian", "package": "zlib1g", "fixed_version": "1:1.2.13.dfsg-1", "severity": "CRITICAL"},
+		{"id": "CVE-2023-0003", "os": "debian", "os_version": "11", "package": "libssl3", "severity": "CRITICAL"},
+		{"id": "CVE-2023-0004", "os": "alpine", "package": "busybox", "severity": "CRITICAL"},
+		{"id": "CVE-2023-0005", "os": "debian", "package": "zlib", "severity": "low"}
+	]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s := &Scanner{dbPath: dbFile}
+	report, err := s.ScanArchive("demo:latest", archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.OS != "debian" || report.OSVersion != "12" || report.Packages != 2 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if len(report.Vulnerabilities) != 2 {
+		t.Fatalf("expected 2 vulnerabilities, but got %+v", report.Vulnerabilities)
+	}
+	if v := report.Vulnerabilities[0]; v.ID != "CVE-2023-0001" || v.Package != "libssl3" || v.Severity != SeverityHigh {
+		t.Errorf("unexpected vulnerability %+v", v)
+	}
+	if v := report.Vulnerabilities[1]; v.ID != "CVE-2023-0005" || v.Package != "zlib1g" || v.Severity != SeverityLow {
+		t.Errorf("unexpected vulnerability %+v", v)
+	}
+	if !report.Exceeds(SeverityHigh) || report.Exceeds(SeverityCritical) {
+		t.Errorf("unexpected threshold check of %s", report)
+	}
+}
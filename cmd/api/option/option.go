@@ -20,6 +20,7 @@ package option
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
@@ -63,6 +64,8 @@ type Config struct {
 	RbdNamespace           string
 	ShowSQL                bool
 	GrctlImage             string
+	//AuditLogRetention the audit logs older than it are deleted, they are kept forever if it is 0
+	AuditLogRetention time.Duration
 }
 
 //APIServer  apiserver server
@@ -116,6 +119,7 @@ func (a *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&a.PrometheusEndpoint, "prom-api", "rbd-monitor:9999", "The service DNS name of Prometheus api. Default to rbd-monitor:9999")
 	fs.StringVar(&a.RbdNamespace, "rbd-namespace", "rbd-system", "rbd component namespace")
 	fs.BoolVar(&a.ShowSQL, "show-sql", false, "The trigger for showing sql.")
+	fs.DurationVar(&a.AuditLogRetention, "audit-log-retention", 180*24*time.Hour, "the audit logs older than it are deleted, 0 to keep them forever")
 	fs.StringVar(&a.GrctlImage, "shell-image", "registry.cn-hangzhou.aliyuncs.com/goodrain/rbd-shell:v5.10.0-release", "use shell image")
 }
 
@@ -39,6 +39,7 @@ type Config struct {
 	ClusterName          string
 	MysqlConnectionInfo  string
 	KanikoImage          string
+	CNBBuilderImage      string
 	DBType               string
 	PrometheusMetricPath string
 	EventLogServers      []string
@@ -59,6 +60,13 @@ type Config struct {
 	CachePath            string
 	ContainerRuntime     string
 	RuntimeEndpoint      string
+	BuildCacheBackend    string
+	BuildCacheDir        string
+	BuildCacheEndpoint   string
+	BuildCacheAccessKey  string
+	BuildCacheSecretKey  string
+	BuildCacheBucket     string
+	VulnDBPath           string
 }
 
 //Builder  builder server
@@ -84,6 +92,7 @@ func (a *Builder) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&a.EtcdPrefix, "etcd-prefix", "/store", "the etcd data save key prefix ")
 	fs.StringVar(&a.PrometheusMetricPath, "metric", "/metrics", "prometheus metrics path")
 	fs.StringVar(&a.KanikoImage, "kaniko-image", "registry.cn-hangzhou.aliyuncs.com/goodrain/kaniko-executor:latest", "kaniko image version")
+	fs.StringVar(&a.CNBBuilderImage, "cnb-builder-image", "paketobuildpacks/builder-jammy-base:latest", "the default builder image of Cloud Native Buildpacks, it can be overridden by the build env CNB_BUILDER of the component")
 	fs.StringVar(&a.DBType, "db-type", "mysql", "db type mysql or etcd")
 	fs.StringVar(&a.MysqlConnectionInfo, "mysql", "root:admin@tcp(127.0.0.1:3306)/region", "mysql db connection info")
 	fs.StringSliceVar(&a.EventLogServers, "event-servers", []string{"127.0.0.1:6366"}, "event log server address. simple lb")
@@ -105,6 +114,13 @@ func (a *Builder) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&a.CachePath, "cache-path", "/cache", "volume cache mount path, when cache-mode using hostpath, default path is /cache")
 	fs.StringVar(&a.ContainerRuntime, "container-runtime", sources.ContainerRuntimeContainerd, "container runtime, support docker and containerd")
 	fs.StringVar(&a.RuntimeEndpoint, "runtime-endpoint", sources.RuntimeEndpointContainerd, "container runtime endpoint")
+	fs.StringVar(&a.BuildCacheBackend, "build-cache-backend", "", "the backend to persist the build cache, can be local, s3 and alioss, the build cache is not persisted by default")
+	fs.StringVar(&a.BuildCacheDir, "build-cache-dir", "/grdata/build/cache-archives", "the directory to store the build cache archives when build-cache-backend is local")
+	fs.StringVar(&a.BuildCacheEndpoint, "build-cache-endpoint", "", "the object storage endpoint of the build cache")
+	fs.StringVar(&a.BuildCacheAccessKey, "build-cache-access-key", "", "the access key of the object storage of the build cache")
+	fs.StringVar(&a.BuildCacheSecretKey, "build-cache-secret-key", "", "the secret key of the object storage of the build cache")
+	fs.StringVar(&a.BuildCacheBucket, "build-cache-bucket", "rbd-build-cache", "the bucket of the object storage of the build cache")
+	fs.StringVar(&a.VulnDBPath, "vuln-db-path", "", "the vulnerability database to scan the images, a json file or a directory of them, the images are not scanned if it is empty")
 }
 
 //SetLog 设置log
@@ -23,9 +23,11 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/goodrain/rainbond/builder/buildcache"
 	"github.com/goodrain/rainbond/builder/discover"
 	"github.com/goodrain/rainbond/builder/exector"
 	"github.com/goodrain/rainbond/builder/monitor"
+	"github.com/goodrain/rainbond/builder/scanner"
 	"github.com/goodrain/rainbond/cmd/builder/option"
 	"github.com/goodrain/rainbond/db"
 	"github.com/goodrain/rainbond/db/config"
@@ -69,6 +71,19 @@ func Run(s *option.Builder) error {
 		return err
 	}
 	defer event.CloseManager()
+	if err := buildcache.InitBuildCache(&buildcache.Config{
+		Backend:    s.Config.BuildCacheBackend,
+		LocalDir:   s.Config.BuildCacheDir,
+		Endpoint:   s.Config.BuildCacheEndpoint,
+		AccessKey:  s.Config.BuildCacheAccessKey,
+		SecretKey:  s.Config.BuildCacheSecretKey,
+		BucketName: s.Config.BuildCacheBucket,
+	}); err != nil {
+		return err
+	}
+	if err := scanner.Init(s.Config.VulnDBPath); err != nil {
+		return err
+	}
 	client, err := client.NewMqClient(etcdClientArgs, s.Config.MQAPI)
 	if err != nil {
 		logrus.Errorf("new Mq client error, %v", err)
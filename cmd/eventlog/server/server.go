@@ -109,6 +109,11 @@ func (s *LogServer) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&s.Conf.EventStore.DB.PoolSize, "db.pool.size", 3, "Data persistence db pool init size.")
 	fs.IntVar(&s.Conf.EventStore.DB.PoolMaxSize, "db.pool.maxsize", 10, "Data persistence db pool max size.")
 	fs.StringVar(&s.Conf.EventStore.DB.HomePath, "docker.log.homepath", "/grdata/logs/", "container log persistent home path")
+	fs.StringVar(&s.Conf.EventStore.DB.Compression, "docker.log.compression", "gzip", "the compression of the rotated container log files, gzip or zstd")
+	fs.IntVar(&s.Conf.EventStore.LogRetention.MaxAgeDays, "docker.log.retention.max-age", 0, "the days to retain the container logs by default, DOCKER_LOG_SAVE_DAY or 7 days is used if it is 0")
+	fs.Int64Var(&s.Conf.EventStore.LogRetention.MaxComponentSize, "docker.log.retention.component-max-size", 0, "the max size(MB) of the container logs of a component by default, 0 means unlimited")
+	fs.Int64Var(&s.Conf.EventStore.LogRetention.DiskBudget, "docker.log.retention.disk-budget", 0, "the max size(MB) of the container logs of all components, the oldest logs are removed beyond it, 0 means unlimited")
+	fs.DurationVar(&s.Conf.EventStore.LogRetention.Interval, "docker.log.retention.interval", time.Hour, "the interval to remove the container logs beyond the retention")
 	fs.StringVar(&s.Conf.Entry.NewMonitorMessageServerConf.ListenerHost, "monitor.udp.host", "0.0.0.0", "receive new monitor udp server host")
 	fs.IntVar(&s.Conf.Entry.NewMonitorMessageServerConf.ListenerPort, "monitor.udp.port", 6166, "receive new monitor udp server port")
 	fs.StringVar(&s.Conf.Cluster.Discover.NodeID, "node-id", "", "the unique ID for this node.")
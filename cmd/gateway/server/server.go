@@ -40,6 +40,7 @@ import (
 	"github.com/goodrain/rainbond/gateway/cluster"
 	"github.com/goodrain/rainbond/gateway/controller"
 	"github.com/goodrain/rainbond/gateway/metric"
+	"github.com/goodrain/rainbond/mq/client"
 	"github.com/goodrain/rainbond/util"
 
 	etcdutil "github.com/goodrain/rainbond/util/etcd"
@@ -97,7 +98,13 @@ func Run(s *option.GWServer) error {
 	}
 	mc.Start()
 
-	gwc, err := controller.NewGWController(ctx, clientset, &s.Config, mc, node)
+	mqClient, err := client.NewMqClient(etcdClientArgs, s.Config.MQAPI)
+	if err != nil {
+		return fmt.Errorf("create mq client: %v", err)
+	}
+	defer mqClient.Close()
+
+	gwc, err := controller.NewGWController(ctx, clientset, &s.Config, mc, node, mqClient)
 	if err != nil {
 		return err
 	}
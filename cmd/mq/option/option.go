@@ -36,6 +36,12 @@ type Config struct {
 	RunMode              string //http grpc
 	HostIP               string
 	HostName             string
+	//seconds before a leased task is redelivered
+	VisibilityTimeout int
+	//leased times before a task is moved to the dead letter topic
+	MaxDeliveries int
+	//tenant id -> weight of the fair dequeue, the default weight is 1
+	TenantWeights map[string]int
 }
 
 //MQServer lb worker server
@@ -63,6 +69,9 @@ func (a *MQServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&a.PrometheusMetricPath, "metric", "/metrics", "prometheus metrics path")
 	fs.StringVar(&a.HostIP, "hostIP", "", "Current node Intranet IP")
 	fs.StringVar(&a.HostName, "hostName", "", "Current node host name")
+	fs.IntVar(&a.VisibilityTimeout, "visibility-timeout", 60, "the default seconds before a leased task is redelivered if it is not acked")
+	fs.IntVar(&a.MaxDeliveries, "max-deliveries", 5, "the max delivery times of a leased task, then it is moved to the dead letter topic")
+	fs.StringToIntVar(&a.TenantWeights, "tenant-weights", nil, "the weights of tenants sharing a topic, such as tenant_id1=2,tenant_id2=3, the default weight is 1")
 }
 
 //SetLog 设置log
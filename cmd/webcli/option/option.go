@@ -20,7 +20,9 @@ package option
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/goodrain/rainbond/builder/cloudos"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 )
@@ -38,6 +40,14 @@ type Config struct {
 	SessionKey           string
 	PrometheusMetricPath string
 	K8SConfPath          string
+	//the sessions are recorded in the directory, and they are not recorded if it is empty
+	RecordingDir string
+	//local, s3 or alioss, the finished recordings are uploaded to the object storage if it is not local
+	RecordingStorage  string
+	RecordingS3       cloudos.Config
+	RecordingS3Prefix string
+	ReadOnlyTenants   []string
+	SessionAPIToken   string
 }
 
 //WebCliServer container webcli server
@@ -64,6 +74,16 @@ func (a *WebCliServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&a.K8SConfPath, "kube-conf", "", "absolute path to the kubeconfig file")
 	fs.IntVar(&a.Port, "port", 7171, "server listen port")
 	fs.StringVar(&a.PrometheusMetricPath, "metric", "/metrics", "prometheus metrics path")
+	fs.StringVar(&a.RecordingDir, "recording-dir", "/grdata/webcli/recordings", "the directory to record the terminal sessions, the sessions are not recorded if it is empty")
+	fs.StringVar(&a.RecordingStorage, "recording-storage", "local", "where the recordings are stored: local, s3 or alioss")
+	fs.StringVar(&a.RecordingS3.Endpoint, "recording-s3-endpoint", "", "the endpoint of the object storage of the recordings")
+	fs.StringVar(&a.RecordingS3.AccessKey, "recording-s3-access-key", "", "the access key of the object storage of the recordings")
+	fs.StringVar(&a.RecordingS3.SecretKey, "recording-s3-secret-key", os.Getenv("RECORDING_S3_SECRET_KEY"), "the secret key of the object storage of the recordings")
+	fs.StringVar(&a.RecordingS3.BucketName, "recording-s3-bucket", "", "the bucket of the recordings")
+	fs.BoolVar(&a.RecordingS3.UseSSL, "recording-s3-use-ssl", false, "access the object storage of the recordings with ssl")
+	fs.StringVar(&a.RecordingS3Prefix, "recording-s3-prefix", "webcli/recordings", "the key prefix of the recordings in the object storage")
+	fs.StringSliceVar(&a.ReadOnlyTenants, "readonly-tenants", nil, "the namespaces of the tenants whose terminals are read-only, * for all tenants")
+	fs.StringVar(&a.SessionAPIToken, "session-api-token", os.Getenv("SESSION_API_TOKEN"), "the token to list and replay the sessions, the session api is disabled if it is empty")
 }
 
 //SetLog 设置log
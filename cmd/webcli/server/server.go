@@ -19,11 +19,13 @@
 package server
 
 import (
+	"fmt"
 	"os"
 	"os/signal"
 	"strconv"
 	"syscall"
 
+	"github.com/goodrain/rainbond/builder/cloudos"
 	"github.com/goodrain/rainbond/cmd/webcli/option"
 	"github.com/goodrain/rainbond/discover"
 	"github.com/goodrain/rainbond/webcli/app"
@@ -40,6 +42,17 @@ func Run(s *option.WebCliServer) error {
 	option.Port = strconv.Itoa(s.Port)
 	option.SessionKey = s.SessionKey
 	option.K8SConfPath = s.K8SConfPath
+	option.ReadOnlyTenants = s.ReadOnlyTenants
+	option.SessionAPIToken = s.SessionAPIToken
+	if s.RecordingDir != "" {
+		store, err := newRecordingStore(s)
+		if err != nil {
+			return fmt.Errorf("create recording store: %v", err)
+		}
+		option.RecordingStore = store
+	} else {
+		logrus.Warning("the recording dir is not set, the terminal sessions are not recorded")
+	}
 	ap, err := app.New(&option)
 	if err != nil {
 		return err
@@ -75,3 +88,20 @@ func Run(s *option.WebCliServer) error {
 	logrus.Info("See you next time!")
 	return nil
 }
+
+func newRecordingStore(s *option.WebCliServer) (app.RecordingStore, error) {
+	if s.RecordingStorage == "local" {
+		return app.NewLocalRecordingStore(s.RecordingDir)
+	}
+	provider, err := cloudos.Str2S3Provider(s.RecordingStorage)
+	if err != nil {
+		return nil, err
+	}
+	cfg := s.RecordingS3
+	cfg.ProviderType = provider
+	oss, err := cloudos.New(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	return app.NewObjectRecordingStore(s.RecordingDir, s.RecordingS3Prefix, oss)
+}
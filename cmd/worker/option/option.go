@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
@@ -55,7 +56,9 @@ type Config struct {
 	LeaderElectionIdentity  string
 	RBDNamespace            string
 	GrdataPVCName           string
+	PrometheusAPI           string
 	Helm                    Helm
+	ACME                    ACME
 }
 
 // Helm helm configuration.
@@ -66,6 +69,20 @@ type Helm struct {
 	ChartCache string
 }
 
+// ACME the configuration of the automatic certificates of the http rules
+type ACME struct {
+	// DirectoryURL the directory url of the ACME server
+	DirectoryURL string
+	// Email the contact of the ACME account
+	Email string
+	// RenewBefore the certificates are renewed before they expire in the duration
+	RenewBefore time.Duration
+	// DNSProvider the name of the provider which creates the TXT records of the dns-01 challenges
+	DNSProvider string
+	// DNSConfigFile the json file of the provider config
+	DNSConfigFile string
+}
+
 //Worker  worker server
 type Worker struct {
 	Config
@@ -105,7 +122,13 @@ func (a *Worker) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&a.LeaderElectionIdentity, "leader-election-identity", "", "Unique idenity of this attcher. Typically name of the pod where the attacher runs.")
 	fs.StringVar(&a.RBDNamespace, "rbd-system-namespace", "rbd-system", "rbd components kubernetes namespace")
 	fs.StringVar(&a.GrdataPVCName, "grdata-pvc-name", "rbd-cpt-grdata", "The name of grdata persistent volume claim")
+	fs.StringVar(&a.PrometheusAPI, "prom-api", "rbd-monitor:9999", "The service DNS name of Prometheus api, used by the post-deploy analysis and the idle policy")
 	fs.StringVar(&a.Helm.DataDir, "/grdata/helm", "/grdata/helm", "The data directory of Helm.")
+	fs.StringVar(&a.ACME.DirectoryURL, "acme-directory", "https://acme-v02.api.letsencrypt.org/directory", "The directory url of the ACME server which issues the certificates of the auto TLS http rules")
+	fs.StringVar(&a.ACME.Email, "acme-email", "", "The contact email of the ACME account")
+	fs.DurationVar(&a.ACME.RenewBefore, "acme-renew-before", 30*24*time.Hour, "The auto TLS certificates are renewed before they expire in the duration")
+	fs.StringVar(&a.ACME.DNSProvider, "acme-dns-provider", "", "The dns provider of the dns-01 challenges, such as webhook")
+	fs.StringVar(&a.ACME.DNSConfigFile, "acme-dns-config", "", "The json file of the dns provider config")
 	a.Helm.RepoFile = path.Join(a.Helm.DataDir, "repo/repositories.yaml")
 	a.Helm.RepoCache = path.Join(a.Helm.DataDir, "cache")
 	a.Helm.ChartCache = path.Join(a.Helm.DataDir, "chart")
@@ -512,6 +512,7 @@ type RuleExtensionDao interface {
 	DeleteRuleExtensionByRuleID(ruleID string) error
 	DeleteByRuleIDs(ruleIDs []string) error
 	CreateOrUpdateRuleExtensionsInBatch(exts []*model.RuleExtension) error
+	ListByKey(key string) ([]*model.RuleExtension, error)
 }
 
 // HTTPRuleDao -
@@ -614,6 +615,65 @@ type TenantServiceScalingRecordsDao interface {
 	CountByServiceID(serviceID string) (int, error)
 }
 
+// TenantServiceScalingSchedulesDao -
+type TenantServiceScalingSchedulesDao interface {
+	Dao
+	GetByScheduleID(scheduleID string) (*model.TenantServiceScalingSchedules, error)
+	ListByServiceID(serviceID string) ([]*model.TenantServiceScalingSchedules, error)
+	ListEnableOnes() ([]*model.TenantServiceScalingSchedules, error)
+	DeleteByScheduleID(scheduleID string) error
+	DeleteByServiceID(serviceID string) error
+}
+
+// TenantServiceIdlePolicyDao -
+type TenantServiceIdlePolicyDao interface {
+	Dao
+	GetByServiceID(serviceID string) (*model.TenantServiceIdlePolicy, error)
+	ListEnableOnes() ([]*model.TenantServiceIdlePolicy, error)
+	// Wake marks the idle component as active, it returns false if the component is not idle.
+	Wake(serviceID string) (bool, error)
+	DeleteByServiceID(serviceID string) error
+}
+
+// TenantImageScanPolicyDao -
+type TenantImageScanPolicyDao interface {
+	Dao
+	GetByTenantID(tenantID string) (*model.TenantImageScanPolicy, error)
+	DeleteByTenantID(tenantID string) error
+}
+
+// TenantLogRetentionPolicyDao -
+type TenantLogRetentionPolicyDao interface {
+	Dao
+	GetByTenantID(tenantID string) (*model.TenantLogRetentionPolicy, error)
+	List() ([]*model.TenantLogRetentionPolicy, error)
+	DeleteByTenantID(tenantID string) error
+}
+
+// TenantServiceCanaryReleaseDao -
+type TenantServiceCanaryReleaseDao interface {
+	Dao
+	GetByServiceID(serviceID string) (*model.TenantServiceCanaryRelease, error)
+	DeleteByServiceID(serviceID string) error
+	ListDueReleases(now time.Time) ([]*model.TenantServiceCanaryRelease, error)
+}
+
+// TenantServiceReleaseAnalysisDao -
+type TenantServiceReleaseAnalysisDao interface {
+	Dao
+	GetByServiceID(serviceID string) (*model.TenantServiceReleaseAnalysis, error)
+	DeleteByServiceID(serviceID string) error
+}
+
+// TenantServiceReleaseHealthRecordsDao -
+type TenantServiceReleaseHealthRecordsDao interface {
+	Dao
+	ListByServiceID(serviceID string, offset, limit int) ([]*model.TenantServiceReleaseHealthRecords, error)
+	CountByServiceID(serviceID string) (int, error)
+	ListByStatus(status string) ([]*model.TenantServiceReleaseHealthRecords, error)
+	DeleteByServiceID(serviceID string) error
+}
+
 // TenantServiceMonitorDao -
 type TenantServiceMonitorDao interface {
 	Dao
@@ -634,6 +694,16 @@ type ComponentK8sAttributeDao interface {
 	DeleteByComponentIDs(componentIDs []string) error
 }
 
+// AuditLogDao -
+type AuditLogDao interface {
+	Dao
+	ListAuditLogs(query *model.AuditLogQuery, offset, limit int) ([]*model.AuditLog, int, error)
+	// IterateAuditLogs calls fn with the audit logs in batches, from the oldest to the latest
+	IterateAuditLogs(query *model.AuditLogQuery, batchSize int, fn func(logs []*model.AuditLog) error) error
+	// DeleteAuditLogsBefore deletes the audit logs created before the time, and returns the number of them
+	DeleteAuditLogsBefore(before time.Time) (int64, error)
+}
+
 // K8sResourceDao -
 type K8sResourceDao interface {
 	Dao
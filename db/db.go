@@ -136,12 +136,26 @@ type Manager interface {
 	TenantServceAutoscalerRuleMetricsDaoTransactions(db *gorm.DB) dao.TenantServceAutoscalerRuleMetricsDao
 	TenantServiceScalingRecordsDao() dao.TenantServiceScalingRecordsDao
 	TenantServiceScalingRecordsDaoTransactions(db *gorm.DB) dao.TenantServiceScalingRecordsDao
+	TenantServiceScalingSchedulesDao() dao.TenantServiceScalingSchedulesDao
+	TenantServiceScalingSchedulesDaoTransactions(db *gorm.DB) dao.TenantServiceScalingSchedulesDao
+	TenantServiceIdlePolicyDao() dao.TenantServiceIdlePolicyDao
+	TenantServiceIdlePolicyDaoTransactions(db *gorm.DB) dao.TenantServiceIdlePolicyDao
+	TenantImageScanPolicyDao() dao.TenantImageScanPolicyDao
+	TenantLogRetentionPolicyDao() dao.TenantLogRetentionPolicyDao
+	TenantServiceCanaryReleaseDao() dao.TenantServiceCanaryReleaseDao
+	TenantServiceCanaryReleaseDaoTransactions(db *gorm.DB) dao.TenantServiceCanaryReleaseDao
+	TenantServiceReleaseAnalysisDao() dao.TenantServiceReleaseAnalysisDao
+	TenantServiceReleaseAnalysisDaoTransactions(db *gorm.DB) dao.TenantServiceReleaseAnalysisDao
+	TenantServiceReleaseHealthRecordsDao() dao.TenantServiceReleaseHealthRecordsDao
+	TenantServiceReleaseHealthRecordsDaoTransactions(db *gorm.DB) dao.TenantServiceReleaseHealthRecordsDao
 
 	TenantServiceMonitorDao() dao.TenantServiceMonitorDao
 	TenantServiceMonitorDaoTransactions(db *gorm.DB) dao.TenantServiceMonitorDao
 
 	ComponentK8sAttributeDao() dao.ComponentK8sAttributeDao
 	ComponentK8sAttributeDaoTransactions(db *gorm.DB) dao.ComponentK8sAttributeDao
+
+	AuditLogDao() dao.AuditLogDao
 }
 
 var defaultManager Manager
@@ -0,0 +1,395 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goodrain/rainbond/db/model"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+//lockName the name of the mysql lock held while migrating
+const lockName = "rainbond_region_schema_migration"
+
+//lockTimeout seconds to wait for the other components migrating the database
+const lockTimeout = 300
+
+//Statements the sql statements of a migration by db type: mysql, cockroachdb or sqlite.
+//The statements of "" are used for the db types without their own.
+type Statements map[string][]string
+
+//Migration a versioned change of the schema or the data of the region database
+type Migration struct {
+	Version     int
+	Description string
+	Up          Statements
+	//Down undoes Up. It may be nil if there is nothing to undo,
+	//such as a data backfill or a wider column which works with the older versions as well.
+	Down Statements
+	//Requires the versions the migration depends on. The failure of a migration only stops
+	//the migrations which require it, the others are still applied.
+	Requires []int
+	//NoTransaction runs the statements one by one on a connection instead of in a transaction,
+	//for the schema changes which are rejected in a transaction, such as ALTER COLUMN TYPE of cockroachdb.
+	//The statements must be safe to rerun.
+	NoTransaction bool
+}
+
+//For returns the statements for the db type
+func (s Statements) For(dbType string) []string {
+	if statements, ok := s[dbType]; ok {
+		return statements
+	}
+	return s[""]
+}
+
+//Step a migration to apply or to roll back
+type Step struct {
+	Version       int
+	Description   string
+	Statements    []string
+	Requires      []int
+	NoTransaction bool
+}
+
+//Status the status of a migration
+type Status struct {
+	Version     int
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+	//Unknown the migration is applied by a newer version of rainbond
+	Unknown bool
+}
+
+//Migrator applies the migrations to the region database in order and records them
+type Migrator struct {
+	db         *gorm.DB
+	dbType     string
+	migrations []*Migration
+}
+
+//NewMigrator new migrator of the migrations of rainbond
+func NewMigrator(db *gorm.DB, dbType string) *Migrator {
+	return newMigrator(db, dbType, migrations)
+}
+
+func newMigrator(db *gorm.DB, dbType string, migrations []*Migration) *Migrator {
+	sorted := make([]*Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+	return &Migrator{db: db, dbType: dbType, migrations: sorted}
+}
+
+//Latest the version of the last migration
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+//Version the schema version of the database, it is the version of the last applied migration
+func (m *Migrator) Version() (int, error) {
+	records, err := m.records()
+	if err != nil {
+		return 0, err
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+	return records[len(records)-1].Version, nil
+}
+
+//Status returns the status of all the migrations, ordered by version
+func (m *Migrator) Status() ([]Status, error) {
+	records, err := m.records()
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[int]*model.SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	var status []Status
+	for _, migration := range m.migrations {
+		s := Status{Version: migration.Version, Description: migration.Description}
+		if record, ok := applied[migration.Version]; ok {
+			s.Applied, s.AppliedAt = true, record.AppliedAt
+			delete(applied, migration.Version)
+		}
+		status = append(status, s)
+	}
+	for _, record := range applied {
+		status = append(status, Status{
+			Version:     record.Version,
+			Description: record.Description,
+			Applied:     true,
+			AppliedAt:   record.AppliedAt,
+			Unknown:     true,
+		})
+	}
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].Version < status[j].Version
+	})
+	return status, nil
+}
+
+//Up applies the migrations not applied yet up to the target version, all of them if target is 0.
+//A failed migration does not stop the others unless they require it, it is retried by the next run.
+//With dryRun, it only returns the steps to apply.
+func (m *Migrator) Up(target int, dryRun bool) ([]Step, error) {
+	if target <= 0 {
+		target = m.Latest()
+	}
+	return m.migrate(dryRun, func(applied map[int]bool) ([]Step, error) {
+		var steps []Step
+		for _, migration := range m.migrations {
+			if migration.Version > target || applied[migration.Version] {
+				continue
+			}
+			steps = append(steps, Step{
+				Version:       migration.Version,
+				Description:   migration.Description,
+				Statements:    migration.Up.For(m.dbType),
+				Requires:      migration.Requires,
+				NoTransaction: migration.NoTransaction,
+			})
+		}
+		return steps, nil
+	}, m.apply, true)
+}
+
+//Down rolls back the applied migrations after the target version, the latest first.
+//With dryRun, it only returns the steps to roll back.
+func (m *Migrator) Down(target int, dryRun bool) ([]Step, error) {
+	if target < 0 {
+		return nil, fmt.Errorf("invalid target version %d", target)
+	}
+	known := make(map[int]*Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+	return m.migrate(dryRun, func(applied map[int]bool) ([]Step, error) {
+		var versions []int
+		for version := range applied {
+			if version > target {
+				versions = append(versions, version)
+			}
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+		var steps []Step
+		for _, version := range versions {
+			migration, ok := known[version]
+			if !ok {
+				return nil, fmt.Errorf("migration %d is applied by a newer version of rainbond, it can not be rolled back", version)
+			}
+			steps = append(steps, Step{
+				Version:       migration.Version,
+				Description:   migration.Description,
+				Statements:    migration.Down.For(m.dbType),
+				NoTransaction: migration.NoTransaction,
+			})
+		}
+		return steps, nil
+	}, m.rollback, false)
+}
+
+//migrate plans the steps with the applied migrations and runs them one by one in the migration lock.
+//It returns the steps done. With keepGoing, a failed step only stops the steps which require it.
+func (m *Migrator) migrate(dryRun bool, plan func(applied map[int]bool) ([]Step, error), run func(step Step) error, keepGoing bool) ([]Step, error) {
+	if !dryRun {
+		unlock, err := m.lock()
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+	records, err := m.records()
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[int]bool, len(records))
+	for _, record := range records {
+		applied[record.Version] = true
+	}
+	steps, err := plan(applied)
+	if err != nil || dryRun {
+		return steps, err
+	}
+	var done []Step
+	var errs []string
+	failed := make(map[int]bool)
+	for _, step := range steps {
+		err := requirementFailure(step, failed)
+		if err == nil {
+			err = run(step)
+		}
+		if err != nil {
+			if !keepGoing {
+				return done, err
+			}
+			logrus.Errorf("%v", err)
+			failed[step.Version] = true
+			errs = append(errs, err.Error())
+			continue
+		}
+		done = append(done, step)
+	}
+	if len(errs) > 0 {
+		return done, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return done, nil
+}
+
+func requirementFailure(step Step, failed map[int]bool) error {
+	for _, version := range step.Requires {
+		if failed[version] {
+			return fmt.Errorf("migration %d: skipped because migration %d failed", step.Version, version)
+		}
+	}
+	return nil
+}
+
+//apply runs the statements of the step and records it in one transaction, or records it after
+//the statements if the step can not run in a transaction.
+//Mysql commits the schema changes implicitly, so the statements of a migration should be safe to rerun.
+func (m *Migrator) apply(step Step) error {
+	record := func(tx *gorm.DB) error {
+		record := &model.SchemaMigration{Version: step.Version, Description: step.Description, AppliedAt: time.Now()}
+		if err := tx.Create(record).Error; err != nil {
+			return fmt.Errorf("record migration %d: %v", step.Version, err)
+		}
+		logrus.Infof("migration %d applied: %s", step.Version, step.Description)
+		return nil
+	}
+	if step.NoTransaction {
+		if err := m.execOnConn(step); err != nil {
+			return err
+		}
+		return record(m.db)
+	}
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := m.exec(tx, step); err != nil {
+			return err
+		}
+		return record(tx)
+	})
+}
+
+//rollback runs the down statements of the step and removes its record in one transaction,
+//or removes it after the statements if the step can not run in a transaction.
+func (m *Migrator) rollback(step Step) error {
+	remove := func(tx *gorm.DB) error {
+		if err := tx.Where("version = ?", step.Version).Delete(&model.SchemaMigration{}).Error; err != nil {
+			return fmt.Errorf("remove the record of migration %d: %v", step.Version, err)
+		}
+		logrus.Infof("migration %d rolled back: %s", step.Version, step.Description)
+		return nil
+	}
+	if step.NoTransaction {
+		if err := m.execOnConn(step); err != nil {
+			return err
+		}
+		return remove(m.db)
+	}
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := m.exec(tx, step); err != nil {
+			return err
+		}
+		return remove(tx)
+	})
+}
+
+func (m *Migrator) exec(tx *gorm.DB, step Step) error {
+	for _, statement := range step.Statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return fmt.Errorf("migration %d: %s: %v", step.Version, statement, err)
+		}
+	}
+	return nil
+}
+
+//execOnConn runs the statements one by one on a connection out of any transaction,
+//the session settings set by a statement take effect on the following ones
+func (m *Migrator) execOnConn(step Step) error {
+	ctx := context.Background()
+	conn, err := m.db.DB().Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	for _, statement := range step.Statements {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("migration %d: %s: %v", step.Version, statement, err)
+		}
+	}
+	return nil
+}
+
+//records returns the applied migrations ordered by version, the record table is created if it does not exist
+func (m *Migrator) records() ([]*model.SchemaMigration, error) {
+	if !m.db.HasTable(&model.SchemaMigration{}) {
+		if err := m.db.CreateTable(&model.SchemaMigration{}).Error; err != nil {
+			return nil, fmt.Errorf("create table %s: %v", model.SchemaMigration{}.TableName(), err)
+		}
+	}
+	var records []*model.SchemaMigration
+	if err := m.db.Order("version").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("list the applied migrations: %v", err)
+	}
+	return records, nil
+}
+
+//lock prevents the components starting at the same time from migrating the database together.
+//It only works for mysql, cockroachdb and sqlite are used by the single node regions.
+func (m *Migrator) lock() (func(), error) {
+	if m.dbType != "mysql" {
+		return func() {}, nil
+	}
+	ctx := context.Background()
+	conn, err := m.db.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, lockTimeout).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("get the migration lock: %v", err)
+	}
+	if locked.Int64 != 1 {
+		conn.Close()
+		return nil, fmt.Errorf("wait for the migration lock timeout")
+	}
+	return func() {
+		if _, err := conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", lockName); err != nil {
+			logrus.Warningf("release the migration lock: %v", err)
+		}
+		conn.Close()
+	}, nil
+}
@@ -0,0 +1,193 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package migrate
+
+import (
+	"path"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+func TestMigrationsOrdered(t *testing.T) {
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Errorf("want version %d, but got %d", i+1, migration.Version)
+		}
+		if migration.Description == "" || migration.Up == nil {
+			t.Errorf("migration %d: description and up statements are required", migration.Version)
+		}
+	}
+}
+
+func TestStatementsFor(t *testing.T) {
+	s := Statements{
+		"":      {"default"},
+		"mysql": {"mysql"},
+	}
+	if got := s.For("mysql"); len(got) != 1 || got[0] != "mysql" {
+		t.Errorf("want the statements of mysql, but got %v", got)
+	}
+	if got := s.For("sqlite"); len(got) != 1 || got[0] != "default" {
+		t.Errorf("want the default statements, but got %v", got)
+	}
+	if got := (Statements(nil)).For("mysql"); len(got) != 0 {
+		t.Errorf("want no statements, but got %v", got)
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	db, err := gorm.Open("sqlite3", path.Join(t.TempDir(), "region.sqlite3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	migrator := newMigrator(db, "sqlite", []*Migration{
+		{
+			Version:     2,
+			Description: "backfill",
+			Up:          Statements{"": {"INSERT INTO test_migrate (name) VALUES ('a')"}},
+		},
+		{
+			Version:     1,
+			Description: "create table",
+			Up:          Statements{"": {"CREATE TABLE test_migrate (name varchar(32))"}},
+			Down:        Statements{"mysql": {"unsupported"}, "sqlite": {"DROP TABLE test_migrate"}},
+		},
+	})
+	if migrator.Latest() != 2 {
+		t.Fatalf("want latest version 2, but got %d", migrator.Latest())
+	}
+	versionShouldBe := func(want int) {
+		t.Helper()
+		version, err := migrator.Version()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != want {
+			t.Fatalf("want version %d, but got %d", want, version)
+		}
+	}
+
+	steps, err := migrator.Up(0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != 2 || steps[0].Version != 1 || steps[1].Version != 2 {
+		t.Fatalf("want steps 1 and 2, but got %+v", steps)
+	}
+	versionShouldBe(0)
+
+	if _, err := migrator.Up(1, false); err != nil {
+		t.Fatal(err)
+	}
+	versionShouldBe(1)
+	if _, err := migrator.Up(0, false); err != nil {
+		t.Fatal(err)
+	}
+	versionShouldBe(2)
+	var count int
+	if err := db.Table("test_migrate").Count(&count).Error; err != nil || count != 1 {
+		t.Fatalf("want 1 row backfilled, but got %d, %v", count, err)
+	}
+	if steps, err := migrator.Up(0, false); err != nil || len(steps) != 0 {
+		t.Fatalf("want nothing to apply, but got %+v, %v", steps, err)
+	}
+
+	// migration 2 has nothing to undo, the table is dropped by migration 1
+	steps, err = migrator.Down(0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != 2 || steps[0].Version != 2 || len(steps[0].Statements) != 0 || steps[1].Statements[0] != "DROP TABLE test_migrate" {
+		t.Fatalf("unexpected steps %+v", steps)
+	}
+	if _, err := migrator.Down(1, false); err != nil {
+		t.Fatal(err)
+	}
+	versionShouldBe(1)
+	if _, err := migrator.Down(0, false); err != nil {
+		t.Fatal(err)
+	}
+	versionShouldBe(0)
+	if db.HasTable("test_migrate") {
+		t.Error("want table test_migrate dropped")
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status) != 2 || status[0].Applied || status[1].Applied {
+		t.Errorf("want all the migrations pending, but got %+v", status)
+	}
+}
+
+func TestMigratorFailure(t *testing.T) {
+	db, err := gorm.Open("sqlite3", path.Join(t.TempDir(), "region.sqlite3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	migrator := newMigrator(db, "sqlite", []*Migration{
+		{Version: 1, Description: "ok", Up: Statements{"": {"CREATE TABLE test_migrate (name varchar(32))"}}},
+		{Version: 2, Description: "broken", Up: Statements{"": {"UPDATE not_exist SET name='a'"}}},
+		{Version: 3, Description: "unrelated", Up: Statements{"": {"INSERT INTO test_migrate (name) VALUES ('a')"}}},
+		{Version: 4, Description: "requires the broken one", Requires: []int{2}, Up: Statements{"": {"INSERT INTO test_migrate (name) VALUES ('b')"}}},
+		{Version: 5, Description: "broken out of transaction", NoTransaction: true, Up: Statements{"": {"UPDATE not_exist SET name='a'"}}},
+		{Version: 6, Description: "out of transaction", NoTransaction: true, Up: Statements{"": {
+			"CREATE TABLE test_no_transaction (name varchar(32))",
+			"INSERT INTO test_no_transaction (name) VALUES ('a')",
+		}}},
+	})
+	steps, err := migrator.Up(0, false)
+	if err == nil {
+		t.Fatal("want the errors of migration 2, 4 and 5")
+	}
+	if len(steps) != 3 || steps[0].Version != 1 || steps[1].Version != 3 || steps[2].Version != 6 {
+		t.Errorf("want migration 1, 3 and 6 applied, but got %+v", steps)
+	}
+	status, err := migrator.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range status {
+		want := s.Version == 1 || s.Version == 3 || s.Version == 6
+		if s.Applied != want {
+			t.Errorf("migration %d: want applied %v, but got %v", s.Version, want, s.Applied)
+		}
+	}
+	var count int
+	if err := db.Table("test_migrate").Count(&count).Error; err != nil || count != 1 {
+		t.Errorf("want 1 row inserted by migration 3, but got %d, %v", count, err)
+	}
+	if err := db.Table("test_no_transaction").Count(&count).Error; err != nil || count != 1 {
+		t.Errorf("want 1 row inserted by migration 6, but got %d, %v", count, err)
+	}
+
+	// the failed migrations are retried by the next run
+	steps, err = migrator.Up(0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != 3 || steps[0].Version != 2 || steps[1].Version != 4 || steps[2].Version != 5 {
+		t.Errorf("want migration 2, 4 and 5 pending, but got %+v", steps)
+	}
+}
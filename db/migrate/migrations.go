@@ -0,0 +1,87 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package migrate
+
+//migrations the migrations of the region database, append a new one with the next version.
+//The tables are created from the models before the migrations, so a migration only
+//changes the existing tables and data. Sqlite ignores the length of the column types.
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "widen the columns created by the older versions",
+		Up: Statements{
+			"mysql": {
+				"ALTER TABLE tenant_services_envs MODIFY COLUMN attr_value text",
+				"ALTER TABLE tenant_services_event MODIFY COLUMN request_body varchar(1024)",
+				"ALTER TABLE tenant_services_volume MODIFY COLUMN volume_type varchar(64)",
+				// the keys and users of the gateway authentication are longer than varchar(255)
+				"ALTER TABLE gateway_rule_extension MODIFY COLUMN value text",
+			},
+			// the columns of cockroachdb are widened by migration 6
+			"cockroachdb": {},
+			"sqlite":      {},
+		},
+	},
+	{
+		Version:     2,
+		Description: "listen on all the addresses for the tcp rules without ip",
+		Up: Statements{
+			"": {"UPDATE gateway_tcp_rule SET ip='0.0.0.0' WHERE ip=''"},
+		},
+	},
+	{
+		Version:     3,
+		Description: "backfill the namespaces of the tenants",
+		Up: Statements{
+			"": {"UPDATE tenants SET namespace=uuid WHERE namespace IS NULL"},
+		},
+	},
+	{
+		Version:     4,
+		Description: "backfill the kubernetes names of the applications",
+		Up: Statements{
+			"mysql":       {"UPDATE applications SET k8s_app=CONCAT('app-', LEFT(app_id, 8)) WHERE k8s_app IS NULL"},
+			"cockroachdb": {"UPDATE applications SET k8s_app='app-' || substring(app_id, 1, 8) WHERE k8s_app IS NULL"},
+			"sqlite":      {"UPDATE applications SET k8s_app='app-' || substr(app_id, 1, 8) WHERE k8s_app IS NULL"},
+		},
+	},
+	{
+		Version:     5,
+		Description: "backfill the kubernetes names of the components",
+		Up: Statements{
+			"": {"UPDATE tenant_services SET k8s_component_name=service_alias WHERE k8s_component_name IS NULL"},
+		},
+	},
+	{
+		Version:     6,
+		Description: "widen the columns created by the older versions of cockroachdb",
+		// cockroachdb changes the column types only out of the transactions, with the experimental setting of the session
+		NoTransaction: true,
+		Up: Statements{
+			"cockroachdb": {
+				"SET enable_experimental_alter_column_type_general = true",
+				"ALTER TABLE tenant_services_envs ALTER COLUMN attr_value TYPE text",
+				"ALTER TABLE tenant_services_event ALTER COLUMN request_body TYPE varchar(1024)",
+				"ALTER TABLE tenant_services_volume ALTER COLUMN volume_type TYPE varchar(64)",
+				"ALTER TABLE gateway_rule_extension ALTER COLUMN value TYPE text",
+			},
+			"": {},
+		},
+	},
+}
@@ -27,6 +27,14 @@ const (
 	GovernanceModeIstioServiceMesh = "ISTIO_SERVICE_MESH"
 )
 
+// tracing drivers of the build-in service mesh
+const (
+	// TracingDriverZipkin reports the spans to a zipkin collector
+	TracingDriverZipkin = "zipkin"
+	// TracingDriverOTLP reports the spans to an OpenTelemetry collector
+	TracingDriverOTLP = "otlp"
+)
+
 // app type
 const (
 	AppTypeRainbond = "rainbond"
@@ -47,6 +55,10 @@ type Application struct {
 	Version         string `gorm:"column:version" json:"version"`
 	GovernanceMode  string `gorm:"column:governance_mode;default:'BUILD_IN_SERVICE_MESH'" json:"governance_mode"`
 	K8sApp          string `gorm:"column:k8s_app" json:"k8s_app"`
+	// tracing of the build-in service mesh, disabled if the driver is empty
+	TracingDriver    string  `gorm:"column:tracing_driver" json:"tracing_driver"`
+	TracingCollector string  `gorm:"column:tracing_collector" json:"tracing_collector"`
+	TracingSampling  float64 `gorm:"column:tracing_sampling" json:"tracing_sampling"`
 }
 
 // TableName return tableName "application"
@@ -54,6 +66,11 @@ func (t *Application) TableName() string {
 	return "applications"
 }
 
+// TracingEnabled whether the tracing of the build-in service mesh is enabled
+func (t *Application) TracingEnabled() bool {
+	return t.TracingDriver != "" && t.TracingCollector != ""
+}
+
 // ConfigGroupService -
 type ConfigGroupService struct {
 	Model
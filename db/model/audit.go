@@ -0,0 +1,73 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+import "time"
+
+//AuditLog the record of a mutating request to the region api
+type AuditLog struct {
+	Model
+	//Actor who sends the request, identified by the token or the client certificate
+	Actor string `gorm:"column:actor;size:128;index" json:"actor"`
+	//Operator the user of the console sending the request, it is set in the request body
+	Operator   string `gorm:"column:operator;size:64;index" json:"operator"`
+	TenantID   string `gorm:"column:tenant_id;size:32;index" json:"tenant_id"`
+	TenantName string `gorm:"column:tenant_name;size:64" json:"tenant_name"`
+	//Resource the route of the request, such as /v2/tenants/{tenant_name}/apps/{app_id}
+	Resource   string `gorm:"column:resource;size:255;index" json:"resource"`
+	ResourceID string `gorm:"column:resource_id;size:255" json:"resource_id"`
+	//Action the method of the request
+	Action string `gorm:"column:action;size:16" json:"action"`
+	Path   string `gorm:"column:path;size:1024" json:"path"`
+	//RequestBody the change requested, the sensitive fields are masked
+	RequestBody string `gorm:"column:request_body;type:text" json:"request_body"`
+	StatusCode  int    `gorm:"column:status_code" json:"status_code"`
+	//Result success or failure
+	Result   string `gorm:"column:result;size:16" json:"result"`
+	ErrMsg   string `gorm:"column:err_msg;size:1024" json:"err_msg"`
+	ClientIP string `gorm:"column:client_ip;size:64" json:"client_ip"`
+	//Elapsed milliseconds to handle the request
+	Elapsed int64 `gorm:"column:elapsed" json:"elapsed"`
+}
+
+//TableName returns table name of AuditLog
+func (AuditLog) TableName() string {
+	return "region_audit_logs"
+}
+
+//AuditLogQuery the filters of the audit logs, the empty ones are ignored
+type AuditLogQuery struct {
+	Actor      string
+	Operator   string
+	TenantID   string
+	TenantName string
+	//Resource matches the resources containing it
+	Resource   string
+	ResourceID string
+	Action     string
+	Result     string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+//AuditResultSuccess the request is handled with a status code less than 400
+const AuditResultSuccess = "success"
+
+//AuditResultFailure the request is handled with a status code not less than 400
+const AuditResultFailure = "failure"
@@ -46,13 +46,87 @@ var HTTPToHTTPS RuleExtensionKey = "httptohttps"
 // LBType load balancer type
 var LBType RuleExtensionKey = "lb-type"
 
+// RateLimitRPS the requests per second allowed by the http rule
+var RateLimitRPS RuleExtensionKey = "rate-limit-rps"
+
+// RateLimitBurst the requests allowed to exceed the rate of the http rule
+var RateLimitBurst RuleExtensionKey = "rate-limit-burst"
+
+// RateLimitKey what the requests are limited by, ip(default), path or header:<name>
+var RateLimitKey RuleExtensionKey = "rate-limit-key"
+
+// AllowCIDRs the comma separated client networks allowed by the http rule
+var AllowCIDRs RuleExtensionKey = "allow-cidrs"
+
+// DenyCIDRs the comma separated client networks denied by the http rule
+var DenyCIDRs RuleExtensionKey = "deny-cidrs"
+
+// AuthType the authentication of the http rule, basic, jwt or forward
+var AuthType RuleExtensionKey = "auth-type"
+
+// AuthRealm the realm of the basic authentication
+var AuthRealm RuleExtensionKey = "auth-realm"
+
+// AuthBasicUsers the users of the basic authentication, user:password separated by newline,
+// the passwords are stored as bcrypt hashes
+var AuthBasicUsers RuleExtensionKey = "auth-basic-users"
+
+// AuthJWKSURL the url of the JSON web key set to verify the JWT
+var AuthJWKSURL RuleExtensionKey = "auth-jwks-url"
+
+// AuthJWTKeys the PEM encoded public keys to verify the JWT
+var AuthJWTKeys RuleExtensionKey = "auth-jwt-keys"
+
+// AuthJWTIssuer the issuer the JWT must be issued by
+var AuthJWTIssuer RuleExtensionKey = "auth-jwt-issuer"
+
+// AuthJWTAudience the audience the JWT must be issued for
+var AuthJWTAudience RuleExtensionKey = "auth-jwt-audience"
+
+// AuthJWTClaimHeaders the claims of the JWT passed to the upstream, claim:header separated by comma
+var AuthJWTClaimHeaders RuleExtensionKey = "auth-jwt-claim-headers"
+
+// AuthURL the url of the forward authentication
+var AuthURL RuleExtensionKey = "auth-url"
+
+// AuthResponseHeaders the comma separated headers of the forward authentication response passed to the upstream
+var AuthResponseHeaders RuleExtensionKey = "auth-response-headers"
+
+// AutoTLS the certificate of the http rule is issued and renewed through the ACME protocol if it is true
+var AutoTLS RuleExtensionKey = "auto-tls"
+
+// AutoTLSChallenge the ACME challenge of the automatic certificate, http-01(default) or dns-01
+var AutoTLSChallenge RuleExtensionKey = "auto-tls-challenge"
+
+// ClientCA the PEM encoded CA bundle the client certificates of the http rule are verified by
+var ClientCA RuleExtensionKey = "client-ca"
+
+// ClientVerify the verification of the client certificates, on(default) rejects the requests without
+// a verified client certificate, optional passes them to the upstream
+var ClientVerify RuleExtensionKey = "client-verify"
+
+// ClientVerifyDepth the max depth of the client certificate chain
+var ClientVerifyDepth RuleExtensionKey = "client-verify-depth"
+
+// UpstreamProtocol the protocol the requests are proxied to the upstream by, http(default) or https
+var UpstreamProtocol RuleExtensionKey = "upstream-protocol"
+
+// UpstreamTLSVerify the certificates of the upstream are verified if it is true
+var UpstreamTLSVerify RuleExtensionKey = "upstream-tls-verify"
+
+// UpstreamTLSCA the PEM encoded CA bundle the upstream certificates are verified by, the system CA bundle by default
+var UpstreamTLSCA RuleExtensionKey = "upstream-tls-ca"
+
+// UpstreamTLSServerName the server name sent to the upstream by SNI and verified, the host of the request by default
+var UpstreamTLSServerName RuleExtensionKey = "upstream-tls-server-name"
+
 // RuleExtension contains rule extensions for http rule or tcp rule
 type RuleExtension struct {
 	Model
 	UUID   string `gorm:"column:uuid"`
 	RuleID string `gorm:"column:rule_id"`
 	Key    string `gorm:"column:key"`
-	Value  string `gorm:"column:value"`
+	Value  string `gorm:"column:value;type:text"`
 }
 
 // LoadBalancerType load balancer type
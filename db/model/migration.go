@@ -0,0 +1,33 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+import "time"
+
+//SchemaMigration the record of a migration applied to the region database
+type SchemaMigration struct {
+	Version     int       `gorm:"column:version;primary_key;AUTO_INCREMENT:false" json:"version"`
+	Description string    `gorm:"column:description;size:255" json:"description"`
+	AppliedAt   time.Time `gorm:"column:applied_at" json:"applied_at"`
+}
+
+//TableName returns table name of SchemaMigration
+func (SchemaMigration) TableName() string {
+	return "region_schema_migrations"
+}
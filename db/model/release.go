@@ -0,0 +1,200 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CanaryReleaseStatus the status of a canary release
+type CanaryReleaseStatus string
+
+const (
+	// CanaryReleaseStatusNone no canary release is running, only the strategy is saved
+	CanaryReleaseStatusNone CanaryReleaseStatus = ""
+	// CanaryReleaseStatusProgressing the canary version receives part of the traffic
+	CanaryReleaseStatusProgressing CanaryReleaseStatus = "progressing"
+	// CanaryReleaseStatusPaused the canary release stays on the current step
+	CanaryReleaseStatusPaused CanaryReleaseStatus = "paused"
+	// CanaryReleaseStatusPromoted the canary version replaced the stable version
+	CanaryReleaseStatusPromoted CanaryReleaseStatus = "promoted"
+	// CanaryReleaseStatusAborted the canary version was removed, the stable version serves all traffic
+	CanaryReleaseStatusAborted CanaryReleaseStatus = "aborted"
+)
+
+// String -
+func (c CanaryReleaseStatus) String() string {
+	return string(c)
+}
+
+// DefaultCanarySteps the traffic percentages used when a component has no canary strategy
+const DefaultCanarySteps = "10,30,50"
+
+// TenantServiceCanaryRelease holds the canary strategy of a component and the state of
+// its current canary release. There is at most one record per component.
+type TenantServiceCanaryRelease struct {
+	Model
+	ServiceID string `gorm:"column:service_id;unique;size:32" json:"service_id"`
+	// Steps the comma separated traffic percentages of the canary version, such as 10,30,50.
+	// The last step is always followed by a full promotion.
+	Steps string `gorm:"column:steps" json:"steps"`
+	// Replicas the replicas of the canary version
+	Replicas int `gorm:"column:replicas;default:1" json:"replicas"`
+	// StepInterval seconds to wait before moving to the next step automatically, 0 means manual promotion
+	StepInterval  int    `gorm:"column:step_interval" json:"step_interval"`
+	StableVersion string `gorm:"column:stable_version" json:"stable_version"`
+	CanaryVersion string `gorm:"column:canary_version" json:"canary_version"`
+	// CurrentStep the index of the step in Steps which is applied now
+	CurrentStep int       `gorm:"column:current_step" json:"current_step"`
+	Weight      int       `gorm:"column:weight" json:"weight"`
+	Status      string    `gorm:"column:status;size:32" json:"status"`
+	EventID     string    `gorm:"column:event_id;size:32" json:"event_id"`
+	Operator    string    `gorm:"column:operator" json:"operator"`
+	UpdateTime  time.Time `gorm:"column:update_time" json:"update_time"`
+	// NextStepTime the time to move to the next step automatically, nil if the release is not progressing
+	// or it is promoted manually. It is driven by the leader worker, so it survives the restarts of the workers.
+	NextStepTime *time.Time `gorm:"column:next_step_time;index" json:"next_step_time,omitempty"`
+}
+
+// TableName -
+func (t *TenantServiceCanaryRelease) TableName() string {
+	return "tenant_services_canary_release"
+}
+
+// IsActive checks if the canary version is running next to the stable version
+func (t *TenantServiceCanaryRelease) IsActive() bool {
+	return t.Status == CanaryReleaseStatusProgressing.String() || t.Status == CanaryReleaseStatusPaused.String()
+}
+
+// ScheduleNextStep sets the time to move to the next step automatically if the step interval is set
+func (t *TenantServiceCanaryRelease) ScheduleNextStep(now time.Time) {
+	t.NextStepTime = nil
+	if t.StepInterval > 0 && t.Status == CanaryReleaseStatusProgressing.String() {
+		next := now.Add(time.Duration(t.StepInterval) * time.Second)
+		t.NextStepTime = &next
+	}
+}
+
+// StepWeights parses the traffic percentages of the canary steps
+func (t *TenantServiceCanaryRelease) StepWeights() ([]int, error) {
+	steps := t.Steps
+	if steps == "" {
+		steps = DefaultCanarySteps
+	}
+	var weights []int
+	for _, step := range strings.Split(steps, ",") {
+		weight, err := strconv.Atoi(strings.TrimSpace(step))
+		if err != nil {
+			return nil, fmt.Errorf("invalid canary step %q", step)
+		}
+		if weight <= 0 || weight > 100 {
+			return nil, fmt.Errorf("canary step %d out of range (0, 100]", weight)
+		}
+		if len(weights) > 0 && weight <= weights[len(weights)-1] {
+			return nil, fmt.Errorf("canary steps must be increasing")
+		}
+		weights = append(weights, weight)
+	}
+	return weights, nil
+}
+
+// ReleaseHealthStatus the result of the analysis after a component is deployed
+type ReleaseHealthStatus string
+
+const (
+	// ReleaseHealthAnalyzing the new version is in the analysis window
+	ReleaseHealthAnalyzing ReleaseHealthStatus = "analyzing"
+	// ReleaseHealthHealthy no threshold was breached in the analysis window
+	ReleaseHealthHealthy ReleaseHealthStatus = "healthy"
+	// ReleaseHealthRolledBack a threshold was breached, the component was rolled back to the previous version
+	ReleaseHealthRolledBack ReleaseHealthStatus = "rolledback"
+	// ReleaseHealthCanceled the analysis was stopped before the end of the window, such as by another deployment
+	ReleaseHealthCanceled ReleaseHealthStatus = "canceled"
+)
+
+// String -
+func (r ReleaseHealthStatus) String() string {
+	return string(r)
+}
+
+// TenantServiceReleaseAnalysis the post-deploy analysis of a component.
+// A zero threshold disables the corresponding check.
+type TenantServiceReleaseAnalysis struct {
+	Model
+	ServiceID string `gorm:"column:service_id;unique;size:32" json:"service_id"`
+	Enable    bool   `gorm:"column:enable" json:"enable"`
+	// Window seconds to analyze the new version after it is ready
+	Window int `gorm:"column:window;default:300" json:"window"`
+	// Interval seconds between two evaluations
+	Interval int `gorm:"column:interval;default:30" json:"interval"`
+	// ErrorRateThreshold the max percentage of 5xx responses
+	ErrorRateThreshold float64 `gorm:"column:error_rate_threshold" json:"error_rate_threshold"`
+	// P99LatencyThreshold the max p99 latency in milliseconds
+	P99LatencyThreshold float64 `gorm:"column:p99_latency_threshold" json:"p99_latency_threshold"`
+	// RestartThreshold the container restarts which trigger the rollback
+	RestartThreshold int `gorm:"column:restart_threshold" json:"restart_threshold"`
+}
+
+// TableName -
+func (t *TenantServiceReleaseAnalysis) TableName() string {
+	return "tenant_services_release_analysis"
+}
+
+// Breached returns the reason if any threshold is breached by the given metrics, or empty.
+func (t *TenantServiceReleaseAnalysis) Breached(errorRate, p99Latency float64, restarts int) string {
+	if t.ErrorRateThreshold > 0 && errorRate > t.ErrorRateThreshold {
+		return fmt.Sprintf("error rate %.2f%% exceeds the threshold %.2f%%", errorRate, t.ErrorRateThreshold)
+	}
+	if t.P99LatencyThreshold > 0 && p99Latency > t.P99LatencyThreshold {
+		return fmt.Sprintf("p99 latency %.0fms exceeds the threshold %.0fms", p99Latency, t.P99LatencyThreshold)
+	}
+	if t.RestartThreshold > 0 && restarts >= t.RestartThreshold {
+		return fmt.Sprintf("containers restarted %d times, the threshold is %d", restarts, t.RestartThreshold)
+	}
+	return ""
+}
+
+// TenantServiceReleaseHealthRecords the health record of a deployed version
+type TenantServiceReleaseHealthRecords struct {
+	Model
+	ServiceID       string    `gorm:"column:service_id;index:service_id;size:32" json:"-"`
+	EventID         string    `gorm:"column:event_id;size:32" json:"event_id"`
+	DeployVersion   string    `gorm:"column:deploy_version" json:"deploy_version"`
+	PreviousVersion string    `gorm:"column:previous_version" json:"previous_version"`
+	Status          string    `gorm:"column:status;index;size:32" json:"status"`
+	Reason          string    `gorm:"column:reason;size:1023" json:"reason"`
+	ErrorRate       float64   `gorm:"column:error_rate" json:"error_rate"`
+	P99Latency      float64   `gorm:"column:p99_latency" json:"p99_latency"`
+	Restarts        int       `gorm:"column:restarts" json:"restarts"`
+	RollbackEventID string    `gorm:"column:rollback_event_id;size:32" json:"rollback_event_id"`
+	StartTime       time.Time `gorm:"column:start_time" json:"start_time"`
+	EndTime         time.Time `gorm:"column:end_time" json:"end_time"`
+	// ReadyTime the time the deploy version is ready, the analysis window starts from it
+	ReadyTime *time.Time `gorm:"column:ready_time" json:"ready_time,omitempty"`
+	// CheckTime the time of the last evaluation
+	CheckTime *time.Time `gorm:"column:check_time" json:"check_time,omitempty"`
+}
+
+// TableName -
+func (t *TenantServiceReleaseHealthRecords) TableName() string {
+	return "tenant_services_release_health_records"
+}
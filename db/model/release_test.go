@@ -0,0 +1,81 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTenantServiceCanaryRelease_StepWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   string
+		want    []int
+		wantErr bool
+	}{
+		{name: "default steps", steps: "", want: []int{10, 30, 50}},
+		{name: "custom steps", steps: "5, 20,100", want: []int{5, 20, 100}},
+		{name: "not a number", steps: "10,abc", wantErr: true},
+		{name: "out of range", steps: "10,120", wantErr: true},
+		{name: "not increasing", steps: "30,10", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &TenantServiceCanaryRelease{Steps: tt.steps}
+			got, err := r.StepWeights()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StepWeights() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StepWeights() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTenantServiceCanaryRelease_ScheduleNextStep(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		interval int
+		status   CanaryReleaseStatus
+		want     *time.Time
+	}{
+		{name: "manual", status: CanaryReleaseStatusProgressing},
+		{name: "paused", interval: 60, status: CanaryReleaseStatusPaused},
+		{name: "progressing", interval: 60, status: CanaryReleaseStatusProgressing, want: func() *time.Time { t := now.Add(time.Minute); return &t }()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &TenantServiceCanaryRelease{StepInterval: tt.interval, Status: tt.status.String(), NextStepTime: &now}
+			r.ScheduleNextStep(now)
+			if !reflect.DeepEqual(r.NextStepTime, tt.want) {
+				t.Errorf("ScheduleNextStep() = %v, want %v", r.NextStepTime, tt.want)
+			}
+		})
+	}
+}
+
+func TestTenantServiceReleaseAnalysis_Breached(t *testing.T) {
+	analysis := &TenantServiceReleaseAnalysis{ErrorRateThreshold: 5, RestartThreshold: 3}
+	tests := []struct {
+		name       string
+		errorRate  float64
+		p99Latency float64
+		restarts   int
+		breached   bool
+	}{
+		{name: "healthy", errorRate: 1, p99Latency: 5000, restarts: 2},
+		{name: "error rate", errorRate: 5.5, breached: true},
+		{name: "restarts", restarts: 3, breached: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := analysis.Breached(tt.errorRate, tt.p99Latency, tt.restarts)
+			if (reason != "") != tt.breached {
+				t.Errorf("Breached() = %q, want breached %v", reason, tt.breached)
+			}
+		})
+	}
+}
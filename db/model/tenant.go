@@ -659,6 +659,25 @@ func (t *TenantServiceAutoscalerRules) TableName() string {
 	return "tenant_services_autoscaler_rules"
 }
 
+// The metric types of autoscaler rules
+const (
+	// MetricsTypeResource cpu or memory of the pods
+	MetricsTypeResource = "resource_metrics"
+	// MetricsTypePods a custom metric of the pods, such as requests per second
+	MetricsTypePods = "pods_metrics"
+	// MetricsTypeObject a custom metric of a kubernetes object, such as the requests of an ingress
+	MetricsTypeObject = "object_metrics"
+	// MetricsTypeExternal a metric not related to kubernetes objects, such as the depth of a queue
+	MetricsTypeExternal = "external_metrics"
+)
+
+// The metric target types of autoscaler rules
+const (
+	MetricTargetTypeUtilization  = "utilization"
+	MetricTargetTypeAverageValue = "average_value"
+	MetricTargetTypeValue        = "value"
+)
+
 // TenantServiceAutoscalerRuleMetrics -
 type TenantServiceAutoscalerRuleMetrics struct {
 	Model
@@ -667,6 +686,10 @@ type TenantServiceAutoscalerRuleMetrics struct {
 	MetricsName       string `gorm:"column:metric_name;not null"`
 	MetricTargetType  string `gorm:"column:metric_target_type;not null"`
 	MetricTargetValue int    `gorm:"column:metric_target_value;not null"`
+	// MetricSelector the label selector of pods, object and external metrics, such as queue=orders
+	MetricSelector string `gorm:"column:metric_selector"`
+	// DescribedObject the object of object metrics, [apiVersion/]kind/name
+	DescribedObject string `gorm:"column:described_object"`
 }
 
 // TableName -
@@ -674,6 +697,58 @@ func (t *TenantServiceAutoscalerRuleMetrics) TableName() string {
 	return "tenant_services_autoscaler_rule_metrics"
 }
 
+// ParseDescribedObject parses the described object of object metrics
+func (t *TenantServiceAutoscalerRuleMetrics) ParseDescribedObject() (apiVersion, kind, name string, err error) {
+	parts := strings.Split(t.DescribedObject, "/")
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("described object %q should be [apiVersion/]kind/name", t.DescribedObject)
+	}
+	apiVersion = strings.Join(parts[:len(parts)-2], "/")
+	kind, name = parts[len(parts)-2], parts[len(parts)-1]
+	if kind == "" || name == "" {
+		return "", "", "", fmt.Errorf("described object %q should be [apiVersion/]kind/name", t.DescribedObject)
+	}
+	return apiVersion, kind, name, nil
+}
+
+// Validate checks if the metric can be converted to a metric spec of HPA
+func (t *TenantServiceAutoscalerRuleMetrics) Validate() error {
+	if t.MetricTargetValue < 0 {
+		return fmt.Errorf("metric target value of %s should not be negative", t.MetricsName)
+	}
+	switch t.MetricsType {
+	case MetricsTypeResource:
+		if t.MetricsName != "cpu" && t.MetricsName != "memory" {
+			return fmt.Errorf("unsupported resource metric %s", t.MetricsName)
+		}
+		if t.MetricTargetType != MetricTargetTypeUtilization && t.MetricTargetType != MetricTargetTypeAverageValue {
+			return fmt.Errorf("unsupported target type %s of resource metric", t.MetricTargetType)
+		}
+		return nil
+	case MetricsTypePods:
+		if t.MetricTargetType != MetricTargetTypeAverageValue {
+			return fmt.Errorf("target type of pods metric should be %s", MetricTargetTypeAverageValue)
+		}
+	case MetricsTypeObject:
+		if t.MetricTargetType != MetricTargetTypeValue && t.MetricTargetType != MetricTargetTypeAverageValue {
+			return fmt.Errorf("unsupported target type %s of object metric", t.MetricTargetType)
+		}
+		if _, _, _, err := t.ParseDescribedObject(); err != nil {
+			return err
+		}
+	case MetricsTypeExternal:
+		if t.MetricTargetType != MetricTargetTypeValue && t.MetricTargetType != MetricTargetTypeAverageValue {
+			return fmt.Errorf("unsupported target type %s of external metric", t.MetricTargetType)
+		}
+	default:
+		return fmt.Errorf("unsupported metric type %s", t.MetricsType)
+	}
+	if t.MetricsName == "" {
+		return fmt.Errorf("metric name is required")
+	}
+	return nil
+}
+
 // TenantServiceScalingRecords -
 type TenantServiceScalingRecords struct {
 	Model
@@ -693,6 +768,99 @@ func (t *TenantServiceScalingRecords) TableName() string {
 	return "tenant_services_scaling_records"
 }
 
+// The record types of scaling records
+const (
+	// ScalingRecordTypeManual the component is scaled by a user
+	ScalingRecordTypeManual = "manual"
+	// ScalingRecordTypeCron the component is scaled by a scaling schedule
+	ScalingRecordTypeCron = "cron"
+	// ScalingRecordTypeIdle the component is scaled to zero or woken up by the idle policy
+	ScalingRecordTypeIdle = "idle"
+)
+
+// TenantServiceScalingSchedules scales the component to the replicas at the time of the cron expression
+type TenantServiceScalingSchedules struct {
+	Model
+	ScheduleID string `gorm:"column:schedule_id;unique;size:32" json:"schedule_id"`
+	ServiceID  string `gorm:"column:service_id;size:32" json:"service_id"`
+	Enable     bool   `gorm:"column:enable" json:"enable"`
+	Cron       string `gorm:"column:cron;size:64" json:"cron"`
+	// the location of the cron expression, such as Asia/Shanghai, default is UTC
+	Timezone string `gorm:"column:timezone;size:64" json:"timezone"`
+	Replicas int    `gorm:"column:replicas" json:"replicas"`
+	// the last time the schedule is checked or fired, the next time is computed from it
+	LastTime time.Time `gorm:"column:last_time" json:"last_time"`
+}
+
+// TableName -
+func (t *TenantServiceScalingSchedules) TableName() string {
+	return "tenant_services_scaling_schedules"
+}
+
+// Location returns the location of the cron expression
+func (t *TenantServiceScalingSchedules) Location() (*time.Location, error) {
+	if t.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(t.Timezone)
+}
+
+// TenantServiceIdlePolicy scales the component to zero when the gateway sees no request in the idle timeout,
+// and scales it back up when a request arrives.
+type TenantServiceIdlePolicy struct {
+	Model
+	ServiceID string `gorm:"column:service_id;unique;size:32" json:"service_id"`
+	Enable    bool   `gorm:"column:enable" json:"enable"`
+	// seconds without any request before the component is scaled to zero
+	IdleTimeout int `gorm:"column:idle_timeout" json:"idle_timeout"`
+	// seconds the gateway holds a request while the component is scaling up
+	WakeTimeout int `gorm:"column:wake_timeout" json:"wake_timeout"`
+	// whether the component is scaled to zero by the policy
+	Idle bool `gorm:"column:idle" json:"idle"`
+	// the replicas before the component is scaled to zero, restored when it wakes up
+	Replicas int `gorm:"column:replicas" json:"replicas"`
+	// the last time the component is woken up or the policy is enabled,
+	// the component is not idle in the idle timeout after it.
+	ActiveTime time.Time `gorm:"column:active_time" json:"active_time"`
+}
+
+// TableName -
+func (t *TenantServiceIdlePolicy) TableName() string {
+	return "tenant_services_idle_policy"
+}
+
+// TenantImageScanPolicy blocks the deploys of the images which have vulnerabilities of the severity or above
+type TenantImageScanPolicy struct {
+	Model
+	TenantID string `gorm:"column:tenant_id;unique;size:32" json:"tenant_id"`
+	Enable   bool   `gorm:"column:enable" json:"enable"`
+	// the severity threshold: LOW, MEDIUM, HIGH or CRITICAL
+	Severity string `gorm:"column:severity;size:20" json:"severity"`
+}
+
+// TableName -
+func (t *TenantImageScanPolicy) TableName() string {
+	return "tenant_image_scan_policy"
+}
+
+// TenantLogRetentionPolicy how long and how much the container logs of the tenant components are retained,
+// 0 means the default retention of eventlog is used.
+type TenantLogRetentionPolicy struct {
+	Model
+	TenantID string `gorm:"column:tenant_id;unique;size:32" json:"tenant_id"`
+	// the days to retain the logs
+	MaxAgeDays int `gorm:"column:max_age_days" json:"max_age_days"`
+	// the max size(MB) of the logs of a component
+	MaxComponentSize int `gorm:"column:max_component_size" json:"max_component_size"`
+	// the max size(MB) of the logs of all components of the tenant, 0 means unlimited
+	MaxTotalSize int `gorm:"column:max_total_size" json:"max_total_size"`
+}
+
+// TableName -
+func (t *TenantLogRetentionPolicy) TableName() string {
+	return "tenant_log_retention_policy"
+}
+
 // ServiceID -
 type ServiceID struct {
 	ServiceID string `gorm:"column:service_id" json:"-"`
@@ -52,6 +52,13 @@ type VersionInfo struct {
 	FinalStatus string    `gorm:"column:final_status;size:40" json:"final_status"`
 	FinishTime  time.Time `gorm:"column:finish_time;" json:"finish_time"`
 	PlanVersion string  `gorm:"column:plan_version;size:250" json:"plan_version"`
+	//ScanStatus the vulnerability scanning status of the image
+	//passed: no vulnerability exceeds the scan policy of the tenant
+	//blocked: the version is not deployed because of the vulnerabilities
+	//failure: scan failure
+	ScanStatus string `gorm:"column:scan_status;size:20" json:"scan_status"`
+	//ScanReport the vulnerability scanning report of the image in json
+	ScanReport string `gorm:"column:scan_report;type:longtext" json:"-"`
 }
 
 //TableName 表名
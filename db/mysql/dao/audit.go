@@ -0,0 +1,112 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package dao
+
+import (
+	"time"
+
+	"github.com/goodrain/rainbond/db/model"
+	"github.com/jinzhu/gorm"
+)
+
+// AuditLogDaoImpl -
+type AuditLogDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (a *AuditLogDaoImpl) AddModel(mo model.Interface) error {
+	return a.DB.Create(mo.(*model.AuditLog)).Error
+}
+
+// UpdateModel the audit logs can not be changed
+func (a *AuditLogDaoImpl) UpdateModel(mo model.Interface) error {
+	return nil
+}
+
+func (a *AuditLogDaoImpl) filter(query *model.AuditLogQuery) *gorm.DB {
+	db := a.DB.Model(&model.AuditLog{})
+	if query == nil {
+		return db
+	}
+	for _, filter := range [][2]string{
+		{"actor", query.Actor},
+		{"operator", query.Operator},
+		{"tenant_id", query.TenantID},
+		{"tenant_name", query.TenantName},
+		{"resource_id", query.ResourceID},
+		{"action", query.Action},
+		{"result", query.Result},
+	} {
+		if filter[1] != "" {
+			db = db.Where(filter[0]+"=?", filter[1])
+		}
+	}
+	if query.Resource != "" {
+		db = db.Where("resource like ?", "%"+query.Resource+"%")
+	}
+	if !query.StartTime.IsZero() {
+		db = db.Where("create_time>=?", query.StartTime)
+	}
+	if !query.EndTime.IsZero() {
+		db = db.Where("create_time<?", query.EndTime)
+	}
+	return db
+}
+
+// ListAuditLogs returns the audit logs matching the query, the latest first
+func (a *AuditLogDaoImpl) ListAuditLogs(query *model.AuditLogQuery, offset, limit int) ([]*model.AuditLog, int, error) {
+	var logs []*model.AuditLog
+	var total int
+	db := a.filter(query)
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := db.Order("ID DESC").Offset(offset).Limit(limit).Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+// IterateAuditLogs calls fn with the audit logs matching the query in batches, from the oldest to the latest
+func (a *AuditLogDaoImpl) IterateAuditLogs(query *model.AuditLogQuery, batchSize int, fn func(logs []*model.AuditLog) error) error {
+	var lastID uint
+	for {
+		var logs []*model.AuditLog
+		if err := a.filter(query).Where("ID>?", lastID).Order("ID").Limit(batchSize).Find(&logs).Error; err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		if err := fn(logs); err != nil {
+			return err
+		}
+		if len(logs) < batchSize {
+			return nil
+		}
+		lastID = logs[len(logs)-1].ID
+	}
+}
+
+// DeleteAuditLogsBefore deletes the audit logs created before the time
+func (a *AuditLogDaoImpl) DeleteAuditLogsBefore(before time.Time) (int64, error) {
+	db := a.DB.Where("create_time<?", before).Delete(&model.AuditLog{})
+	return db.RowsAffected, db.Error
+}
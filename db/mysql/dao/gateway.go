@@ -133,6 +133,15 @@ func (c *RuleExtensionDaoImpl) GetRuleExtensionByRuleID(ruleID string) ([]*model
 	return ruleExtension, nil
 }
 
+// ListByKey lists the rule extensions of the key
+func (c *RuleExtensionDaoImpl) ListByKey(key string) ([]*model.RuleExtension, error) {
+	var ruleExtensions []*model.RuleExtension
+	if err := c.DB.Where("`key` = ?", key).Find(&ruleExtensions).Error; err != nil {
+		return nil, err
+	}
+	return ruleExtensions, nil
+}
+
 // DeleteRuleExtensionByRuleID delete rule extensions by ruleID
 func (c *RuleExtensionDaoImpl) DeleteRuleExtensionByRuleID(ruleID string) error {
 	re := &model.RuleExtension{
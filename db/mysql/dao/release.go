@@ -0,0 +1,158 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package dao
+
+import (
+	"time"
+
+	"github.com/goodrain/rainbond/db/errors"
+	"github.com/goodrain/rainbond/db/model"
+	"github.com/jinzhu/gorm"
+)
+
+// TenantServiceCanaryReleaseDaoImpl -
+type TenantServiceCanaryReleaseDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (t *TenantServiceCanaryReleaseDaoImpl) AddModel(mo model.Interface) error {
+	release := mo.(*model.TenantServiceCanaryRelease)
+	var old model.TenantServiceCanaryRelease
+	if ok := t.DB.Where("service_id=?", release.ServiceID).Find(&old).RecordNotFound(); ok {
+		release.UpdateTime = time.Now()
+		return t.DB.Create(release).Error
+	}
+	return errors.ErrRecordAlreadyExist
+}
+
+// UpdateModel -
+func (t *TenantServiceCanaryReleaseDaoImpl) UpdateModel(mo model.Interface) error {
+	release := mo.(*model.TenantServiceCanaryRelease)
+	release.UpdateTime = time.Now()
+	return t.DB.Save(release).Error
+}
+
+// GetByServiceID -
+func (t *TenantServiceCanaryReleaseDaoImpl) GetByServiceID(serviceID string) (*model.TenantServiceCanaryRelease, error) {
+	var release model.TenantServiceCanaryRelease
+	if err := t.DB.Where("service_id=?", serviceID).Find(&release).Error; err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// DeleteByServiceID -
+func (t *TenantServiceCanaryReleaseDaoImpl) DeleteByServiceID(serviceID string) error {
+	return t.DB.Where("service_id=?", serviceID).Delete(&model.TenantServiceCanaryRelease{}).Error
+}
+
+// ListDueReleases returns the progressing releases which should move to the next step before now
+func (t *TenantServiceCanaryReleaseDaoImpl) ListDueReleases(now time.Time) ([]*model.TenantServiceCanaryRelease, error) {
+	var releases []*model.TenantServiceCanaryRelease
+	if err := t.DB.Where("status=? and next_step_time<=?", model.CanaryReleaseStatusProgressing.String(), now).Find(&releases).Error; err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+// TenantServiceReleaseAnalysisDaoImpl -
+type TenantServiceReleaseAnalysisDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (t *TenantServiceReleaseAnalysisDaoImpl) AddModel(mo model.Interface) error {
+	analysis := mo.(*model.TenantServiceReleaseAnalysis)
+	var old model.TenantServiceReleaseAnalysis
+	if ok := t.DB.Where("service_id=?", analysis.ServiceID).Find(&old).RecordNotFound(); ok {
+		return t.DB.Create(analysis).Error
+	}
+	return errors.ErrRecordAlreadyExist
+}
+
+// UpdateModel -
+func (t *TenantServiceReleaseAnalysisDaoImpl) UpdateModel(mo model.Interface) error {
+	analysis := mo.(*model.TenantServiceReleaseAnalysis)
+	return t.DB.Save(analysis).Error
+}
+
+// GetByServiceID -
+func (t *TenantServiceReleaseAnalysisDaoImpl) GetByServiceID(serviceID string) (*model.TenantServiceReleaseAnalysis, error) {
+	var analysis model.TenantServiceReleaseAnalysis
+	if err := t.DB.Where("service_id=?", serviceID).Find(&analysis).Error; err != nil {
+		return nil, err
+	}
+	return &analysis, nil
+}
+
+// DeleteByServiceID -
+func (t *TenantServiceReleaseAnalysisDaoImpl) DeleteByServiceID(serviceID string) error {
+	return t.DB.Where("service_id=?", serviceID).Delete(&model.TenantServiceReleaseAnalysis{}).Error
+}
+
+// TenantServiceReleaseHealthRecordsDaoImpl -
+type TenantServiceReleaseHealthRecordsDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (t *TenantServiceReleaseHealthRecordsDaoImpl) AddModel(mo model.Interface) error {
+	record := mo.(*model.TenantServiceReleaseHealthRecords)
+	return t.DB.Create(record).Error
+}
+
+// UpdateModel -
+func (t *TenantServiceReleaseHealthRecordsDaoImpl) UpdateModel(mo model.Interface) error {
+	record := mo.(*model.TenantServiceReleaseHealthRecords)
+	return t.DB.Save(record).Error
+}
+
+// ListByServiceID -
+func (t *TenantServiceReleaseHealthRecordsDaoImpl) ListByServiceID(serviceID string, offset, limit int) ([]*model.TenantServiceReleaseHealthRecords, error) {
+	var records []*model.TenantServiceReleaseHealthRecords
+	if err := t.DB.Where("service_id=?", serviceID).Offset(offset).Limit(limit).Order("start_time desc").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// ListByStatus -
+func (t *TenantServiceReleaseHealthRecordsDaoImpl) ListByStatus(status string) ([]*model.TenantServiceReleaseHealthRecords, error) {
+	var records []*model.TenantServiceReleaseHealthRecords
+	if err := t.DB.Where("status=?", status).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// CountByServiceID -
+func (t *TenantServiceReleaseHealthRecordsDaoImpl) CountByServiceID(serviceID string) (int, error) {
+	record := model.TenantServiceReleaseHealthRecords{}
+	var count int
+	if err := t.DB.Table(record.TableName()).Where("service_id=?", serviceID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteByServiceID -
+func (t *TenantServiceReleaseHealthRecordsDaoImpl) DeleteByServiceID(serviceID string) error {
+	return t.DB.Where("service_id=?", serviceID).Delete(&model.TenantServiceReleaseHealthRecords{}).Error
+}
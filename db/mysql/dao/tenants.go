@@ -2120,6 +2120,198 @@ func (t *TenantServiceScalingRecordsDaoImpl) CountByServiceID(serviceID string)
 	return count, nil
 }
 
+// TenantServiceScalingSchedulesDaoImpl -
+type TenantServiceScalingSchedulesDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (t *TenantServiceScalingSchedulesDaoImpl) AddModel(mo model.Interface) error {
+	schedule := mo.(*model.TenantServiceScalingSchedules)
+	var old model.TenantServiceScalingSchedules
+	if ok := t.DB.Where("schedule_id=?", schedule.ScheduleID).Find(&old).RecordNotFound(); ok {
+		return t.DB.Create(schedule).Error
+	}
+	return errors.ErrRecordAlreadyExist
+}
+
+// UpdateModel -
+func (t *TenantServiceScalingSchedulesDaoImpl) UpdateModel(mo model.Interface) error {
+	schedule := mo.(*model.TenantServiceScalingSchedules)
+	return t.DB.Save(schedule).Error
+}
+
+// GetByScheduleID -
+func (t *TenantServiceScalingSchedulesDaoImpl) GetByScheduleID(scheduleID string) (*model.TenantServiceScalingSchedules, error) {
+	var schedule model.TenantServiceScalingSchedules
+	if err := t.DB.Where("schedule_id=?", scheduleID).Find(&schedule).Error; err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// ListByServiceID -
+func (t *TenantServiceScalingSchedulesDaoImpl) ListByServiceID(serviceID string) ([]*model.TenantServiceScalingSchedules, error) {
+	var schedules []*model.TenantServiceScalingSchedules
+	if err := t.DB.Where("service_id=?", serviceID).Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// ListEnableOnes -
+func (t *TenantServiceScalingSchedulesDaoImpl) ListEnableOnes() ([]*model.TenantServiceScalingSchedules, error) {
+	var schedules []*model.TenantServiceScalingSchedules
+	if err := t.DB.Where("enable=?", true).Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// DeleteByScheduleID -
+func (t *TenantServiceScalingSchedulesDaoImpl) DeleteByScheduleID(scheduleID string) error {
+	return t.DB.Where("schedule_id=?", scheduleID).Delete(&model.TenantServiceScalingSchedules{}).Error
+}
+
+// DeleteByServiceID -
+func (t *TenantServiceScalingSchedulesDaoImpl) DeleteByServiceID(serviceID string) error {
+	return t.DB.Where("service_id=?", serviceID).Delete(&model.TenantServiceScalingSchedules{}).Error
+}
+
+// TenantServiceIdlePolicyDaoImpl -
+type TenantServiceIdlePolicyDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (t *TenantServiceIdlePolicyDaoImpl) AddModel(mo model.Interface) error {
+	policy := mo.(*model.TenantServiceIdlePolicy)
+	var old model.TenantServiceIdlePolicy
+	if ok := t.DB.Where("service_id=?", policy.ServiceID).Find(&old).RecordNotFound(); ok {
+		return t.DB.Create(policy).Error
+	}
+	return errors.ErrRecordAlreadyExist
+}
+
+// UpdateModel -
+func (t *TenantServiceIdlePolicyDaoImpl) UpdateModel(mo model.Interface) error {
+	policy := mo.(*model.TenantServiceIdlePolicy)
+	return t.DB.Save(policy).Error
+}
+
+// GetByServiceID -
+func (t *TenantServiceIdlePolicyDaoImpl) GetByServiceID(serviceID string) (*model.TenantServiceIdlePolicy, error) {
+	var policy model.TenantServiceIdlePolicy
+	if err := t.DB.Where("service_id=?", serviceID).Find(&policy).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// ListEnableOnes -
+func (t *TenantServiceIdlePolicyDaoImpl) ListEnableOnes() ([]*model.TenantServiceIdlePolicy, error) {
+	var policies []*model.TenantServiceIdlePolicy
+	if err := t.DB.Where("enable=?", true).Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// Wake -
+func (t *TenantServiceIdlePolicyDaoImpl) Wake(serviceID string) (bool, error) {
+	// only one of the concurrent wake requests changes the row
+	res := t.DB.Model(&model.TenantServiceIdlePolicy{}).Where("service_id=? and idle=?", serviceID, true).
+		Updates(map[string]interface{}{"idle": false, "active_time": time.Now()})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteByServiceID -
+func (t *TenantServiceIdlePolicyDaoImpl) DeleteByServiceID(serviceID string) error {
+	return t.DB.Where("service_id=?", serviceID).Delete(&model.TenantServiceIdlePolicy{}).Error
+}
+
+// TenantImageScanPolicyDaoImpl -
+type TenantImageScanPolicyDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (t *TenantImageScanPolicyDaoImpl) AddModel(mo model.Interface) error {
+	policy := mo.(*model.TenantImageScanPolicy)
+	var old model.TenantImageScanPolicy
+	if ok := t.DB.Where("tenant_id=?", policy.TenantID).Find(&old).RecordNotFound(); ok {
+		return t.DB.Create(policy).Error
+	}
+	return errors.ErrRecordAlreadyExist
+}
+
+// UpdateModel -
+func (t *TenantImageScanPolicyDaoImpl) UpdateModel(mo model.Interface) error {
+	policy := mo.(*model.TenantImageScanPolicy)
+	return t.DB.Save(policy).Error
+}
+
+// GetByTenantID -
+func (t *TenantImageScanPolicyDaoImpl) GetByTenantID(tenantID string) (*model.TenantImageScanPolicy, error) {
+	var policy model.TenantImageScanPolicy
+	if err := t.DB.Where("tenant_id=?", tenantID).Find(&policy).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// DeleteByTenantID -
+func (t *TenantImageScanPolicyDaoImpl) DeleteByTenantID(tenantID string) error {
+	return t.DB.Where("tenant_id=?", tenantID).Delete(&model.TenantImageScanPolicy{}).Error
+}
+
+// TenantLogRetentionPolicyDaoImpl -
+type TenantLogRetentionPolicyDaoImpl struct {
+	DB *gorm.DB
+}
+
+// AddModel -
+func (t *TenantLogRetentionPolicyDaoImpl) AddModel(mo model.Interface) error {
+	policy := mo.(*model.TenantLogRetentionPolicy)
+	var old model.TenantLogRetentionPolicy
+	if ok := t.DB.Where("tenant_id=?", policy.TenantID).Find(&old).RecordNotFound(); ok {
+		return t.DB.Create(policy).Error
+	}
+	return errors.ErrRecordAlreadyExist
+}
+
+// UpdateModel -
+func (t *TenantLogRetentionPolicyDaoImpl) UpdateModel(mo model.Interface) error {
+	policy := mo.(*model.TenantLogRetentionPolicy)
+	return t.DB.Save(policy).Error
+}
+
+// GetByTenantID -
+func (t *TenantLogRetentionPolicyDaoImpl) GetByTenantID(tenantID string) (*model.TenantLogRetentionPolicy, error) {
+	var policy model.TenantLogRetentionPolicy
+	if err := t.DB.Where("tenant_id=?", tenantID).Find(&policy).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// List -
+func (t *TenantLogRetentionPolicyDaoImpl) List() ([]*model.TenantLogRetentionPolicy, error) {
+	var policies []*model.TenantLogRetentionPolicy
+	if err := t.DB.Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// DeleteByTenantID -
+func (t *TenantLogRetentionPolicyDaoImpl) DeleteByTenantID(tenantID string) error {
+	return t.DB.Where("tenant_id=?", tenantID).Delete(&model.TenantLogRetentionPolicy{}).Error
+}
+
 // ComponentK8sAttributeDaoImpl The K8s attribute value of the component
 type ComponentK8sAttributeDaoImpl struct {
 	DB *gorm.DB
@@ -634,6 +634,90 @@ func (m *Manager) TenantServiceScalingRecordsDaoTransactions(db *gorm.DB) dao.Te
 	}
 }
 
+// TenantServiceScalingSchedulesDao -
+func (m *Manager) TenantServiceScalingSchedulesDao() dao.TenantServiceScalingSchedulesDao {
+	return &mysqldao.TenantServiceScalingSchedulesDaoImpl{
+		DB: m.db,
+	}
+}
+
+// TenantServiceScalingSchedulesDaoTransactions -
+func (m *Manager) TenantServiceScalingSchedulesDaoTransactions(db *gorm.DB) dao.TenantServiceScalingSchedulesDao {
+	return &mysqldao.TenantServiceScalingSchedulesDaoImpl{
+		DB: db,
+	}
+}
+
+// TenantServiceIdlePolicyDao -
+func (m *Manager) TenantServiceIdlePolicyDao() dao.TenantServiceIdlePolicyDao {
+	return &mysqldao.TenantServiceIdlePolicyDaoImpl{
+		DB: m.db,
+	}
+}
+
+// TenantServiceIdlePolicyDaoTransactions -
+func (m *Manager) TenantServiceIdlePolicyDaoTransactions(db *gorm.DB) dao.TenantServiceIdlePolicyDao {
+	return &mysqldao.TenantServiceIdlePolicyDaoImpl{
+		DB: db,
+	}
+}
+
+// TenantImageScanPolicyDao -
+func (m *Manager) TenantImageScanPolicyDao() dao.TenantImageScanPolicyDao {
+	return &mysqldao.TenantImageScanPolicyDaoImpl{
+		DB: m.db,
+	}
+}
+
+// TenantLogRetentionPolicyDao -
+func (m *Manager) TenantLogRetentionPolicyDao() dao.TenantLogRetentionPolicyDao {
+	return &mysqldao.TenantLogRetentionPolicyDaoImpl{
+		DB: m.db,
+	}
+}
+
+// TenantServiceCanaryReleaseDao -
+func (m *Manager) TenantServiceCanaryReleaseDao() dao.TenantServiceCanaryReleaseDao {
+	return &mysqldao.TenantServiceCanaryReleaseDaoImpl{
+		DB: m.db,
+	}
+}
+
+// TenantServiceCanaryReleaseDaoTransactions -
+func (m *Manager) TenantServiceCanaryReleaseDaoTransactions(db *gorm.DB) dao.TenantServiceCanaryReleaseDao {
+	return &mysqldao.TenantServiceCanaryReleaseDaoImpl{
+		DB: db,
+	}
+}
+
+// TenantServiceReleaseAnalysisDao -
+func (m *Manager) TenantServiceReleaseAnalysisDao() dao.TenantServiceReleaseAnalysisDao {
+	return &mysqldao.TenantServiceReleaseAnalysisDaoImpl{
+		DB: m.db,
+	}
+}
+
+// TenantServiceReleaseAnalysisDaoTransactions -
+func (m *Manager) TenantServiceReleaseAnalysisDaoTransactions(db *gorm.DB) dao.TenantServiceReleaseAnalysisDao {
+	return &mysqldao.TenantServiceReleaseAnalysisDaoImpl{
+		DB: db,
+	}
+}
+
+// TenantServiceReleaseHealthRecordsDao -
+func (m *Manager) TenantServiceReleaseHealthRecordsDao() dao.TenantServiceReleaseHealthRecordsDao {
+	return &mysqldao.TenantServiceReleaseHealthRecordsDaoImpl{
+		DB: m.db,
+	}
+}
+
+// TenantServiceReleaseHealthRecordsDaoTransactions -
+func (m *Manager) TenantServiceReleaseHealthRecordsDaoTransactions(db *gorm.DB) dao.TenantServiceReleaseHealthRecordsDao {
+	return &mysqldao.TenantServiceReleaseHealthRecordsDaoImpl{
+		DB: db,
+	}
+}
+
 //TenantServiceMonitorDao monitor dao
 func (m *Manager) TenantServiceMonitorDao() dao.TenantServiceMonitorDao {
 	return &mysqldao.TenantServiceMonitorDaoImpl{
@@ -675,3 +759,10 @@ func (m *Manager) K8sResourceDaoTransactions(db *gorm.DB) dao.K8sResourceDao {
 		DB: db,
 	}
 }
+
+// AuditLogDao -
+func (m *Manager) AuditLogDao() dao.AuditLogDao {
+	return &mysqldao.AuditLogDaoImpl{
+		DB: m.db,
+	}
+}
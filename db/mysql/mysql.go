@@ -19,10 +19,12 @@
 package mysql
 
 import (
+	"fmt"
 	"os"
 	"sync"
 
 	"github.com/goodrain/rainbond/db/config"
+	"github.com/goodrain/rainbond/db/migrate"
 	"github.com/goodrain/rainbond/db/model"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
@@ -42,6 +44,24 @@ type Manager struct {
 
 //CreateManager create manager
 func CreateManager(config config.Config) (*Manager, error) {
+	db, err := OpenDB(config)
+	if err != nil {
+		return nil, err
+	}
+	manager := &Manager{
+		db:      db,
+		config:  config,
+		initOne: sync.Once{},
+	}
+	db.SetLogger(manager)
+	manager.RegisterTableModel()
+	manager.CheckTable()
+	logrus.Debug("mysql db driver create")
+	return manager, nil
+}
+
+//OpenDB opens the region database of the db type
+func OpenDB(config config.Config) (*gorm.DB, error) {
 	var db *gorm.DB
 	if config.DBType == "mysql" {
 		var err error
@@ -74,19 +94,13 @@ func CreateManager(config config.Config) (*Manager, error) {
 		}
 		db.Exec("PRAGMA journal_mode = WAL")
 	}
+	if db == nil {
+		return nil, fmt.Errorf("db type %s not supported", config.DBType)
+	}
 	if config.ShowSQL {
 		db = db.Debug()
 	}
-	manager := &Manager{
-		db:      db,
-		config:  config,
-		initOne: sync.Once{},
-	}
-	db.SetLogger(manager)
-	manager.RegisterTableModel()
-	manager.CheckTable()
-	logrus.Debug("mysql db driver create")
-	return manager, nil
+	return db, nil
 }
 
 //CloseManager 关闭管理器
@@ -171,9 +185,17 @@ func (m *Manager) RegisterTableModel() {
 	m.models = append(m.models, &model.TenantServiceAutoscalerRules{})
 	m.models = append(m.models, &model.TenantServiceAutoscalerRuleMetrics{})
 	m.models = append(m.models, &model.TenantServiceScalingRecords{})
+	m.models = append(m.models, &model.TenantServiceScalingSchedules{})
+	m.models = append(m.models, &model.TenantServiceIdlePolicy{})
+	m.models = append(m.models, &model.TenantImageScanPolicy{})
+	m.models = append(m.models, &model.TenantLogRetentionPolicy{})
+	m.models = append(m.models, &model.TenantServiceCanaryRelease{})
+	m.models = append(m.models, &model.TenantServiceReleaseAnalysis{})
+	m.models = append(m.models, &model.TenantServiceReleaseHealthRecords{})
 	m.models = append(m.models, &model.TenantServiceMonitor{})
 	m.models = append(m.models, &model.ComponentK8sAttributes{})
 	m.models = append(m.models, &model.K8sResource{})
+	m.models = append(m.models, &model.AuditLog{})
 }
 
 //CheckTable check and create tables
@@ -211,35 +233,13 @@ func (m *Manager) CheckTable() {
 				}
 			}
 		}
-		m.patchTable()
+		m.migrate()
 	})
 }
 
-func (m *Manager) patchTable() {
-	if m.config.DBType == "sqlite" {
-		return
-	}
-	if err := m.db.Exec("alter table tenant_services_envs modify column attr_value text;").Error; err != nil {
-		logrus.Errorf("alter table tenant_services_envs error %s", err.Error())
-	}
-
-	if err := m.db.Exec("alter table tenant_services_event modify column request_body varchar(1024);").Error; err != nil {
-		logrus.Errorf("alter table tenant_services_envent error %s", err.Error())
-	}
-
-	if err := m.db.Exec("update gateway_tcp_rule set ip=? where ip=?", "0.0.0.0", "").Error; err != nil {
-		logrus.Errorf("update gateway_tcp_rule data error %s", err.Error())
-	}
-	if err := m.db.Exec("alter table tenant_services_volume modify column volume_type varchar(64);").Error; err != nil {
-		logrus.Errorf("alter table tenant_services_volume error: %s", err.Error())
-	}
-	if err := m.db.Exec("update tenants set namespace=uuid where namespace is NULL;").Error; err != nil {
-		logrus.Errorf("update tenants namespace error: %s", err.Error())
-	}
-	if err := m.db.Exec("update applications set k8s_app=concat('app-',LEFT(app_id,8)) where k8s_app is NULL;").Error; err != nil {
-		logrus.Errorf("update tenants namespace error: %s", err.Error())
-	}
-	if err := m.db.Exec("update tenant_services set k8s_component_name=service_alias where k8s_component_name is NULL;").Error; err != nil {
-		logrus.Errorf("update tenants namespace error: %s", err.Error())
+//migrate applies the migrations not applied yet, see package migrate
+func (m *Manager) migrate() {
+	if _, err := migrate.NewMigrator(m.db, m.config.DBType).Up(0, false); err != nil {
+		logrus.Errorf("migrate the region database error: %v", err)
 	}
 }
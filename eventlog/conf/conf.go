@@ -45,6 +45,8 @@ type DBConf struct {
 	PoolSize    int
 	PoolMaxSize int
 	HomePath    string
+	// the compression of the rotated component log files, gzip or zstd
+	Compression string
 }
 
 // WebSocketConf websocket conf
@@ -132,6 +134,19 @@ type EventStoreConf struct {
 	HandleSubMessageCoreNumber  int
 	HandleDockerLogCoreNumber   int
 	DB                          DBConf
+	LogRetention                LogRetentionConf
+}
+
+// LogRetentionConf the default retention of the component logs, which is overridden by the tenant policy
+type LogRetentionConf struct {
+	// the days to retain the logs
+	MaxAgeDays int
+	// the max size(MB) of the logs of a component
+	MaxComponentSize int64
+	// the max size(MB) of the logs of all components
+	DiskBudget int64
+	// the interval to apply the retention
+	Interval time.Duration
 }
 
 // KubernetsConf kubernetes conf
@@ -25,7 +25,7 @@ import (
 
 func TestEventFileSaveMessage(t *testing.T) {
 	eventFilePlugin := EventFilePlugin{
-		HomePath: "./test",
+		HomePath: t.TempDir(),
 	}
 	if err := eventFilePlugin.SaveMessage([]*EventLogMessage{
 		&EventLogMessage{
@@ -19,12 +19,10 @@
 package db
 
 import (
-	"archive/zip"
 	"bufio"
 	"bytes"
 	"crypto/sha256"
 	"fmt"
-	"io"
 	"os"
 	"os/exec"
 	"path"
@@ -45,6 +43,9 @@ const (
 
 type filePlugin struct {
 	homePath string
+	index    *LogIndex
+	// the compression of the rotated log files
+	compression string
 }
 
 func (m *filePlugin) getStdFilePath(serviceID string) (string, error) {
@@ -93,10 +94,12 @@ func (m *filePlugin) SaveMessage(events []*EventLogMessage) error {
 		}
 	} else {
 		if logFile.ModTime().Day() != time.Now().Day() {
-			err := MvLogFile(fmt.Sprintf("%s/%d-%d-%d.log.gz", filePathDir, logFile.ModTime().Year(), logFile.ModTime().Month(), logFile.ModTime().Day()), stdoutLogPath)
+			err := MvLogFile(rotatedLogPath(filePathDir, logFile.ModTime(), m.compression), stdoutLogPath)
 			if err != nil {
 				return err
 			}
+			// the log file is empty after rotation
+			logFile = nil
 		}
 	}
 	if logfile == nil {
@@ -113,6 +116,11 @@ func (m *filePlugin) SaveMessage(events []*EventLogMessage) error {
 		}
 	}
 
+	if m.index != nil {
+		if err := m.index.Append(key, events); err != nil {
+			logrus.Errorf("[SaveMessage]: index the logs of %s failed %v", key, err)
+		}
+	}
 	var content [][]byte
 	for _, e := range events {
 		content = append(content, e.Content)
@@ -120,16 +128,16 @@ func (m *filePlugin) SaveMessage(events []*EventLogMessage) error {
 	body := bytes.Join(content, []byte("\n"))
 	body = append(body, []byte("\n")...)
 	if logFile != nil && logFile.Size() > int64(logMaxSize) {
-		legacyLogPath := path.Join(filePathDir, "stdout-legacy.log")
-		err = os.Rename(stdoutLogPath, legacyLogPath)
-		if err != nil {
-			logrus.Errorf("[Savemessage]: Rename %v to %v failed %v", stdoutLogPath, legacyLogPath, err)
-			return err
-		}
 		if logfile != nil {
 			logfile.Close()
 		}
-		logfile, err = os.OpenFile(stdoutLogPath, os.O_WRONLY|os.O_CREATE, 0666)
+		rotatedPath := rotatedLogPath(filePathDir, logFile.ModTime(), m.compression)
+		err = MvLogFile(rotatedPath, stdoutLogPath)
+		if err != nil {
+			logrus.Errorf("[Savemessage]: Rotate %v to %v failed %v", stdoutLogPath, rotatedPath, err)
+			return err
+		}
+		logfile, err = os.OpenFile(stdoutLogPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0666)
 		if err != nil {
 			return err
 		}
@@ -172,9 +180,26 @@ func (m *filePlugin) GetMessages(serviceID, level string, length int) (interface
 		}
 		lines = append(lines, string(line))
 	}
+	// the earlier lines are read from the rotated log files, which may be compressed
+	for _, history := range historyLogFiles(filePathDir) {
+		if len(lines) >= length {
+			break
+		}
+		older, err := tailLines(history, length-len(lines))
+		if err != nil {
+			logrus.Warningf("read history log file %s: %v", history, err)
+			break
+		}
+		lines = append(older, lines...)
+	}
 	return lines, nil
 }
 
+//SearchMessages searches the indexed logs of the component
+func (m *filePlugin) SearchMessages(serviceID string, query *LogQuery) (*LogSearchResult, error) {
+	return m.index.Search(serviceID, query)
+}
+
 func (m *filePlugin) Close() error {
 	return nil
 }
@@ -194,36 +219,12 @@ func GetServiceAliasID(ServiceID string) string {
 }
 
 //MvLogFile 更改文件名称，压缩
+//the log file is compressed into the new file, then it is emptied
 func MvLogFile(newName string, filePath string) error {
-	info, err := os.Stat(filePath)
-	if err != nil {
-		return err
-	}
-	reader, err := os.OpenFile(filePath, os.O_RDONLY, 0666)
-	if err != nil {
-		return err
-	}
-	// 将压缩文档内容写入文件
-	f, err := os.OpenFile(newName, os.O_CREATE|os.O_WRONLY, 0666)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	zw := zip.NewWriter(f)
-	defer zw.Close()
-	header, err := zip.FileInfoHeader(info)
-	if err != nil {
-		return err
-	}
-	writer, err := zw.CreateHeader(header)
-	if err != nil {
-		return err
-	}
-	_, err = io.Copy(writer, reader)
-	if err != nil {
+	if err := CompressLogFile(newName, filePath); err != nil {
 		return err
 	}
-	err = os.Remove(filePath)
+	err := os.Remove(filePath)
 	if err != nil {
 		return err
 	}
@@ -23,8 +23,12 @@ import "testing"
 func TestFileSaveMessage(t *testing.T) {
 
 	f := filePlugin{
-		homePath: "./test",
+		homePath: t.TempDir(),
 	}
+	saveTestMessages(t, f)
+}
+
+func saveTestMessages(t *testing.T, f filePlugin) {
 	m := &EventLogMessage{EventID: "qwertyuiopasdfghjkl"}
 	m.Content = []byte("do you under stand")
 	mes := []*EventLogMessage{m}
@@ -45,8 +49,9 @@ func TestMvLogFile(t *testing.T) {
 
 func TestGetMessages(t *testing.T) {
 	f := filePlugin{
-		homePath: "./test",
+		homePath: t.TempDir(),
 	}
+	saveTestMessages(t, f)
 	logs, err := f.GetMessages("qwertyuiopasdfghjkl", "", 10)
 	if err != nil {
 		t.Fatal(err)
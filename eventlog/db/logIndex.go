@@ -0,0 +1,504 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const (
+	// the layout of the name of the hourly segment
+	segmentLayout = "2006010215"
+	// a mark of time and offset is recorded every markInterval lines, to seek to the start time
+	markInterval = 1024
+	bloomBits    = 1 << 18
+	bloomHashes  = 4
+	// the max length of the indexed word
+	maxTokenLength = 64
+)
+
+//LogQuery the query of the component logs
+type LogQuery struct {
+	Start time.Time
+	End   time.Time
+	// the words must be all in the line, case insensitively
+	Keyword string
+	Regex   *regexp.Regexp
+	// the short ids of the containers, the logs of all containers are returned if it is empty
+	ContainerIDs []string
+	Page         int
+	PageSize     int
+}
+
+//LogRecord a log line of the search result
+type LogRecord struct {
+	Time        time.Time `json:"time"`
+	ContainerID string    `json:"container_id"`
+	Message     string    `json:"message"`
+}
+
+//LogSearchResult the search result of the component logs
+type LogSearchResult struct {
+	List     []*LogRecord `json:"list"`
+	Total    int          `json:"total"`
+	Page     int          `json:"page"`
+	PageSize int          `json:"page_size"`
+}
+
+//LogSearcher searches the indexed component logs
+type LogSearcher interface {
+	SearchMessages(serviceID string, query *LogQuery) (*LogSearchResult, error)
+}
+
+// segmentIndex the index of a segment, which is saved beside the segment
+type segmentIndex struct {
+	Lines      int        `json:"lines"`
+	Size       int64      `json:"size"`
+	MinTime    int64      `json:"min_time"`
+	MaxTime    int64      `json:"max_time"`
+	Containers []string   `json:"containers"`
+	Marks      [][2]int64 `json:"marks"`
+	Bloom      []byte     `json:"bloom"`
+}
+
+func (s *segmentIndex) addToken(token string) {
+	for _, bit := range bloomPositions(token) {
+		s.Bloom[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+func (s *segmentIndex) mayContain(token string) bool {
+	for _, bit := range bloomPositions(token) {
+		if s.Bloom[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *segmentIndex) hasContainer(ids []string) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, c := range s.Containers {
+		if matchContainer(c, ids) {
+			return true
+		}
+	}
+	return false
+}
+
+func bloomPositions(token string) [bloomHashes]uint32 {
+	h := fnv.New64a()
+	h.Write([]byte(token))
+	sum := h.Sum64()
+	h1, h2 := uint32(sum), uint32(sum>>32)|1
+	var positions [bloomHashes]uint32
+	for i := range positions {
+		positions[i] = (h1 + uint32(i)*h2) % bloomBits
+	}
+	return positions
+}
+
+// tokenize splits the text into lowercase words, every han character is a word,
+// so that the chinese text can be searched without segmentation.
+func tokenize(text string) []string {
+	var tokens []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			token := word.String()
+			if len(token) > maxTokenLength {
+				token = token[:maxTokenLength]
+			}
+			tokens = append(tokens, token)
+			word.Reset()
+		}
+	}
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func matchContainer(containerID string, ids []string) bool {
+	for _, id := range ids {
+		if id != "" && (strings.HasPrefix(containerID, id) || strings.HasPrefix(id, containerID)) {
+			return true
+		}
+	}
+	return false
+}
+
+// LogIndex indexes the component logs in hourly segments. A line of the segment is
+// "<unix nano>\t<container id>\t<log>", the index of the segment records the time range,
+// the containers, the time marks and a bloom filter of the words to skip the segments
+// and the lines which can not match the query.
+type LogIndex struct {
+	homePath string
+	lock     sync.Mutex
+}
+
+//NewLogIndex creates a log index in the home path of the component logs
+func NewLogIndex(homePath string) *LogIndex {
+	return &LogIndex{homePath: homePath}
+}
+
+func (l *LogIndex) indexPath(serviceID string) string {
+	return path.Join(l.homePath, GetServiceAliasID(serviceID), "index")
+}
+
+func loadSegmentIndex(segmentPath string) (*segmentIndex, error) {
+	body, err := ioutil.ReadFile(strings.TrimSuffix(segmentPath, ".seg") + ".idx")
+	if err != nil {
+		return nil, err
+	}
+	var idx segmentIndex
+	if err := json.Unmarshal(body, &idx); err != nil {
+		return nil, err
+	}
+	if len(idx.Bloom) != bloomBits/8 {
+		return nil, fmt.Errorf("invalid bloom filter in the index of %s", segmentPath)
+	}
+	return &idx, nil
+}
+
+func saveSegmentIndex(segmentPath string, idx *segmentIndex) error {
+	body, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	indexPath := strings.TrimSuffix(segmentPath, ".seg") + ".idx"
+	if err := ioutil.WriteFile(indexPath+".tmp", body, 0644); err != nil {
+		return err
+	}
+	return os.Rename(indexPath+".tmp", indexPath)
+}
+
+// parseDockerLogMessage parses the message of the docker log store, the content of which
+// is "<container id>:<log>", and the time is set when it is received.
+func parseDockerLogMessage(message *EventLogMessage) (time.Time, string, string) {
+	t, err := time.Parse(time.RFC3339Nano, message.Time)
+	if err != nil {
+		t = time.Now()
+	}
+	content := string(message.Content)
+	containerID := ""
+	if i := strings.IndexByte(content, ':'); i > 0 && i <= 64 {
+		containerID, content = content[:i], content[i+1:]
+	}
+	content = strings.TrimRight(content, "\r\n")
+	return t, containerID, strings.Replace(content, "\n", " ", -1)
+}
+
+//Append indexes the log messages of the component
+func (l *LogIndex) Append(serviceID string, messages []*EventLogMessage) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	dir := l.indexPath(serviceID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	var (
+		segment string
+		file    *os.File
+		writer  *bufio.Writer
+		idx     *segmentIndex
+		finish  = func() error {
+			if file == nil {
+				return nil
+			}
+			defer file.Close()
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			return saveSegmentIndex(segment, idx)
+		}
+	)
+	for _, message := range messages {
+		t, containerID, content := parseDockerLogMessage(message)
+		if len(content) == 0 {
+			continue
+		}
+		name := path.Join(dir, t.UTC().Format(segmentLayout)+".seg")
+		if name != segment {
+			if err := finish(); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+			if err != nil {
+				return err
+			}
+			stat, err := f.Stat()
+			if err != nil {
+				f.Close()
+				return err
+			}
+			idx, err = loadSegmentIndex(name)
+			if err != nil || idx.Size != stat.Size() {
+				// the index is lost or not complete, the bloom filter does not cover all lines
+				// any more, so it is rebuilt.
+				if idx, err = rebuildSegmentIndex(name); err != nil {
+					f.Close()
+					return err
+				}
+			}
+			segment, file, writer = name, f, bufio.NewWriter(f)
+		}
+		ts := t.UnixNano()
+		if idx.Lines%markInterval == 0 {
+			idx.Marks = append(idx.Marks, [2]int64{ts, idx.Size})
+		}
+		if idx.Lines == 0 || ts < idx.MinTime {
+			idx.MinTime = ts
+		}
+		if ts > idx.MaxTime {
+			idx.MaxTime = ts
+		}
+		if containerID != "" && !matchContainer(containerID, idx.Containers) {
+			idx.Containers = append(idx.Containers, containerID)
+		}
+		for _, token := range tokenize(content) {
+			idx.addToken(token)
+		}
+		line := strconv.FormatInt(ts, 10) + "\t" + containerID + "\t" + content + "\n"
+		if _, err := writer.WriteString(line); err != nil {
+			file.Close()
+			return err
+		}
+		idx.Size += int64(len(line))
+		idx.Lines++
+	}
+	return finish()
+}
+
+// rebuildSegmentIndex builds the index of the segment by its lines
+func rebuildSegmentIndex(segmentPath string) (*segmentIndex, error) {
+	idx := &segmentIndex{Bloom: make([]byte, bloomBits/8)}
+	f, err := os.Open(segmentPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// the last line without new line is not complete
+			break
+		}
+		if ts, containerID, content, ok := parseSegmentLine(line); ok {
+			if idx.Lines%markInterval == 0 {
+				idx.Marks = append(idx.Marks, [2]int64{ts, idx.Size})
+			}
+			if idx.Lines == 0 || ts < idx.MinTime {
+				idx.MinTime = ts
+			}
+			if ts > idx.MaxTime {
+				idx.MaxTime = ts
+			}
+			if containerID != "" && !matchContainer(containerID, idx.Containers) {
+				idx.Containers = append(idx.Containers, containerID)
+			}
+			for _, token := range tokenize(content) {
+				idx.addToken(token)
+			}
+			idx.Lines++
+		}
+		idx.Size += int64(len(line))
+	}
+	// drop the incomplete line, so that the new lines are appended after the complete ones
+	if err := os.Truncate(segmentPath, idx.Size); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+func parseSegmentLine(line string) (int64, string, string, bool) {
+	line = strings.TrimSuffix(line, "\n")
+	parts := strings.SplitN(line, "\t", 3)
+	if len(parts) != 3 {
+		return 0, "", "", false
+	}
+	ts, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", "", false
+	}
+	return ts, parts[1], parts[2], true
+}
+
+//Search searches the logs of the component in the time order
+func (l *LogIndex) Search(serviceID string, query *LogQuery) (*LogSearchResult, error) {
+	result := &LogSearchResult{Page: query.Page, PageSize: query.PageSize, List: []*LogRecord{}}
+	segments, err := l.segments(serviceID, query.Start, query.End)
+	if err != nil {
+		return nil, err
+	}
+	keywords := tokenize(query.Keyword)
+	skip := (query.Page - 1) * query.PageSize
+	match := func(ts int64, containerID, content string) bool {
+		if ts < query.Start.UnixNano() || ts > query.End.UnixNano() {
+			return false
+		}
+		if len(query.ContainerIDs) > 0 && !matchContainer(containerID, query.ContainerIDs) {
+			return false
+		}
+		if len(keywords) > 0 {
+			words := make(map[string]struct{})
+			for _, token := range tokenize(content) {
+				words[token] = struct{}{}
+			}
+			for _, keyword := range keywords {
+				if _, ok := words[keyword]; !ok {
+					return false
+				}
+			}
+		}
+		return query.Regex == nil || query.Regex.MatchString(content)
+	}
+	for _, segment := range segments {
+		offset, ok := l.prune(segment, query, keywords)
+		if !ok {
+			continue
+		}
+		err := scanSegment(segment, offset, func(ts int64, containerID, content string) {
+			if !match(ts, containerID, content) {
+				return
+			}
+			result.Total++
+			if result.Total > skip && len(result.List) < query.PageSize {
+				result.List = append(result.List, &LogRecord{
+					Time:        time.Unix(0, ts),
+					ContainerID: containerID,
+					Message:     content,
+				})
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// segments the segments which may have the logs in the time range
+func (l *LogIndex) segments(serviceID string, start, end time.Time) ([]string, error) {
+	files, err := ioutil.ReadDir(l.indexPath(serviceID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var segments []string
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), ".seg") {
+			continue
+		}
+		hour, err := time.Parse(segmentLayout, strings.TrimSuffix(f.Name(), ".seg"))
+		if err != nil {
+			continue
+		}
+		if hour.Add(time.Hour).Before(start.UTC().Truncate(time.Hour)) || hour.After(end) {
+			continue
+		}
+		segments = append(segments, path.Join(l.indexPath(serviceID), f.Name()))
+	}
+	sort.Strings(segments)
+	return segments, nil
+}
+
+// prune returns false if the segment can not match the query according to its index,
+// otherwise returns the offset to start scanning.
+func (l *LogIndex) prune(segment string, query *LogQuery, keywords []string) (int64, bool) {
+	idx, err := loadSegmentIndex(segment)
+	if err != nil {
+		// scan the segment without the index
+		return 0, true
+	}
+	if idx.Lines > 0 && (idx.MaxTime < query.Start.UnixNano() || idx.MinTime > query.End.UnixNano()) {
+		return 0, false
+	}
+	if !idx.hasContainer(query.ContainerIDs) {
+		return 0, false
+	}
+	if stat, err := os.Stat(segment); err == nil && stat.Size() == idx.Size {
+		for _, keyword := range keywords {
+			if !idx.mayContain(keyword) {
+				return 0, false
+			}
+		}
+	}
+	// the lines are nearly in the time order, start from the mark before the last one
+	// earlier than the start time, to cover the lines which are a little out of order.
+	var offset int64
+	for i, mark := range idx.Marks {
+		if mark[0] >= query.Start.UnixNano() {
+			break
+		}
+		if i > 0 {
+			offset = idx.Marks[i-1][1]
+		}
+	}
+	return offset, true
+}
+
+func scanSegment(segment string, offset int64, fn func(ts int64, containerID, content string)) error {
+	f, err := os.Open(segment)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, 0); err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		if ts, containerID, content, ok := parseSegmentLine(scanner.Text()); ok {
+			fn(ts, containerID, content)
+		}
+	}
+	return scanner.Err()
+}
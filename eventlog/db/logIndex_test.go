@@ -0,0 +1,140 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestLogIndexSearch(t *testing.T) {
+	home, err := ioutil.TempDir("", "logindex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	index := NewLogIndex(home)
+	serviceID := "2f9d3a1b5c7e4f60a8b9c0d1e2f3a4b5"
+	start := time.Date(2022, 3, 4, 2, 50, 0, 0, time.UTC)
+	var messages []*EventLogMessage
+	for i := 0; i < 3000; i++ {
+		containerID := "aaaaaaaaaaaa"
+		if i%2 == 1 {
+			containerID = "bbbbbbbbbbbb"
+		}
+		content := fmt.Sprintf("%s:request %d handled", containerID, i)
+		if i == 2200 {
+			content = containerID + ":dial tcp 10.0.0.1:3306: connection Refused"
+		}
+		messages = append(messages, &EventLogMessage{
+			EventID: serviceID,
+			Content: []byte(content),
+			Time:    start.Add(time.Duration(i) * time.Second).Format(time.RFC3339Nano),
+		})
+	}
+	// two appends, and the logs span two segments
+	if err := index.Append(serviceID, messages[:1000]); err != nil {
+		t.Fatal(err)
+	}
+	if err := index.Append(serviceID, messages[1000:]); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		query LogQuery
+		total int
+		first string
+	}{
+		{
+			name:  "time range",
+			query: LogQuery{Start: start.Add(10 * time.Second), End: start.Add(19 * time.Second)},
+			total: 10,
+			first: "request 10 handled",
+		},
+		{
+			name:  "keyword",
+			query: LogQuery{Start: start, End: start.Add(time.Hour), Keyword: "connection refused"},
+			total: 1,
+			first: "dial tcp 10.0.0.1:3306: connection Refused",
+		},
+		{
+			name:  "partial word",
+			query: LogQuery{Start: start, End: start.Add(time.Hour), Keyword: "refuse"},
+		},
+		{
+			name:  "regex and container",
+			query: LogQuery{Start: start, End: start.Add(time.Hour), Regex: regexp.MustCompile(`request 2\d{3} `), ContainerIDs: []string{"bbbb"}},
+			total: 500,
+			first: "request 2001 handled",
+		},
+		{
+			name:  "page",
+			query: LogQuery{Start: start.Add(20 * time.Minute), End: start.Add(time.Hour), ContainerIDs: []string{"aaaaaaaaaaaa"}, Page: 3},
+			total: 900,
+			first: "request 1600 handled",
+		},
+	}
+	for _, tc := range tests {
+		if tc.query.Page == 0 {
+			tc.query.Page = 1
+		}
+		tc.query.PageSize = 100
+		result, err := index.Search(serviceID, &tc.query)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if result.Total != tc.total {
+			t.Errorf("%s: expected total %d, but got %d", tc.name, tc.total, result.Total)
+		}
+		if tc.first != "" && (len(result.List) == 0 || result.List[0].Message != tc.first) {
+			t.Errorf("%s: expected the first log %q, but got %v", tc.name, tc.first, result.List)
+		}
+	}
+
+	// the segments are removed with the log files by the retention
+	if _, err := ApplyRetention(home, nil, RetentionPolicy{MaxAge: time.Hour}, 0, start.Add(2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	result, err := index.Search(serviceID, &LogQuery{Start: start, End: start.Add(10*time.Minute - time.Second), Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 0 {
+		t.Errorf("expected no log after cleaning, but got %d", result.Total)
+	}
+	result, err = index.Search(serviceID, &LogQuery{Start: start.Add(10 * time.Minute), End: start.Add(20*time.Minute - time.Second), Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 600 {
+		t.Errorf("expected the logs of the retained segment, but got %d", result.Total)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	got := fmt.Sprint(tokenize("ERROR: 连接失败, retry_count=3"))
+	if want := "[error 连 接 失 败 retry_count 3]"; got != want {
+		t.Errorf("expected %s, but got %s", want, got)
+	}
+}
@@ -35,8 +35,16 @@ type Manager interface {
 func NewManager(conf conf.DBConf, log *logrus.Entry) (Manager, error) {
 	switch conf.Type {
 	case "file":
+		if conf.Compression == "" {
+			conf.Compression = CompressionGzip
+		}
+		if err := ValidCompression(conf.Compression); err != nil {
+			return nil, err
+		}
 		return &filePlugin{
-			homePath: conf.HomePath,
+			homePath:    conf.HomePath,
+			index:       NewLogIndex(conf.HomePath),
+			compression: conf.Compression,
 		}, nil
 	case "eventfile":
 		return &EventFilePlugin{
@@ -0,0 +1,420 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	//CompressionGzip compresses the rotated log files with gzip
+	CompressionGzip = "gzip"
+	//CompressionZstd compresses the rotated log files with zstd
+	CompressionZstd = "zstd"
+)
+
+// the layout of the date in the name of the rotated log file, such as 2022-3-4.log.gz
+const rotatedLayout = "2006-1-2"
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+//ValidCompression checks the compression of the rotated log files
+func ValidCompression(compression string) error {
+	switch compression {
+	case CompressionGzip, CompressionZstd:
+		return nil
+	}
+	return fmt.Errorf("unsupported log compression %s, gzip or zstd is supported", compression)
+}
+
+func compressionExt(compression string) string {
+	if compression == CompressionZstd {
+		return ".zst"
+	}
+	return ".gz"
+}
+
+// rotatedLogPath the path of the rotated log file of the day, a sequence is added to
+// the name if the log file of the day has been rotated for its size.
+func rotatedLogPath(dir string, day time.Time, compression string) string {
+	base := day.Format(rotatedLayout)
+	for seq := 0; ; seq++ {
+		name := base
+		if seq > 0 {
+			name += "." + strconv.Itoa(seq)
+		}
+		exists := false
+		for _, ext := range []string{".gz", ".zst"} {
+			if _, err := os.Stat(path.Join(dir, name+".log"+ext)); err == nil {
+				exists = true
+			}
+		}
+		if !exists {
+			return path.Join(dir, name+".log"+compressionExt(compression))
+		}
+	}
+}
+
+// parseRotatedLogName returns the day and the sequence of the rotated log file
+func parseRotatedLogName(name string) (time.Time, int, bool) {
+	parts := strings.Split(name, ".")
+	if len(parts) < 3 || parts[len(parts)-2] != "log" {
+		return time.Time{}, 0, false
+	}
+	day, err := time.ParseInLocation(rotatedLayout, parts[0], time.Local)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+	seq := 0
+	if len(parts) == 4 {
+		if seq, err = strconv.Atoi(parts[1]); err != nil {
+			return time.Time{}, 0, false
+		}
+	}
+	return day, seq, true
+}
+
+//CompressLogFile compresses the file into the compressed file, the compression is chosen by the extension
+func CompressLogFile(compressed, filePath string) error {
+	reader, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	f, err := os.OpenFile(compressed+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var writer io.WriteCloser
+	if strings.HasSuffix(compressed, ".zst") {
+		if writer, err = zstd.NewWriter(f); err != nil {
+			return err
+		}
+	} else {
+		writer = gzip.NewWriter(f)
+	}
+	if _, err := io.Copy(writer, reader); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(compressed+".tmp", compressed)
+}
+
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (r *readCloser) Close() error {
+	return r.close()
+}
+
+//OpenLogFile opens the log file, the compressed file is decompressed transparently.
+//The zip files rotated by the old versions are supported too.
+func OpenLogFile(filePath string) (io.ReadCloser, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(f)
+	magic, _ := reader.Peek(4)
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gr, err := gzip.NewReader(reader)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &readCloser{Reader: gr, close: f.Close}, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(reader)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &readCloser{Reader: zr, close: func() error {
+			zr.Close()
+			return f.Close()
+		}}, nil
+	case bytes.HasPrefix(magic, zipMagic):
+		f.Close()
+		zr, err := zip.OpenReader(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if len(zr.File) == 0 {
+			zr.Close()
+			return nil, fmt.Errorf("no file in %s", filePath)
+		}
+		r, err := zr.File[0].Open()
+		if err != nil {
+			zr.Close()
+			return nil, err
+		}
+		return &readCloser{Reader: r, close: func() error {
+			r.Close()
+			return zr.Close()
+		}}, nil
+	}
+	return &readCloser{Reader: reader, close: f.Close}, nil
+}
+
+// tailLines returns the last n lines of the log file, the lines are kept in a ring of n lines
+func tailLines(filePath string, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	reader, err := OpenLogFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	ring := make([]string, n)
+	count := 0
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		ring[count%n] = scanner.Text()
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if count < n {
+		return ring[:count], nil
+	}
+	// the oldest line is next to the newest one
+	start := count % n
+	return append(ring[start:], ring[:start]...), nil
+}
+
+// historyLogFiles the rotated log files of the component from new to old
+func historyLogFiles(dir string) []string {
+	var paths []string
+	files := componentLogFiles(dir)
+	for i := len(files) - 1; i >= 0; i-- {
+		name := path.Base(files[i].paths[0])
+		if _, _, ok := parseRotatedLogName(name); ok || name == "stdout-legacy.log" {
+			paths = append(paths, files[i].paths[0])
+		}
+	}
+	return paths
+}
+
+//RetentionPolicy how long and how much the component logs are retained, 0 means unlimited
+type RetentionPolicy struct {
+	MaxAge time.Duration
+	// the max bytes of the logs of a component
+	MaxComponentSize int64
+	// the max bytes of the logs of all components in the group
+	MaxTotalSize int64
+}
+
+//RetentionGroup the components whose logs are retained by the same policy, such as the components of a tenant
+type RetentionGroup struct {
+	Name       string
+	ServiceIDs []string
+	Policy     RetentionPolicy
+}
+
+// retainedFile a rotated log file or a log index segment, the current log file is not removable
+type retainedFile struct {
+	paths     []string
+	size      int64
+	time      time.Time
+	removable bool
+}
+
+// componentLogFiles the log files of the component from old to new
+func componentLogFiles(dir string) []*retainedFile {
+	var result []*retainedFile
+	files, _ := ioutil.ReadDir(dir)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		file := &retainedFile{paths: []string{path.Join(dir, f.Name())}, size: f.Size(), time: f.ModTime()}
+		if day, seq, ok := parseRotatedLogName(f.Name()); ok {
+			// the logs of the day are before the next day, and the sequence keeps the order of the day
+			file.time = day.Add(24*time.Hour - time.Duration(100-seq)*time.Second)
+			file.removable = true
+		} else if f.Name() == "stdout-legacy.log" || strings.HasSuffix(f.Name(), ".tmp") {
+			file.removable = true
+		}
+		result = append(result, file)
+	}
+	segments, _ := ioutil.ReadDir(path.Join(dir, "index"))
+	bySegment := make(map[string]*retainedFile)
+	for _, f := range segments {
+		name := f.Name()
+		ext := path.Ext(name)
+		hour, err := time.Parse(segmentLayout, strings.TrimSuffix(name, ext))
+		if err != nil {
+			continue
+		}
+		key := strings.TrimSuffix(name, ext)
+		file, ok := bySegment[key]
+		if !ok {
+			file = &retainedFile{time: hour.Add(time.Hour), removable: true}
+			bySegment[key] = file
+			result = append(result, file)
+		}
+		file.paths = append(file.paths, path.Join(dir, "index", name))
+		file.size += f.Size()
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].time.Before(result[j].time)
+	})
+	return result
+}
+
+func (f *retainedFile) remove() error {
+	for _, p := range f.paths {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	f.removable = false
+	f.size = 0
+	return nil
+}
+
+// removeOldest removes the oldest files until the total size is not greater than the max size
+func removeOldest(files []*retainedFile, maxSize int64) int {
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+	removed := 0
+	for _, f := range files {
+		if total <= maxSize {
+			break
+		}
+		if !f.removable {
+			continue
+		}
+		size := f.size
+		if err := f.remove(); err != nil {
+			logrus.Warningf("remove log file %s: %v", f.paths[0], err)
+			continue
+		}
+		total -= size
+		removed++
+	}
+	return removed
+}
+
+//ApplyRetention removes the rotated log files and the index segments of the components beyond the policies.
+//The components not in any group are retained by the default policy, the MaxTotalSize of which is ignored.
+//At last, the oldest files of all components are removed until the total size fits in the disk budget.
+func ApplyRetention(homePath string, groups []*RetentionGroup, def RetentionPolicy, diskBudget int64, now time.Time) (int, error) {
+	dirs, err := ioutil.ReadDir(homePath)
+	if err != nil {
+		return 0, err
+	}
+	groupOfDir := make(map[string]*RetentionGroup)
+	for _, group := range groups {
+		for _, serviceID := range group.ServiceIDs {
+			groupOfDir[GetServiceAliasID(serviceID)] = group
+		}
+	}
+	removed := 0
+	filesOfGroup := make(map[*RetentionGroup][]*retainedFile)
+	var all []*retainedFile
+	for _, dir := range dirs {
+		// the event logs are not component logs
+		if !dir.IsDir() || dir.Name() == "eventlog" {
+			continue
+		}
+		policy := def
+		group := groupOfDir[dir.Name()]
+		if group != nil {
+			policy = group.Policy
+		}
+		files := componentLogFiles(path.Join(homePath, dir.Name()))
+		if policy.MaxAge > 0 {
+			for _, f := range files {
+				if f.removable && f.time.Before(now.Add(-policy.MaxAge)) {
+					if err := f.remove(); err != nil {
+						logrus.Warningf("remove expired log file %s: %v", f.paths[0], err)
+						continue
+					}
+					removed++
+				}
+			}
+		}
+		if policy.MaxComponentSize > 0 {
+			removed += removeOldest(files, policy.MaxComponentSize)
+		}
+		if group != nil {
+			filesOfGroup[group] = append(filesOfGroup[group], files...)
+		}
+		all = append(all, files...)
+	}
+	for group, files := range filesOfGroup {
+		if group.Policy.MaxTotalSize > 0 {
+			sort.SliceStable(files, func(i, j int) bool {
+				return files[i].time.Before(files[j].time)
+			})
+			if n := removeOldest(files, group.Policy.MaxTotalSize); n > 0 {
+				logrus.Infof("remove %d log files of %s for its total size", n, group.Name)
+				removed += n
+			}
+		}
+	}
+	if diskBudget > 0 {
+		sort.SliceStable(all, func(i, j int) bool {
+			return all[i].time.Before(all[j].time)
+		})
+		if n := removeOldest(all, diskBudget); n > 0 {
+			logrus.Warningf("remove %d log files for the disk budget of the component logs", n)
+			removed += n
+		}
+	}
+	return removed, nil
+}
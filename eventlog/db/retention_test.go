@@ -0,0 +1,205 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompressLogFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logcompress")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	content := []byte("line 1\nline 2\nline 3\n")
+	day := time.Date(2022, 3, 4, 0, 0, 0, 0, time.Local)
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		src := path.Join(dir, "stdout.log")
+		if err := ioutil.WriteFile(src, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+		compressed := rotatedLogPath(dir, day, compression)
+		if err := CompressLogFile(compressed, src); err != nil {
+			t.Fatalf("%s: %v", compression, err)
+		}
+		reader, err := OpenLogFile(compressed)
+		if err != nil {
+			t.Fatalf("%s: %v", compression, err)
+		}
+		got, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", compression, err)
+		}
+		if string(got) != string(content) {
+			t.Errorf("%s: got %q, want %q", compression, got, content)
+		}
+	}
+	// the file of the same day is not overwritten
+	if got, want := path.Base(rotatedLogPath(dir, day, CompressionGzip)), "2022-3-4.2.log.gz"; got != want {
+		t.Errorf("rotated log path %s, want %s", got, want)
+	}
+	// the zip files rotated by the old versions
+	legacy := path.Join(dir, "2022-3-3.log.gz")
+	f, err := os.Create(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	w, err := zw.Create("stdout.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(content)
+	zw.Close()
+	f.Close()
+	lines, err := tailLines(legacy, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"line 2", "line 3"}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("tail lines %v, want %v", lines, want)
+	}
+	lines, err = tailLines(legacy, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"line 1", "line 2", "line 3"}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("tail lines %v, want %v", lines, want)
+	}
+}
+
+func TestGetMessagesFromRotatedFiles(t *testing.T) {
+	home, err := ioutil.TempDir("", "logrotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	serviceID := "2f9d3a1b5c7e4f60a8b9c0d1e2f3a4b5"
+	plugin := &filePlugin{homePath: home, compression: CompressionZstd}
+	dir, err := plugin.getStdFilePath(serviceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := path.Join(dir, "stdout.log")
+	for i, lines := range []string{"a\nb\n", "c\nd\n"} {
+		if err := ioutil.WriteFile(stdout, []byte(lines), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := MvLogFile(rotatedLogPath(dir, time.Now().AddDate(0, 0, i-2), plugin.compression), stdout); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(stdout, []byte("e\nf\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	messages, err := plugin.GetMessages(serviceID, "", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"b", "c", "d", "e", "f"}; !reflect.DeepEqual(messages, want) {
+		t.Errorf("messages %v, want %v", messages, want)
+	}
+}
+
+func TestApplyRetention(t *testing.T) {
+	home, err := ioutil.TempDir("", "logretention")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	now := time.Date(2022, 3, 10, 12, 0, 0, 0, time.Local)
+	write := func(serviceID, name string, size int) string {
+		dir := path.Join(home, GetServiceAliasID(serviceID))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		filePath := path.Join(dir, name)
+		if err := ioutil.WriteFile(filePath, make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return filePath
+	}
+	exists := func(filePath string) bool {
+		_, err := os.Stat(filePath)
+		return err == nil
+	}
+	// the component with the default policy
+	defOld := write("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "2022-3-1.log.gz", 100)
+	defNew := write("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "2022-3-8.log.gz", 100)
+	defStdout := write("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "stdout.log", 5000)
+	// the components of a tenant which retains the logs for 1 day and 400 bytes at most
+	tenant1 := write("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "2022-3-8.log.gz", 100)
+	tenant2 := write("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "2022-3-9.log.gz", 100)
+	tenant3 := write("cccccccccccccccccccccccccccccccc", "2022-3-9.1.log.zst", 100)
+	tenant4 := write("cccccccccccccccccccccccccccccccc", "stdout.log", 150)
+	eventlog := path.Join(home, "eventlog", "2022-1-1.log")
+	os.MkdirAll(path.Dir(eventlog), 0755)
+	ioutil.WriteFile(eventlog, nil, 0644)
+
+	groups := []*RetentionGroup{{
+		Name:       "tenant",
+		ServiceIDs: []string{"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "cccccccccccccccccccccccccccccccc"},
+		Policy:     RetentionPolicy{MaxAge: 24 * time.Hour, MaxTotalSize: 400},
+	}}
+	def := RetentionPolicy{MaxAge: 7 * 24 * time.Hour}
+	removed, err := ApplyRetention(home, groups, def, 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 2 {
+		t.Errorf("removed %d files, want 2", removed)
+	}
+	for filePath, want := range map[string]bool{
+		defOld: false, defNew: true, defStdout: true,
+		tenant1: false, tenant2: true, tenant3: true, tenant4: true,
+		eventlog: true,
+	} {
+		if exists(filePath) != want {
+			t.Errorf("%s exists %v, want %v", filePath, !want, want)
+		}
+	}
+	// the oldest files of the tenant are removed for its total size
+	groups[0].Policy.MaxTotalSize = 300
+	if _, err := ApplyRetention(home, groups, def, 0, now); err != nil {
+		t.Fatal(err)
+	}
+	if exists(tenant2) || !exists(tenant3) {
+		t.Errorf("the oldest log file of the tenant should be removed for its total size")
+	}
+	// the oldest files of all components are removed for the disk budget, but not the current log files
+	if _, err := ApplyRetention(home, groups, def, 5300, now); err != nil {
+		t.Fatal(err)
+	}
+	for filePath, want := range map[string]bool{
+		defNew: false, defStdout: true, tenant3: true, tenant4: true,
+	} {
+		if exists(filePath) != want {
+			t.Errorf("%s exists %v, want %v", filePath, !want, want)
+		}
+	}
+}
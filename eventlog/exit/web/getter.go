@@ -19,10 +19,16 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi"
+	"github.com/goodrain/rainbond/eventlog/db"
 	httputil "github.com/goodrain/rainbond/util/http"
 )
 
@@ -36,3 +42,71 @@ func (s *SocketServer) getDockerLogs(w http.ResponseWriter, r *http.Request) {
 	loglist := s.storemanager.GetDockerLogs(serviceID, rows)
 	httputil.ReturnSuccess(r, w, loglist)
 }
+
+//searchDockerLogs search the indexed docker logs
+func (s *SocketServer) searchDockerLogs(w http.ResponseWriter, r *http.Request) {
+	query, err := parseLogQuery(r.URL.Query(), time.Now())
+	if err != nil {
+		httputil.ReturnError(r, w, 400, err.Error())
+		return
+	}
+	result, err := s.storemanager.SearchDockerLogs(chi.URLParam(r, "serviceID"), query)
+	if err != nil {
+		httputil.ReturnError(r, w, 500, err.Error())
+		return
+	}
+	httputil.ReturnSuccess(r, w, result)
+}
+
+// parseLogQuery parses the log query, the time is RFC3339 or unix seconds, and the logs
+// in the last hour are searched by default.
+func parseLogQuery(values url.Values, now time.Time) (*db.LogQuery, error) {
+	parseTime := func(key string, def time.Time) (time.Time, error) {
+		value := values.Get(key)
+		if value == "" {
+			return def, nil
+		}
+		if sec, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Unix(sec, 0), nil
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return t, fmt.Errorf("%s must be RFC3339 time or unix seconds", key)
+		}
+		return t, nil
+	}
+	query := &db.LogQuery{Keyword: values.Get("keyword"), Page: 1, PageSize: 100}
+	var err error
+	if query.End, err = parseTime("end", now); err != nil {
+		return nil, err
+	}
+	if query.Start, err = parseTime("start", query.End.Add(-time.Hour)); err != nil {
+		return nil, err
+	}
+	if query.Start.After(query.End) {
+		return nil, fmt.Errorf("start must be before end")
+	}
+	if expr := values.Get("regex"); expr != "" {
+		if query.Regex, err = regexp.Compile(expr); err != nil {
+			return nil, fmt.Errorf("invalid regex: %v", err)
+		}
+	}
+	for _, value := range values["container_id"] {
+		for _, id := range strings.Split(value, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				query.ContainerIDs = append(query.ContainerIDs, id)
+			}
+		}
+	}
+	if page := values.Get("page"); page != "" {
+		if query.Page, err = strconv.Atoi(page); err != nil || query.Page < 1 {
+			return nil, fmt.Errorf("page must be a positive number")
+		}
+	}
+	if pageSize := values.Get("page_size"); pageSize != "" {
+		if query.PageSize, err = strconv.Atoi(pageSize); err != nil || query.PageSize < 1 || query.PageSize > 1000 {
+			return nil, fmt.Errorf("page_size must be between 1 and 1000")
+		}
+	}
+	return query, nil
+}
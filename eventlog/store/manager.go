@@ -22,6 +22,7 @@ import (
 	"errors"
 	"strconv"
 
+	cdb "github.com/goodrain/rainbond/db"
 	"github.com/goodrain/rainbond/eventlog/db"
 	coreutil "github.com/goodrain/rainbond/util"
 
@@ -39,7 +40,6 @@ import (
 
 	"context"
 	"os"
-	"path/filepath"
 
 	"github.com/pquerna/ffjson/ffjson"
 	"github.com/prometheus/client_golang/prometheus"
@@ -53,6 +53,7 @@ type Manager interface {
 	PubMessageChan() chan [][]byte
 	DockerLogMessageChan() chan []byte
 	GetDockerLogs(serviceID string, length int) []string
+	SearchDockerLogs(serviceID string, query *db.LogQuery) (*db.LogSearchResult, error)
 	MonitorMessageChan() chan [][]byte
 	WebSocketMessageChan(mode, eventID, subID string) chan *db.EventLogMessage
 	NewMonitorMessageChan() chan []byte
@@ -267,58 +268,75 @@ func (s *storeManager) Run() error {
 }
 
 //cleanLog
-// clean service log that before 7 days in every 24h
-// clean event log that before 30 days message in every 24h
+// clean the component logs beyond the retention policies of the tenants in every interval
 func (s *storeManager) cleanLog() {
+	interval := s.conf.LogRetention.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
 	coreutil.Exec(s.context, func() error {
-		//do something
 		pathname := s.conf.DB.HomePath
 		logrus.Infof("start clean history service log %s", pathname)
-		files, err := coreutil.GetFileList(pathname, 2)
+		removed, err := db.ApplyRetention(pathname, s.retentionGroups(), s.defaultRetention(), s.conf.LogRetention.DiskBudget*1024*1024, time.Now())
 		if err != nil {
-			logrus.Error("list log dir error, ", err.Error())
-		} else {
-			for _, fi := range files {
-				if !strings.Contains(fi, "eventlog") {
-					if err := s.deleteFile(fi); err != nil {
-						logrus.Errorf("delete log file %s error. %s", fi, err.Error())
-					}
-				}
-			}
+			logrus.Errorf("clean history service log error. %s", err.Error())
+			return nil
 		}
+		logrus.Infof("clean history service log success, %d files removed", removed)
 		return nil
-	}, time.Hour*24)
+	}, interval)
 }
 
-func (s *storeManager) deleteFile(filename string) error {
-	now := time.Now()
-	if strings.HasSuffix(filename, "stdout.log") || strings.HasSuffix(filename, "stdout-legacy.log") {
-		return nil
+// defaultRetention the retention of the components whose tenant has no policy
+func (s *storeManager) defaultRetention() db.RetentionPolicy {
+	maxAgeDays := s.conf.LogRetention.MaxAgeDays
+	if maxAgeDays <= 0 {
+		maxAgeDays = logSaveDay()
 	}
-	name := filepath.Base(filename)
-	lis := strings.Split(name, ".")
-	if len(lis) < 1 {
-		return errors.New("file name format error")
+	return db.RetentionPolicy{
+		MaxAge:           time.Duration(maxAgeDays) * time.Hour * 24,
+		MaxComponentSize: s.conf.LogRetention.MaxComponentSize * 1024 * 1024,
 	}
-	date := lis[0]
-	loc, _ := time.LoadLocation("Local")
-	theTime, err := time.ParseInLocation("2006-1-2", date, loc)
+}
+
+// retentionGroups the components of the tenants which have the log retention policy
+func (s *storeManager) retentionGroups() []*db.RetentionGroup {
+	policies, err := cdb.GetManager().TenantLogRetentionPolicyDao().List()
 	if err != nil {
-		return err
+		logrus.Errorf("list tenant log retention policies error. %s", err.Error())
+		return nil
+	}
+	def := s.defaultRetention()
+	var groups []*db.RetentionGroup
+	for _, policy := range policies {
+		services, err := cdb.GetManager().TenantServiceDao().GetServicesByTenantID(policy.TenantID)
+		if err != nil {
+			logrus.Errorf("list services of tenant %s error. %s", policy.TenantID, err.Error())
+			continue
+		}
+		group := &db.RetentionGroup{Name: policy.TenantID, Policy: def}
+		if policy.MaxAgeDays > 0 {
+			group.Policy.MaxAge = time.Duration(policy.MaxAgeDays) * time.Hour * 24
+		}
+		if policy.MaxComponentSize > 0 {
+			group.Policy.MaxComponentSize = int64(policy.MaxComponentSize) * 1024 * 1024
+		}
+		group.Policy.MaxTotalSize = int64(policy.MaxTotalSize) * 1024 * 1024
+		for _, service := range services {
+			group.ServiceIDs = append(group.ServiceIDs, service.ServiceID)
+		}
+		groups = append(groups, group)
 	}
+	return groups
+}
+
+// logSaveDay the days to save the service logs
+func logSaveDay() int {
 	saveDay, _ := strconv.Atoi(os.Getenv("DOCKER_LOG_SAVE_DAY"))
 	if saveDay == 0 {
 		saveDay = 7
 	}
-	if now.After(theTime.Add(time.Duration(saveDay) * time.Hour * 24)) {
-		if err := os.Remove(filename); err != nil {
-			if !strings.Contains(err.Error(), "No such file or directory") {
-				return err
-			}
-		}
-		logrus.Debugf("clean service log %s", filename)
-	}
-	return nil
+	return saveDay
 }
 
 func (s *storeManager) checkHealth() {
@@ -482,6 +500,7 @@ loop:
 				Message: buffer.String(),
 				Content: buffer.Bytes(),
 				EventID: serviceID,
+				Time:    time.Now().Format(time.RFC3339Nano),
 			}
 			s.dockerLogStore.InsertMessage(&message)
 			buffer.Reset()
@@ -530,3 +549,12 @@ func (s *storeManager) Error() chan error {
 func (s *storeManager) GetDockerLogs(serviceID string, length int) []string {
 	return s.dockerLogStore.GetHistoryMessage(serviceID, length)
 }
+
+//SearchDockerLogs search the indexed docker logs
+func (s *storeManager) SearchDockerLogs(serviceID string, query *db.LogQuery) (*db.LogSearchResult, error) {
+	searcher, ok := s.filePlugin.(db.LogSearcher)
+	if !ok {
+		return nil, errors.New("the log store does not support search")
+	}
+	return searcher.SearchMessages(serviceID, query)
+}
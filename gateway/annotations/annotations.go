@@ -19,12 +19,17 @@
 package annotations
 
 import (
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"github.com/goodrain/rainbond/gateway/annotations/authtls"
 	"github.com/goodrain/rainbond/gateway/annotations/cookie"
 	"github.com/goodrain/rainbond/gateway/annotations/header"
+	"github.com/goodrain/rainbond/gateway/annotations/ipaccess"
 	"github.com/goodrain/rainbond/gateway/annotations/l4"
 	"github.com/goodrain/rainbond/gateway/annotations/lbtype"
 	"github.com/goodrain/rainbond/gateway/annotations/parser"
 	"github.com/goodrain/rainbond/gateway/annotations/proxy"
+	"github.com/goodrain/rainbond/gateway/annotations/proxyssl"
+	"github.com/goodrain/rainbond/gateway/annotations/ratelimit"
 	"github.com/goodrain/rainbond/gateway/annotations/resolver"
 	"github.com/goodrain/rainbond/gateway/annotations/rewrite"
 	"github.com/goodrain/rainbond/gateway/annotations/upstreamhashby"
@@ -49,6 +54,11 @@ type Ingress struct {
 	UpstreamHashBy    string
 	LoadBalancingType string
 	Proxy             proxy.Config
+	RateLimit         ratelimit.Config
+	IPAccess          ipaccess.Config
+	Auth              auth.Config
+	AuthTLS           authtls.Config
+	ProxySSL          proxyssl.Config
 }
 
 // Extractor defines the annotation parsers to be used in the extraction of annotations
@@ -68,6 +78,11 @@ func NewAnnotationExtractor(cfg resolver.Resolver) Extractor {
 			"UpstreamHashBy":    upstreamhashby.NewParser(cfg),
 			"LoadBalancingType": lbtype.NewParser(cfg),
 			"Proxy":             proxy.NewParser(cfg),
+			"RateLimit":         ratelimit.NewParser(cfg),
+			"IPAccess":          ipaccess.NewParser(cfg),
+			"Auth":              auth.NewParser(cfg),
+			"AuthTLS":           authtls.NewParser(cfg),
+			"ProxySSL":          proxyssl.NewParser(cfg),
 		},
 	}
 }
@@ -0,0 +1,255 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TypeBasic authenticates the users with the passwords in the secret
+	TypeBasic = "basic"
+	// TypeJWT authenticates the bearer tokens with the JWKS or the public keys in the secret
+	TypeJWT = "jwt"
+	// TypeForward authenticates the requests by an external service
+	TypeForward = "forward"
+
+	// SecretUsersKey the key of the basic auth users in the secret, user:bcrypt-hash separated by newline
+	SecretUsersKey = "auth-users"
+	// SecretJWTKeysKey the key of the PEM encoded JWT public keys in the secret
+	SecretJWTKeysKey = "auth-jwt-keys"
+
+	defaultRealm = "Restricted"
+)
+
+var headerNameRegex = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+// Config describes the authentication of a location, the requests are rejected
+// if the config is incomplete.
+type Config struct {
+	Type string `json:"type"`
+	// Realm the realm of the basic authentication
+	Realm string `json:"realm"`
+	// Users the bcrypt hashes of the passwords of the basic authentication users
+	Users map[string]string `json:"users"`
+	// JWKSURL the url of the JSON web key set to verify the JWT
+	JWKSURL string `json:"jwksURL"`
+	// Keys the PEM encoded public keys to verify the JWT
+	Keys string `json:"keys"`
+	// Issuer the issuer the JWT must be issued by, any issuer is allowed if it is empty
+	Issuer string `json:"issuer"`
+	// Audience the audience the JWT must be issued for, any audience is allowed if it is empty
+	Audience string `json:"audience"`
+	// ClaimHeaders the headers the claims of the JWT are passed to the upstream as
+	ClaimHeaders map[string]string `json:"claimHeaders"`
+	// URL the url of the forward authentication
+	URL string `json:"url"`
+	// ResponseHeaders the headers of the forward authentication response passed to the upstream
+	ResponseHeaders []string `json:"responseHeaders"`
+}
+
+// Equal tests for equality between two Config types
+func (c1 *Config) Equal(c2 *Config) bool {
+	if c1 == c2 {
+		return true
+	}
+	if c1 == nil || c2 == nil {
+		return false
+	}
+	if c1.Type != c2.Type || c1.Realm != c2.Realm || c1.JWKSURL != c2.JWKSURL || c1.Keys != c2.Keys ||
+		c1.Issuer != c2.Issuer || c1.Audience != c2.Audience || c1.URL != c2.URL {
+		return false
+	}
+	if !equalMap(c1.Users, c2.Users) || !equalMap(c1.ClaimHeaders, c2.ClaimHeaders) {
+		return false
+	}
+	if len(c1.ResponseHeaders) != len(c2.ResponseHeaders) {
+		return false
+	}
+	for i := range c1.ResponseHeaders {
+		if c1.ResponseHeaders[i] != c2.ResponseHeaders[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalMap(m1, m2 map[string]string) bool {
+	if len(m1) != len(m2) {
+		return false
+	}
+	for k, v := range m1 {
+		if vv, ok := m2[k]; !ok || v != vv {
+			return false
+		}
+	}
+	return true
+}
+
+// Enabled returns true if the requests are authenticated
+func (c *Config) Enabled() bool {
+	return c != nil && c.Type != ""
+}
+
+// UpstreamHeaders the sorted headers set by the authentication and passed to the upstream,
+// the ones sent by the client are removed.
+func (c *Config) UpstreamHeaders() []string {
+	var headers []string
+	switch c.Type {
+	case TypeJWT:
+		for _, header := range c.ClaimHeaders {
+			headers = append(headers, header)
+		}
+	case TypeForward:
+		headers = append(headers, c.ResponseHeaders...)
+	}
+	sort.Strings(headers)
+	return headers
+}
+
+// ParseUsers parses the basic auth users, user:password separated by newline
+func ParseUsers(value string) (map[string]string, error) {
+	users := make(map[string]string)
+	for _, line := range strings.Split(value, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return nil, fmt.Errorf("invalid user %s, user:password is required", strings.SplitN(line, ":", 2)[0])
+		}
+		users[kv[0]] = kv[1]
+	}
+	return users, nil
+}
+
+// ParseClaimHeaders parses the claims passed to the upstream, claim:header separated by comma
+func ParseClaimHeaders(value string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		kv := strings.SplitN(item, ":", 2)
+		if len(kv) != 2 || kv[0] == "" || !headerNameRegex.MatchString(kv[1]) {
+			return nil, fmt.Errorf("invalid claim header %s, claim:header is required", item)
+		}
+		headers[kv[0]] = kv[1]
+	}
+	return headers, nil
+}
+
+// ParseHeaders parses the comma separated header names
+func ParseHeaders(value string) ([]string, error) {
+	var headers []string
+	for _, header := range strings.Split(value, ",") {
+		header = strings.TrimSpace(header)
+		if header == "" {
+			continue
+		}
+		if !headerNameRegex.MatchString(header) {
+			return nil, fmt.Errorf("invalid header %s", header)
+		}
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+// ValidURL checks the url of the forward authentication or the JWKS
+func ValidURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %s, a http or https url is required", value)
+	}
+	return nil
+}
+
+type auth struct {
+	r resolver.Resolver
+}
+
+// NewParser creates a new authentication annotation parser
+func NewParser(r resolver.Resolver) parser.IngressAnnotation {
+	return auth{r}
+}
+
+// Parse parses the annotations contained in the ingress rule
+// used to authenticate the requests of the location
+func (a auth) Parse(meta *metav1.ObjectMeta) (interface{}, error) {
+	authType, err := parser.GetStringAnnotation("auth-type", meta)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{Type: authType}
+	data := a.secretData(meta)
+	switch authType {
+	case TypeBasic:
+		config.Realm, _ = parser.GetStringAnnotation("auth-realm", meta)
+		if config.Realm == "" {
+			config.Realm = defaultRealm
+		}
+		if config.Users, err = ParseUsers(string(data[SecretUsersKey])); err != nil {
+			logrus.Warningf("ingress %s/%s: %v", meta.Namespace, meta.Name, err)
+		}
+	case TypeJWT:
+		config.JWKSURL, _ = parser.GetStringAnnotation("auth-jwks-url", meta)
+		config.Keys = string(data[SecretJWTKeysKey])
+		config.Issuer, _ = parser.GetStringAnnotation("auth-jwt-issuer", meta)
+		config.Audience, _ = parser.GetStringAnnotation("auth-jwt-audience", meta)
+		claimHeaders, _ := parser.GetStringAnnotation("auth-jwt-claim-headers", meta)
+		if config.ClaimHeaders, err = ParseClaimHeaders(claimHeaders); err != nil {
+			logrus.Warningf("ingress %s/%s: %v", meta.Namespace, meta.Name, err)
+		}
+	case TypeForward:
+		config.URL, _ = parser.GetStringAnnotation("auth-url", meta)
+		responseHeaders, _ := parser.GetStringAnnotation("auth-response-headers", meta)
+		if config.ResponseHeaders, err = ParseHeaders(responseHeaders); err != nil {
+			logrus.Warningf("ingress %s/%s: %v", meta.Namespace, meta.Name, err)
+		}
+	default:
+		// all the requests are rejected by the unknown authentication
+		logrus.Warningf("ingress %s/%s: unknown auth type %s", meta.Namespace, meta.Name, authType)
+	}
+	return config, nil
+}
+
+// secretData the data of the secret the users or the keys are stored in
+func (a auth) secretData(meta *metav1.ObjectMeta) map[string][]byte {
+	name, _ := parser.GetStringAnnotation("auth-secret", meta)
+	if name == "" {
+		return nil
+	}
+	secret, err := a.r.GetSecret(fmt.Sprintf("%s/%s", meta.Namespace, name))
+	if err != nil || secret == nil {
+		logrus.Warningf("get auth secret %s of ingress %s/%s: %v", name, meta.Namespace, meta.Name, err)
+		return nil
+	}
+	return secret.Data
+}
@@ -0,0 +1,103 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type secretResolver struct {
+	resolver.Mock
+	secrets map[string]*apiv1.Secret
+}
+
+func (r secretResolver) GetSecret(key string) (*apiv1.Secret, error) {
+	return r.secrets[key], nil
+}
+
+func TestParseUsers(t *testing.T) {
+	users, err := ParseUsers("foo:$2a$10$abc\n\n bar:secret \n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"foo": "$2a$10$abc", "bar": "secret"}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("expected %v, but got %v", want, users)
+	}
+	if _, err := ParseUsers("foo"); err == nil {
+		t.Errorf("expected an error of the user without password")
+	}
+}
+
+func TestParseClaimHeaders(t *testing.T) {
+	headers, err := ParseClaimHeaders("sub:X-User, email:X-Email")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"sub": "X-User", "email": "X-Email"}
+	if !reflect.DeepEqual(headers, want) {
+		t.Errorf("expected %v, but got %v", want, headers)
+	}
+	if _, err := ParseClaimHeaders("sub:X User"); err == nil {
+		t.Errorf("expected an error of the invalid header")
+	}
+}
+
+func TestParse(t *testing.T) {
+	r := secretResolver{secrets: map[string]*apiv1.Secret{
+		"ns/rule": {Data: map[string][]byte{SecretUsersKey: []byte("foo:$2a$10$abc")}},
+	}}
+	meta := &metav1.ObjectMeta{Namespace: "ns", Annotations: map[string]string{"foo": "bar"}}
+	if _, err := NewParser(r).Parse(meta); err == nil {
+		t.Errorf("expected an error of the missing annotations")
+	}
+
+	meta.Annotations[parser.GetAnnotationWithPrefix("auth-type")] = TypeBasic
+	meta.Annotations[parser.GetAnnotationWithPrefix("auth-secret")] = "rule"
+	i, err := NewParser(r).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Config{Type: TypeBasic, Realm: defaultRealm, Users: map[string]string{"foo": "$2a$10$abc"}}
+	if got := i.(*Config); !got.Equal(want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+
+	meta.Annotations[parser.GetAnnotationWithPrefix("auth-type")] = TypeForward
+	meta.Annotations[parser.GetAnnotationWithPrefix("auth-url")] = "http://auth.example.com/verify"
+	meta.Annotations[parser.GetAnnotationWithPrefix("auth-response-headers")] = "X-User,X-Groups"
+	i, err = NewParser(r).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := i.(*Config)
+	want = &Config{Type: TypeForward, URL: "http://auth.example.com/verify", ResponseHeaders: []string{"X-User", "X-Groups"}}
+	if !got.Equal(want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+	if headers := got.UpstreamHeaders(); !reflect.DeepEqual(headers, []string{"X-Groups", "X-User"}) {
+		t.Errorf("unexpected upstream headers %v", headers)
+	}
+}
@@ -0,0 +1,157 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package authtls
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VerifyOn rejects the requests without a verified client certificate
+	VerifyOn = "on"
+	// VerifyOptional passes the requests without a verified client certificate to the upstream,
+	// the result of the verification is passed as the header X-Client-Verify
+	VerifyOptional = "optional"
+
+	// SecretCAKey the key of the PEM encoded CA bundle verifying the client certificates in the secret
+	SecretCAKey = "ca.crt"
+
+	defaultVerifyDepth = 1
+	// MaxVerifyDepth the max depth of the client certificate chain
+	MaxVerifyDepth = 10
+)
+
+// Config describes the client certificate verification of a location, the verified subject
+// and SAN of the client certificate are passed to the upstream as headers.
+type Config struct {
+	resolver.AuthSSLCert
+	// VerifyClient on or optional
+	VerifyClient string `json:"verifyClient"`
+	// VerifyDepth the max depth of the client certificate chain
+	VerifyDepth int `json:"verifyDepth"`
+}
+
+// Equal tests for equality between two Config types
+func (c1 *Config) Equal(c2 *Config) bool {
+	if c1 == c2 {
+		return true
+	}
+	if c1 == nil || c2 == nil {
+		return false
+	}
+	return c1.AuthSSLCert.Equal(&c2.AuthSSLCert) && c1.VerifyClient == c2.VerifyClient && c1.VerifyDepth == c2.VerifyDepth
+}
+
+// Enabled returns true if the client certificates are verified, the requests are regarded as
+// the ones without a verified client certificate if the CA bundle is missing.
+func (c *Config) Enabled() bool {
+	return c != nil && c.VerifyClient != ""
+}
+
+// ValidVerifyClient checks the verification mode of the client certificates
+func ValidVerifyClient(value string) error {
+	if value != VerifyOn && value != VerifyOptional {
+		return fmt.Errorf("invalid client verify %s, on or optional is required", value)
+	}
+	return nil
+}
+
+// ParseVerifyDepth parses the max depth of the client certificate chain
+func ParseVerifyDepth(value string) (int, error) {
+	depth, err := strconv.Atoi(value)
+	if err != nil || depth < 1 || depth > MaxVerifyDepth {
+		return 0, fmt.Errorf("invalid client verify depth %s, an integer in [1, %d] is required", value, MaxVerifyDepth)
+	}
+	return depth, nil
+}
+
+// ValidCABundle checks the PEM encoded CA bundle, which contains one or more certificates
+func ValidCABundle(value string) error {
+	rest := []byte(value)
+	var count int
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block %s in the CA bundle", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("invalid certificate in the CA bundle: %v", err)
+		}
+		count++
+	}
+	if count == 0 {
+		return fmt.Errorf("no certificate found in the CA bundle")
+	}
+	return nil
+}
+
+type authTLS struct {
+	r resolver.Resolver
+}
+
+// NewParser creates a new client certificate verification annotation parser
+func NewParser(r resolver.Resolver) parser.IngressAnnotation {
+	return authTLS{r}
+}
+
+// Parse parses the annotations contained in the ingress rule
+// used to verify the client certificates of the location
+func (a authTLS) Parse(meta *metav1.ObjectMeta) (interface{}, error) {
+	verify, err := parser.GetStringAnnotation("client-verify", meta)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{
+		VerifyClient: VerifyOn,
+		VerifyDepth:  defaultVerifyDepth,
+	}
+	if err := ValidVerifyClient(verify); err != nil {
+		// the requests are rejected rather than passed without verification
+		logrus.Warningf("ingress %s/%s: %v", meta.Namespace, meta.Name, err)
+	} else {
+		config.VerifyClient = verify
+	}
+	if depth, _ := parser.GetStringAnnotation("client-verify-depth", meta); depth != "" {
+		if config.VerifyDepth, err = ParseVerifyDepth(depth); err != nil {
+			logrus.Warningf("ingress %s/%s: %v", meta.Namespace, meta.Name, err)
+			config.VerifyDepth = defaultVerifyDepth
+		}
+	}
+	name, _ := parser.GetStringAnnotation("ca-secret", meta)
+	cert, err := a.r.GetAuthCertificate(fmt.Sprintf("%s/%s", meta.Namespace, name))
+	if err != nil || cert == nil || cert.CAFileName == "" {
+		// no client certificate is verified without the CA bundle
+		logrus.Warningf("get client CA %s of ingress %s/%s: %v", name, meta.Namespace, meta.Name, err)
+		return config, nil
+	}
+	config.AuthSSLCert = *cert
+	return config, nil
+}
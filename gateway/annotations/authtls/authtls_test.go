@@ -0,0 +1,118 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package authtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type certResolver struct {
+	resolver.Mock
+	certs map[string]*resolver.AuthSSLCert
+}
+
+func (r certResolver) GetAuthCertificate(key string) (*resolver.AuthSSLCert, error) {
+	return r.certs[key], nil
+}
+
+func TestValidCABundle(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "partner CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	if err := ValidCABundle(ca + ca); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+	for _, value := range []string{"", "invalid", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))} {
+		if err := ValidCABundle(value); err == nil {
+			t.Errorf("expected an error of the CA bundle %q", value)
+		}
+	}
+}
+
+func TestParseVerifyDepth(t *testing.T) {
+	if depth, err := ParseVerifyDepth("2"); err != nil || depth != 2 {
+		t.Errorf("expected depth 2, but got %d, %v", depth, err)
+	}
+	for _, value := range []string{"0", "11", "x"} {
+		if _, err := ParseVerifyDepth(value); err == nil {
+			t.Errorf("expected an error of the depth %s", value)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	r := certResolver{certs: map[string]*resolver.AuthSSLCert{
+		"ns/rule": {Secret: "ns/rule", CAFileName: "/tmp/ns-rule-ca.crt", PemSHA: "sha"},
+	}}
+	meta := &metav1.ObjectMeta{Namespace: "ns", Annotations: map[string]string{"foo": "bar"}}
+	if _, err := NewParser(r).Parse(meta); err == nil {
+		t.Errorf("expected an error of the missing annotations")
+	}
+
+	meta.Annotations[parser.GetAnnotationWithPrefix("client-verify")] = VerifyOptional
+	meta.Annotations[parser.GetAnnotationWithPrefix("client-verify-depth")] = "3"
+	meta.Annotations[parser.GetAnnotationWithPrefix("ca-secret")] = "rule"
+	i, err := NewParser(r).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := i.(*Config)
+	if !cfg.Enabled() || cfg.CAFileName != "/tmp/ns-rule-ca.crt" || cfg.VerifyClient != VerifyOptional || cfg.VerifyDepth != 3 {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	// the requests are rejected without the CA bundle
+	meta.Annotations[parser.GetAnnotationWithPrefix("client-verify")] = "invalid"
+	meta.Annotations[parser.GetAnnotationWithPrefix("ca-secret")] = "missing"
+	i, err = NewParser(r).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg = i.(*Config)
+	if !cfg.Enabled() || cfg.CAFileName != "" || cfg.VerifyClient != VerifyOn {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+}
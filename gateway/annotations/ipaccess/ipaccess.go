@@ -0,0 +1,131 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package ipaccess
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	"github.com/goodrain/rainbond/util/ingress-nginx/ingress/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Config describes the client networks allowed or denied by a location
+type Config struct {
+	// Allow the client networks allowed, the others are denied if it is not empty
+	Allow []string `json:"allow"`
+	// Deny the client networks denied, which takes precedence over Allow
+	Deny []string `json:"deny"`
+	// DenyAll denies all the clients, it is set if the networks are invalid
+	DenyAll bool `json:"denyAll"`
+}
+
+// Equal tests for equality between two Config types
+func (c1 *Config) Equal(c2 *Config) bool {
+	if c1 == c2 {
+		return true
+	}
+	if c1 == nil || c2 == nil {
+		return false
+	}
+	return c1.DenyAll == c2.DenyAll && equalStrings(c1.Allow, c2.Allow) && equalStrings(c1.Deny, c2.Deny)
+}
+
+// Enabled returns true if the client networks are checked
+func (c *Config) Enabled() bool {
+	return c != nil && (c.DenyAll || len(c.Allow) > 0 || len(c.Deny) > 0)
+}
+
+func equalStrings(s1, s2 []string) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseCIDRs parses the comma separated CIDRs or ips, an ip is regarded as a network of itself
+func ParseCIDRs(value string) ([]string, error) {
+	var cidrs []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ip %s", item)
+			}
+			if ip.To4() != nil {
+				item += "/32"
+			} else {
+				item += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %s", item)
+		}
+		cidrs = append(cidrs, network.String())
+	}
+	return cidrs, nil
+}
+
+type ipaccess struct {
+	r resolver.Resolver
+}
+
+// NewParser creates a new ip access annotation parser
+func NewParser(r resolver.Resolver) parser.IngressAnnotation {
+	return ipaccess{r}
+}
+
+// Parse parses the annotations contained in the ingress rule
+// used to allow or deny the client networks of the location
+func (a ipaccess) Parse(meta *metav1.ObjectMeta) (interface{}, error) {
+	config := &Config{}
+	for name, cidrs := range map[string]*[]string{"allow-cidrs": &config.Allow, "deny-cidrs": &config.Deny} {
+		value, err := parser.GetStringAnnotation(name, meta)
+		if err != nil {
+			if errors.IsMissingAnnotations(err) {
+				continue
+			}
+			return nil, err
+		}
+		*cidrs, err = ParseCIDRs(value)
+		if err != nil {
+			// the location is denied rather than opened to all the clients
+			logrus.Errorf("invalid %s of ingress %s/%s, deny all the clients: %v", name, meta.Namespace, meta.Name, err)
+			return &Config{DenyAll: true}, nil
+		}
+	}
+	if !config.Enabled() {
+		return nil, errors.ErrMissingAnnotations
+	}
+	return config, nil
+}
@@ -0,0 +1,70 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package ipaccess
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseCIDRs(t *testing.T) {
+	cidrs, err := ParseCIDRs("10.0.0.0/8, 192.168.1.10 ,,fd00::1/8,::1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"10.0.0.0/8", "192.168.1.10/32", "fd00::/8", "::1/128"}
+	if !reflect.DeepEqual(cidrs, want) {
+		t.Errorf("expected %v, but got %v", want, cidrs)
+	}
+	if _, err := ParseCIDRs("10.0.0.0/33"); err == nil {
+		t.Errorf("expected an error of the invalid CIDR")
+	}
+}
+
+func TestParse(t *testing.T) {
+	meta := &metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}}
+	if _, err := NewParser(&resolver.Mock{}).Parse(meta); err == nil {
+		t.Errorf("expected an error of the missing annotations")
+	}
+
+	meta.Annotations[parser.GetAnnotationWithPrefix("allow-cidrs")] = "10.0.0.0/8"
+	meta.Annotations[parser.GetAnnotationWithPrefix("deny-cidrs")] = "10.1.0.0/16"
+	i, err := NewParser(&resolver.Mock{}).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Config{Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.1.0.0/16"}}
+	if got := i.(*Config); !got.Equal(want) {
+		t.Errorf("expected %v, but got %v", want, got)
+	}
+
+	// all the clients are denied if the networks are invalid
+	meta.Annotations[parser.GetAnnotationWithPrefix("allow-cidrs")] = "10.0.0.0/8,foo"
+	i, err = NewParser(&resolver.Mock{}).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := i.(*Config); !got.DenyAll {
+		t.Errorf("expected all the clients are denied, but got %v", got)
+	}
+}
@@ -0,0 +1,133 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package proxyssl
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	"github.com/goodrain/rainbond/util/ingress-nginx/ingress/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProtocolHTTP the requests are proxied to the upstream by http
+	ProtocolHTTP = "http"
+	// ProtocolHTTPS the requests are proxied to the upstream by https
+	ProtocolHTTPS = "https"
+
+	// SecretUpstreamCAKey the key of the PEM encoded CA bundle verifying the upstream certificates in the secret
+	SecretUpstreamCAKey = "upstream-ca.crt"
+
+	// DefaultTrustedCAFile the system CA bundle the upstream certificates are verified by
+	// if there is no CA bundle in the secret
+	DefaultTrustedCAFile = "/etc/ssl/certs/ca-certificates.crt"
+)
+
+var serverNameRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)*$`)
+
+// Config describes the TLS of the connections between the gateway and the upstream of a location
+type Config struct {
+	// Enabled the requests are proxied to the upstream by https
+	Enabled bool `json:"enabled"`
+	// Verify verifies the certificates of the upstream
+	Verify bool `json:"verify"`
+	// TrustedCAFile the CA bundle the upstream certificates are verified by
+	TrustedCAFile string `json:"trustedCAFile"`
+	// ServerName the name sent by SNI and the upstream certificates are verified with,
+	// the host of the request is used if it is empty
+	ServerName string `json:"serverName"`
+}
+
+// Equal tests for equality between two Config types
+func (c1 *Config) Equal(c2 *Config) bool {
+	if c1 == c2 {
+		return true
+	}
+	if c1 == nil || c2 == nil {
+		return false
+	}
+	return *c1 == *c2
+}
+
+// ValidProtocol checks the protocol of the upstream
+func ValidProtocol(value string) error {
+	if value != ProtocolHTTP && value != ProtocolHTTPS {
+		return fmt.Errorf("invalid upstream protocol %s, http or https is required", value)
+	}
+	return nil
+}
+
+// ValidServerName checks the name of the upstream certificates
+func ValidServerName(value string) error {
+	if len(value) > 253 || !serverNameRegex.MatchString(value) {
+		return fmt.Errorf("invalid upstream server name %s", value)
+	}
+	return nil
+}
+
+type proxySSL struct {
+	r resolver.Resolver
+}
+
+// NewParser creates a new upstream TLS annotation parser
+func NewParser(r resolver.Resolver) parser.IngressAnnotation {
+	return proxySSL{r}
+}
+
+// Parse parses the annotations contained in the ingress rule
+// used to connect the upstream of the location by TLS
+func (p proxySSL) Parse(meta *metav1.ObjectMeta) (interface{}, error) {
+	protocol, err := parser.GetStringAnnotation("upstream-protocol", meta)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidProtocol(protocol); err != nil {
+		logrus.Warningf("ingress %s/%s: %v", meta.Namespace, meta.Name, err)
+		return nil, errors.ErrMissingAnnotations
+	}
+	config := &Config{Enabled: protocol == ProtocolHTTPS}
+	if !config.Enabled {
+		return config, nil
+	}
+	config.Verify, _ = parser.GetBoolAnnotation("upstream-tls-verify", meta)
+	if name, _ := parser.GetStringAnnotation("upstream-tls-server-name", meta); name != "" {
+		if err := ValidServerName(name); err != nil {
+			logrus.Warningf("ingress %s/%s: %v", meta.Namespace, meta.Name, err)
+		} else {
+			config.ServerName = name
+		}
+	}
+	if !config.Verify {
+		return config, nil
+	}
+	config.TrustedCAFile = DefaultTrustedCAFile
+	if name, _ := parser.GetStringAnnotation("ca-secret", meta); name != "" {
+		cert, err := p.r.GetAuthCertificate(fmt.Sprintf("%s/%s", meta.Namespace, name))
+		if err != nil || cert == nil {
+			logrus.Warningf("get ca secret %s of ingress %s/%s: %v", name, meta.Namespace, meta.Name, err)
+		} else if cert.UpstreamCAFileName != "" {
+			config.TrustedCAFile = cert.UpstreamCAFileName
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,87 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package proxyssl
+
+import (
+	"testing"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type certResolver struct {
+	resolver.Mock
+	certs map[string]*resolver.AuthSSLCert
+}
+
+func (r certResolver) GetAuthCertificate(key string) (*resolver.AuthSSLCert, error) {
+	return r.certs[key], nil
+}
+
+func TestValidServerName(t *testing.T) {
+	for _, name := range []string{"backend", "backend.ns.svc.cluster.local"} {
+		if err := ValidServerName(name); err != nil {
+			t.Errorf("unexpected error of %s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "-backend", "backend..svc", "backend;"} {
+		if err := ValidServerName(name); err == nil {
+			t.Errorf("expected an error of %s", name)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	r := certResolver{certs: map[string]*resolver.AuthSSLCert{
+		"ns/rule": {Secret: "ns/rule", UpstreamCAFileName: "/tmp/ns-rule-upstream-ca.crt"},
+	}}
+	meta := &metav1.ObjectMeta{Namespace: "ns", Annotations: map[string]string{
+		parser.GetAnnotationWithPrefix("upstream-protocol"): ProtocolHTTP,
+	}}
+	i, err := NewParser(r).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg := i.(*Config); cfg.Enabled {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	meta.Annotations[parser.GetAnnotationWithPrefix("upstream-protocol")] = ProtocolHTTPS
+	meta.Annotations[parser.GetAnnotationWithPrefix("upstream-tls-server-name")] = "backend.ns.svc"
+	i, err = NewParser(r).Parse(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Config{Enabled: true, ServerName: "backend.ns.svc"}
+	if cfg := i.(*Config); *cfg != want {
+		t.Errorf("expected %+v, but got %+v", want, cfg)
+	}
+
+	meta.Annotations[parser.GetAnnotationWithPrefix("upstream-tls-verify")] = "true"
+	i, _ = NewParser(r).Parse(meta)
+	if cfg := i.(*Config); !cfg.Verify || cfg.TrustedCAFile != DefaultTrustedCAFile {
+		t.Errorf("expected the system CA bundle, but got %+v", cfg)
+	}
+	meta.Annotations[parser.GetAnnotationWithPrefix("ca-secret")] = "rule"
+	i, _ = NewParser(r).Parse(meta)
+	if cfg := i.(*Config); cfg.TrustedCAFile != "/tmp/ns-rule-upstream-ca.crt" {
+		t.Errorf("expected the CA bundle of the secret, but got %+v", cfg)
+	}
+}
@@ -0,0 +1,126 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package ratelimit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	"github.com/goodrain/rainbond/util/ingress-nginx/ingress/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KeyIP limits the requests by the client ip
+const KeyIP = "ip"
+
+// KeyPath limits the requests by the request path
+const KeyPath = "path"
+
+// keyHeaderPrefix limits the requests by the value of the header, e.g. header:X-Api-Key
+const keyHeaderPrefix = "header:"
+
+var headerNameRegex = regexp.MustCompile(`^[A-Za-z0-9-_]+$`)
+
+// Config describes the rate limit of a location
+type Config struct {
+	// RPS the requests per second allowed, 0 means unlimited
+	RPS int `json:"rps"`
+	// Burst the requests allowed to exceed the rate, which are not delayed
+	Burst int `json:"burst"`
+	// Key what the requests are limited by, ip, path or header:<name>
+	Key string `json:"key"`
+}
+
+// Equal tests for equality between two Config types
+func (c1 *Config) Equal(c2 *Config) bool {
+	if c1 == c2 {
+		return true
+	}
+	if c1 == nil || c2 == nil {
+		return false
+	}
+	return c1.RPS == c2.RPS && c1.Burst == c2.Burst && c1.Key == c2.Key
+}
+
+// Enabled returns true if the requests are limited
+func (c *Config) Enabled() bool {
+	return c != nil && c.RPS > 0
+}
+
+// Header returns the header the requests are limited by, empty if they are not limited by any header
+func (c *Config) Header() string {
+	if !strings.HasPrefix(c.Key, keyHeaderPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(c.Key, keyHeaderPrefix)
+}
+
+// ValidKey checks the key the requests are limited by
+func ValidKey(key string) error {
+	switch {
+	case key == KeyIP, key == KeyPath:
+		return nil
+	case strings.HasPrefix(key, keyHeaderPrefix) && headerNameRegex.MatchString(strings.TrimPrefix(key, keyHeaderPrefix)):
+		return nil
+	}
+	return fmt.Errorf("invalid rate limit key %s, ip, path or header:<name> is supported", key)
+}
+
+type ratelimit struct {
+	r resolver.Resolver
+}
+
+// NewParser creates a new rate limit annotation parser
+func NewParser(r resolver.Resolver) parser.IngressAnnotation {
+	return ratelimit{r}
+}
+
+// Parse parses the annotations contained in the ingress rule
+// used to limit the requests of the location
+func (a ratelimit) Parse(meta *metav1.ObjectMeta) (interface{}, error) {
+	rps, err := parser.GetIntAnnotation("rate-limit-rps", meta)
+	if err != nil {
+		return nil, err
+	}
+	if rps <= 0 {
+		return nil, errors.NewInvalidAnnotationContent("rate-limit-rps", rps)
+	}
+	burst, err := parser.GetIntAnnotation("rate-limit-burst", meta)
+	if err != nil && !errors.IsMissingAnnotations(err) {
+		return nil, err
+	}
+	if burst < 0 {
+		return nil, errors.NewInvalidAnnotationContent("rate-limit-burst", burst)
+	}
+	key, _ := parser.GetStringAnnotation("rate-limit-key", meta)
+	if key == "" {
+		key = KeyIP
+	}
+	if err := ValidKey(key); err != nil {
+		return nil, errors.NewInvalidAnnotationContent("rate-limit-key", key)
+	}
+	return &Config{
+		RPS:   rps,
+		Burst: burst,
+		Key:   key,
+	}, nil
+}
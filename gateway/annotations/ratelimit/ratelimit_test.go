@@ -0,0 +1,88 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        *Config
+	}{
+		{
+			name:        "missing",
+			annotations: map[string]string{"foo": "bar"},
+		},
+		{
+			name:        "default key",
+			annotations: map[string]string{"rate-limit-rps": "10"},
+			want:        &Config{RPS: 10, Key: KeyIP},
+		},
+		{
+			name:        "header key",
+			annotations: map[string]string{"rate-limit-rps": "10", "rate-limit-burst": "20", "rate-limit-key": "header:X-Api-Key"},
+			want:        &Config{RPS: 10, Burst: 20, Key: "header:X-Api-Key"},
+		},
+		{
+			name:        "invalid rps",
+			annotations: map[string]string{"rate-limit-rps": "0"},
+		},
+		{
+			name:        "invalid key",
+			annotations: map[string]string{"rate-limit-rps": "10", "rate-limit-key": "cookie:session"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := &metav1.ObjectMeta{Annotations: map[string]string{}}
+			for key, value := range tc.annotations {
+				meta.Annotations[parser.GetAnnotationWithPrefix(key)] = value
+			}
+			i, err := NewParser(&resolver.Mock{}).Parse(meta)
+			if tc.want == nil {
+				if err == nil {
+					t.Errorf("expected an error, but got %v", i)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := i.(*Config); !got.Equal(tc.want) {
+				t.Errorf("expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHeader(t *testing.T) {
+	if header := (&Config{Key: "header:X-Api-Key"}).Header(); header != "X-Api-Key" {
+		t.Errorf("expected X-Api-Key, but got %s", header)
+	}
+	if header := (&Config{Key: KeyPath}).Header(); header != "" {
+		t.Errorf("expected no header, but got %s", header)
+	}
+}
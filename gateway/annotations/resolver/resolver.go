@@ -34,12 +34,20 @@ limitations under the License.
 
 import (
 	"github.com/goodrain/rainbond/gateway/defaults"
+	apiv1 "k8s.io/api/core/v1"
 )
 
 // Resolver is an interface that knows how to extract information from a controller
 type Resolver interface {
 	// GetDefaultBackend returns the backend that must be used as default
 	GetDefaultBackend() defaults.Backend
+
+	// GetSecret searches for secrets contenating the namespace and name using a the character /
+	GetSecret(string) (*apiv1.Secret, error)
+
+	// GetAuthCertificate resolves a given secret name into an SSL certificate.
+	// The secret must contain 'ca.crt' or 'upstream-ca.crt'
+	GetAuthCertificate(string) (*AuthSSLCert, error)
 }
 
 // AuthSSLCert contains the necessary information to do certificate based
@@ -49,6 +57,8 @@ type AuthSSLCert struct {
 	Secret string `json:"secret"`
 	// CAFileName contains the path to the secrets 'ca.crt'
 	CAFileName string `json:"caFilename"`
+	// UpstreamCAFileName contains the path to the secrets 'upstream-ca.crt'
+	UpstreamCAFileName string `json:"upstreamCAFilename"`
 	// PemSHA contains the SHA1 hash of the 'ca.crt' or combinations of (tls.crt, tls.key, tls.crt) depending on certs in secret
 	PemSHA string `json:"pemSha"`
 }
@@ -68,6 +78,9 @@ func (asslc1 *AuthSSLCert) Equal(assl2 *AuthSSLCert) bool {
 	if asslc1.CAFileName != assl2.CAFileName {
 		return false
 	}
+	if asslc1.UpstreamCAFileName != assl2.UpstreamCAFileName {
+		return false
+	}
 	if asslc1.PemSHA != assl2.PemSHA {
 		return false
 	}
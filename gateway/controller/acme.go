@@ -0,0 +1,81 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/goodrain/rainbond/gateway/store"
+	"github.com/sirupsen/logrus"
+)
+
+// acmeSocket the unix socket the http-01 challenge requests are proxied to by openresty
+const acmeSocket = "/tmp/acme-nginx.socket"
+
+const acmeChallengePath = "/.well-known/acme-challenge/"
+
+// acmeResponder serves the http-01 challenges of the certificates issued by the acme controller of the worker
+type acmeResponder struct {
+	store    store.Storer
+	listener net.Listener
+	server   *http.Server
+}
+
+func newACMEResponder(store store.Storer) (*acmeResponder, error) {
+	os.Remove(acmeSocket)
+	listener, err := net.Listen("unix", acmeSocket)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(acmeSocket, 0777); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	r := &acmeResponder{store: store, listener: listener}
+	r.server = &http.Server{Handler: r}
+	return r, nil
+}
+
+func (r *acmeResponder) start() {
+	if err := r.server.Serve(r.listener); err != nil && err != http.ErrServerClosed {
+		logrus.Errorf("acme challenge server is closed: %v", err)
+	}
+}
+
+func (r *acmeResponder) stop() {
+	r.server.Close()
+}
+
+func (r *acmeResponder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	token := strings.TrimPrefix(req.URL.Path, acmeChallengePath)
+	if token == "" || token == req.URL.Path || strings.Contains(token, "/") {
+		http.NotFound(w, req)
+		return
+	}
+	response, ok := r.store.GetACMEChallenge(token)
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte(response))
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/goodrain/rainbond/gateway/metric"
 	"github.com/goodrain/rainbond/gateway/store"
 	v1 "github.com/goodrain/rainbond/gateway/v1"
+	mqclient "github.com/goodrain/rainbond/mq/client"
 	"github.com/goodrain/rainbond/util/ingress-nginx/task"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -75,6 +76,7 @@ type GWController struct {
 	ctx     context.Context
 
 	metricCollector metric.Collector
+	acmeResponder   *acmeResponder
 }
 
 // Start starts Gateway
@@ -86,6 +88,14 @@ func (gwc *GWController) Start(errCh chan error) error {
 	// start informer
 	gwc.store.Run(gwc.stopCh)
 
+	acmeResponder, err := newACMEResponder(gwc.store)
+	if err != nil {
+		logrus.Errorf("create acme challenge listener failure %s", err.Error())
+		return err
+	}
+	gwc.acmeResponder = acmeResponder
+	go gwc.acmeResponder.start()
+
 	// start task queue
 	go gwc.syncQueue.Run(1*time.Second, gwc.stopCh)
 
@@ -113,6 +123,9 @@ func (gwc *GWController) Close() error {
 	logrus.Infof("Shutting down controller queues")
 	close(gwc.stopCh) // stop the loop in *GWController#Start()
 	go gwc.syncQueue.Shutdown()
+	if gwc.acmeResponder != nil {
+		gwc.acmeResponder.stop()
+	}
 
 	return gwc.GWS.Stop()
 }
@@ -178,7 +191,7 @@ func (gwc *GWController) syncGateway(key interface{}) error {
 }
 
 //NewGWController new Gateway controller
-func NewGWController(ctx context.Context, clientset kubernetes.Interface, cfg *option.Config, mc metric.Collector, node *cluster.NodeManager) (*GWController, error) {
+func NewGWController(ctx context.Context, clientset kubernetes.Interface, cfg *option.Config, mc metric.Collector, node *cluster.NodeManager, mqclient mqclient.MQClient) (*GWController, error) {
 	gwc := &GWController{
 		updateCh:        channels.NewRingChannel(1024),
 		syncRateLimiter: flowcontrol.NewTokenBucketRateLimiter(cfg.SyncRateLimit, 1),
@@ -189,7 +202,7 @@ func NewGWController(ctx context.Context, clientset kubernetes.Interface, cfg *o
 		metricCollector: mc,
 	}
 
-	gwc.GWS = openresty.CreateOpenrestyService(cfg, &gwc.isShuttingDown)
+	gwc.GWS = openresty.CreateOpenrestyService(cfg, &gwc.isShuttingDown, mqclient)
 
 	gwc.store = store.New(
 		clientset,
@@ -0,0 +1,316 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package openresty
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"github.com/goodrain/rainbond/gateway/controller/openresty/model"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// authSocket the unix socket the auth subrequests of auth.lua and authtls.lua are proxied to
+const authSocket = "/tmp/auth-nginx.socket"
+
+// forwardAuthTimeout the timeout of the requests to the forward authentication service
+var forwardAuthTimeout = 5 * time.Second
+
+// passwordCacheTTL the verified basic auth passwords are not checked by bcrypt again in the ttl
+var passwordCacheTTL = 5 * time.Minute
+
+// maxPasswordCache the max size of the verified passwords cache
+const maxPasswordCache = 4096
+
+// authID identifies the authentication config of a location
+func authID(serverName, path string) string {
+	sum := sha1.Sum([]byte(serverName + path))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// authConfigs returns the authentication configs of the locations of the servers
+func authConfigs(servers []*model.Server) map[string]auth.Config {
+	configs := make(map[string]auth.Config)
+	for _, server := range servers {
+		for _, loc := range server.Locations {
+			if loc.AuthID != "" {
+				configs[loc.AuthID] = loc.Auth
+			}
+		}
+	}
+	return configs
+}
+
+// authenticator authenticates the requests of the locations for auth.lua,
+// the requests are allowed if it responds 2xx, the other responses are returned to the client.
+type authenticator struct {
+	listener net.Listener
+	server   *http.Server
+	client   *http.Client
+	jwks     *jwksCache
+
+	lock    sync.RWMutex
+	configs map[string]auth.Config
+	// the PEM of the jwt public keys -> the parsed keys
+	jwtKeys map[string][]publicKey
+
+	pwdLock   sync.Mutex
+	passwords map[string]time.Time
+}
+
+// listenWorkerSocket listens on the unix socket which only the nginx workers can connect to.
+// The workers run as the nginx user, so the socket is shared with the group of the user by 0660,
+// or it is 0600 if the workers run as the current user.
+func listenWorkerSocket(socket, nginxUser string) (net.Listener, error) {
+	mode, gid := os.FileMode(0600), -1
+	current, err := user.Current()
+	if err != nil {
+		return nil, err
+	}
+	if nginxUser != "" && nginxUser != current.Username {
+		if gid, err = userGroup(nginxUser); err != nil {
+			return nil, err
+		}
+		mode = 0660
+	}
+	os.Remove(socket)
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+	if gid >= 0 {
+		if err := os.Chown(socket, -1, gid); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+	if err := os.Chmod(socket, mode); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+// userGroup the group of the nginx workers, which is the group named as the user like nginx does,
+// or the primary group of the user.
+func userGroup(name string) (int, error) {
+	if group, err := user.LookupGroup(name); err == nil {
+		return strconv.Atoi(group.Gid)
+	}
+	u, err := user.Lookup(name)
+	if err != nil {
+		return -1, err
+	}
+	return strconv.Atoi(u.Gid)
+}
+
+func newAuthenticator(nginxUser string) (*authenticator, error) {
+	listener, err := listenWorkerSocket(authSocket, nginxUser)
+	if err != nil {
+		return nil, err
+	}
+	a := &authenticator{
+		listener: listener,
+		client: &http.Client{
+			Timeout: forwardAuthTimeout,
+			// the redirections of the forward authentication are returned to the client
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		jwks:      newJWKSCache(),
+		configs:   make(map[string]auth.Config),
+		jwtKeys:   make(map[string][]publicKey),
+		passwords: make(map[string]time.Time),
+	}
+	a.server = &http.Server{Handler: a}
+	return a, nil
+}
+
+func (a *authenticator) start() {
+	if err := a.server.Serve(a.listener); err != nil && err != http.ErrServerClosed {
+		logrus.Errorf("auth server is closed: %v", err)
+	}
+}
+
+func (a *authenticator) stop() {
+	a.server.Close()
+}
+
+// update replaces the authentication configs, the jwt public keys are parsed once here
+func (a *authenticator) update(configs map[string]auth.Config) {
+	jwtKeys := make(map[string][]publicKey)
+	for id, cfg := range configs {
+		if cfg.Type != auth.TypeJWT || cfg.Keys == "" {
+			continue
+		}
+		if _, ok := jwtKeys[cfg.Keys]; ok {
+			continue
+		}
+		keys, err := parsePublicKeys(cfg.Keys)
+		if err != nil {
+			logrus.Warningf("parse jwt public keys of %s: %v", id, err)
+		}
+		jwtKeys[cfg.Keys] = keys
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.configs = configs
+	a.jwtKeys = jwtKeys
+}
+
+func (a *authenticator) config(id string) (auth.Config, bool) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	cfg, ok := a.configs[id]
+	return cfg, ok
+}
+
+func (a *authenticator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == clientCertPath {
+		serveClientCert(w, r)
+		return
+	}
+	id := r.URL.Query().Get("id")
+	cfg, ok := a.config(id)
+	if !ok {
+		// the config of the location is not synchronized yet
+		logrus.Warningf("auth config %s not found", id)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch cfg.Type {
+	case auth.TypeBasic:
+		a.basicAuth(w, r, &cfg)
+	case auth.TypeJWT:
+		a.jwtAuth(w, r, &cfg)
+	case auth.TypeForward:
+		a.forwardAuth(w, r, &cfg)
+	default:
+		w.WriteHeader(http.StatusForbidden)
+	}
+}
+
+func (a *authenticator) basicAuth(w http.ResponseWriter, r *http.Request, cfg *auth.Config) {
+	user, password, ok := r.BasicAuth()
+	if ok {
+		if hash, exists := cfg.Users[user]; exists && a.verifyPassword(hash, password) {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	w.Header().Set("WWW-Authenticate", `Basic realm="`+strings.Replace(cfg.Realm, `"`, "", -1)+`"`)
+	w.WriteHeader(http.StatusUnauthorized)
+}
+
+// verifyPassword compares the bcrypt hash with the password, the verified ones are cached
+// because bcrypt is too slow to check every request.
+func (a *authenticator) verifyPassword(hash, password string) bool {
+	sum := sha256.Sum256([]byte(hash + "\x00" + password))
+	key := string(sum[:])
+	now := time.Now()
+
+	a.pwdLock.Lock()
+	if verified, ok := a.passwords[key]; ok && now.Sub(verified) < passwordCacheTTL {
+		a.pwdLock.Unlock()
+		return true
+	}
+	a.pwdLock.Unlock()
+
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+		return false
+	}
+
+	a.pwdLock.Lock()
+	defer a.pwdLock.Unlock()
+	if len(a.passwords) >= maxPasswordCache {
+		for k, verified := range a.passwords {
+			if now.Sub(verified) >= passwordCacheTTL {
+				delete(a.passwords, k)
+			}
+		}
+		if len(a.passwords) >= maxPasswordCache {
+			a.passwords = make(map[string]time.Time)
+		}
+	}
+	a.passwords[key] = now
+	return true
+}
+
+// hopHeaders are not forwarded to the forward authentication service
+var hopHeaders = []string{"Connection", "Keep-Alive", "Proxy-Connection", "Te", "Trailer", "Transfer-Encoding", "Upgrade", "Content-Length"}
+
+// forwardAuth sends the headers of the request to the forward authentication service
+func (a *authenticator) forwardAuth(w http.ResponseWriter, r *http.Request, cfg *auth.Config) {
+	req, err := http.NewRequest(http.MethodGet, cfg.URL, nil)
+	if err != nil {
+		logrus.Warningf("create forward auth request %s: %v", cfg.URL, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	for name, values := range r.Header {
+		req.Header[name] = values
+	}
+	for _, name := range hopHeaders {
+		req.Header.Del(name)
+	}
+	res, err := a.client.Do(req)
+	if err != nil {
+		logrus.Warningf("forward auth request %s: %v", cfg.URL, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		for _, name := range cfg.ResponseHeaders {
+			if value := res.Header.Get(name); value != "" {
+				w.Header().Set(name, value)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden ||
+		(res.StatusCode >= 300 && res.StatusCode < 400):
+		for _, name := range []string{"WWW-Authenticate", "Location", "Set-Cookie", "Content-Type"} {
+			for _, value := range res.Header.Values(name) {
+				w.Header().Add(name, value)
+			}
+		}
+		w.WriteHeader(res.StatusCode)
+		io.Copy(w, io.LimitReader(res.Body, 64*1024))
+	default:
+		logrus.Warningf("unexpected status %d of forward auth %s", res.StatusCode, cfg.URL)
+		io.Copy(ioutil.Discard, io.LimitReader(res.Body, 64*1024))
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
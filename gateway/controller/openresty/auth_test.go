@@ -0,0 +1,292 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package openresty
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/user"
+	"path"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestAuthenticator(configs map[string]auth.Config) *authenticator {
+	a := &authenticator{
+		client: &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		jwks:      newJWKSCache(),
+		passwords: make(map[string]time.Time),
+	}
+	a.update(configs)
+	return a
+}
+
+func authenticate(a *authenticator, id string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/_rbd_auth?id="+id, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, req)
+	return w
+}
+
+func signJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:]); err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestBasicAuth(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	a := newTestAuthenticator(map[string]auth.Config{
+		"basic": {Type: auth.TypeBasic, Realm: "test", Users: map[string]string{"foo": string(hash)}},
+		"empty": {Type: auth.TypeBasic, Realm: "test"},
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.SetBasicAuth("foo", "secret")
+	// the verified password is cached
+	for i := 0; i < 2; i++ {
+		if w := authenticate(a, "basic", req.Header); w.Code != http.StatusOK {
+			t.Errorf("expected 200, but got %d", w.Code)
+		}
+	}
+	if w := authenticate(a, "empty", req.Header); w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without users, but got %d", w.Code)
+	}
+	req.SetBasicAuth("foo", "wrong")
+	w := authenticate(a, "basic", req.Header)
+	if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") != `Basic realm="test"` {
+		t.Errorf("expected 401 with the realm, but got %d %v", w.Code, w.Header())
+	}
+	if w := authenticate(a, "unknown", req.Header); w.Code != http.StatusForbidden {
+		t.Errorf("expected 403 of the unknown config, but got %d", w.Code)
+	}
+}
+
+func TestJWTAuth(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	der, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	pemKeys := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "EC",
+			"kid": "ec1",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(ecKey.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(ecKey.Y.FillBytes(make([]byte, 32))),
+		}}})
+	}))
+	defer jwks.Close()
+
+	a := newTestAuthenticator(map[string]auth.Config{
+		"pem":  {Type: auth.TypeJWT, Keys: pemKeys, Issuer: "rainbond", ClaimHeaders: map[string]string{"sub": "X-User"}},
+		"jwks": {Type: auth.TypeJWT, JWKSURL: jwks.URL, Audience: "api"},
+	})
+	if keys := a.jwtKeys[pemKeys]; len(keys) != 1 {
+		t.Fatalf("expected the public key parsed with the configs, but got %v", keys)
+	}
+	now := time.Now().Unix()
+	tests := []struct {
+		name   string
+		id     string
+		token  string
+		status int
+	}{
+		{"rsa", "pem", signJWT(t, "RS256", "", rsaKey, map[string]interface{}{"sub": "foo", "iss": "rainbond", "exp": now + 60}), http.StatusOK},
+		{"expired", "pem", signJWT(t, "RS256", "", rsaKey, map[string]interface{}{"iss": "rainbond", "exp": now - 120}), http.StatusUnauthorized},
+		{"issuer", "pem", signJWT(t, "RS256", "", rsaKey, map[string]interface{}{"iss": "other"}), http.StatusForbidden},
+		{"wrong key", "pem", signJWT(t, "ES256", "", ecKey, map[string]interface{}{"iss": "rainbond"}), http.StatusUnauthorized},
+		{"jwks", "jwks", signJWT(t, "ES256", "ec1", ecKey, map[string]interface{}{"aud": []string{"api"}}), http.StatusOK},
+		{"audience", "jwks", signJWT(t, "ES256", "ec1", ecKey, map[string]interface{}{"aud": "web"}), http.StatusForbidden},
+		{"none", "jwks", base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"aud":"api"}`)) + ".", http.StatusUnauthorized},
+		{"missing", "jwks", "", http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		header := http.Header{}
+		if tc.token != "" {
+			header.Set("Authorization", "Bearer "+tc.token)
+		}
+		w := authenticate(a, tc.id, header)
+		if w.Code != tc.status {
+			t.Errorf("%s: expected %d, but got %d", tc.name, tc.status, w.Code)
+		}
+		if tc.name == "rsa" && w.Header().Get("X-User") != "foo" {
+			t.Errorf("expected the claim header X-User foo, but got %v", w.Header())
+		}
+	}
+}
+
+func TestJWKSCache(t *testing.T) {
+	release := make(chan struct{})
+	var fetches int32
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		w.Write([]byte(`{"keys":[{"kty":"OKP","crv":"Ed25519","kid":"ed1","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},{"kty":"unknown"}]}`))
+	}))
+	defer slow.Close()
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"keys":[]}`))
+	}))
+	defer fast.Close()
+
+	c := newJWKSCache()
+	results := make(chan []publicKey, 2)
+	for i := 0; i < 2; i++ {
+		go func() { results <- c.get(slow.URL, "ed1") }()
+	}
+	// the slow key set does not block the others
+	done := make(chan struct{})
+	go func() {
+		c.get(fast.URL, "")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("the fetch of a slow jwks blocks the others")
+	}
+	close(release)
+	for i := 0; i < 2; i++ {
+		if keys := <-results; len(keys) != 1 || keys[0].kid != "ed1" {
+			t.Errorf("expected the key ed1, but got %v", keys)
+		}
+	}
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Errorf("expected the concurrent requests share 1 fetch, but got %d", n)
+	}
+}
+
+func TestForwardAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "token":
+			w.Header().Set("X-User", "foo")
+			w.Header().Set("X-Internal", "bar")
+		case "":
+			http.Redirect(w, r, "https://login.example.com", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	a := newTestAuthenticator(map[string]auth.Config{
+		"forward": {Type: auth.TypeForward, URL: server.URL, ResponseHeaders: []string{"X-User"}},
+	})
+	w := authenticate(a, "forward", http.Header{"Authorization": {"token"}})
+	if w.Code != http.StatusOK || w.Header().Get("X-User") != "foo" || w.Header().Get("X-Internal") != "" {
+		t.Errorf("expected 200 with X-User only, but got %d %v", w.Code, w.Header())
+	}
+	w = authenticate(a, "forward", http.Header{})
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "https://login.example.com" {
+		t.Errorf("expected the redirection, but got %d %v", w.Code, w.Header())
+	}
+	if w := authenticate(a, "forward", http.Header{"Authorization": {"other"}}); w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, but got %d", w.Code)
+	}
+}
+
+func TestListenWorkerSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "socket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	current, err := user.Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+	type testCase struct {
+		name      string
+		nginxUser string
+		mode      os.FileMode
+	}
+	tests := []testCase{
+		{name: "current user", nginxUser: current.Username, mode: 0600},
+		{name: "no user", mode: 0600},
+	}
+	// the gateway runs as root to change the group of the socket to the group of another user
+	if _, err := user.Lookup("nobody"); err == nil && current.Uid == "0" {
+		tests = append(tests, testCase{name: "other user", nginxUser: "nobody", mode: 0660})
+	}
+	for _, tc := range tests {
+		socket := path.Join(dir, "auth.socket")
+		listener, err := listenWorkerSocket(socket, tc.nginxUser)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		stat, err := os.Stat(socket)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stat.Mode().Perm() != tc.mode {
+			t.Errorf("%s: expected mode %v, but got %v", tc.name, tc.mode, stat.Mode().Perm())
+		}
+		if tc.mode == 0660 {
+			gid, _ := userGroup(tc.nginxUser)
+			if got := int(stat.Sys().(*syscall.Stat_t).Gid); got != gid {
+				t.Errorf("%s: expected group %d, but got %d", tc.name, gid, got)
+			}
+		}
+		listener.Close()
+	}
+}
@@ -0,0 +1,76 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package openresty
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// clientCertPath the path of the client certificate subrequests of authtls.lua
+const clientCertPath = "/client-cert"
+
+// serveClientCert responds the subject alternative names of the client certificate verified by nginx
+func serveClientCert(w http.ResponseWriter, r *http.Request) {
+	san, err := certificateSAN(r.Header.Get("X-Client-Cert"))
+	if err != nil {
+		logrus.Warningf("parse client certificate: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("X-Client-SAN", san)
+	w.WriteHeader(http.StatusOK)
+}
+
+// certificateSAN returns the comma separated subject alternative names of the url escaped PEM certificate,
+// in the format of openssl, such as DNS:example.com,IP:10.0.0.1
+func certificateSAN(escaped string) (string, error) {
+	data, err := url.QueryUnescape(escaped)
+	if err != nil {
+		return "", err
+	}
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return "", fmt.Errorf("no certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	var names []string
+	for _, name := range cert.DNSNames {
+		names = append(names, "DNS:"+name)
+	}
+	for _, ip := range cert.IPAddresses {
+		names = append(names, "IP:"+ip.String())
+	}
+	for _, email := range cert.EmailAddresses {
+		names = append(names, "email:"+email)
+	}
+	for _, uri := range cert.URIs {
+		names = append(names, "URI:"+uri.String())
+	}
+	return strings.Join(names, ","), nil
+}
@@ -0,0 +1,93 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package openresty
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func escapedTestCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spiffe, _ := url.Parse("spiffe://partner.example.com/client")
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		Subject:        pkix.Name{CommonName: "client"},
+		NotBefore:      time.Now(),
+		NotAfter:       time.Now().Add(time.Hour),
+		DNSNames:       []string{"client.example.com"},
+		IPAddresses:    []net.IP{net.ParseIP("10.0.0.1")},
+		EmailAddresses: []string{"ops@example.com"},
+		URIs:           []*url.URL{spiffe},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+}
+
+func TestCertificateSAN(t *testing.T) {
+	san, err := certificateSAN(escapedTestCertificate(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "DNS:client.example.com,IP:10.0.0.1,email:ops@example.com,URI:spiffe://partner.example.com/client"
+	if san != want {
+		t.Errorf("expected %s, but got %s", want, san)
+	}
+	if _, err := certificateSAN("invalid"); err == nil {
+		t.Errorf("expected an error of the invalid certificate")
+	}
+}
+
+func TestServeClientCert(t *testing.T) {
+	a := newTestAuthenticator(nil)
+	req := httptest.NewRequest(http.MethodGet, clientCertPath, nil)
+	req.Header.Set("X-Client-Cert", escapedTestCertificate(t))
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, but got %d", w.Code)
+	}
+	if san := w.Header().Get("X-Client-SAN"); san == "" {
+		t.Errorf("expected the SAN of the client certificate")
+	}
+
+	req = httptest.NewRequest(http.MethodGet, clientCertPath, nil)
+	w = httptest.NewRecorder()
+	a.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 without the client certificate, but got %d", w.Code)
+	}
+}
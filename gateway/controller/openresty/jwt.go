@@ -0,0 +1,342 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package openresty
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"github.com/sirupsen/logrus"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// jwtLeeway the clock skew allowed when the exp and nbf claims are checked
+var jwtLeeway = 60 * time.Second
+
+// jwksTTL the fetched JSON web key sets are used in the ttl
+var jwksTTL = 10 * time.Minute
+
+// jwksRefetchInterval the JSON web key set is fetched at most once in the interval
+// when the token is signed by an unknown key
+var jwksRefetchInterval = 30 * time.Second
+
+// jwtMethods the signing methods of the public keys, the none and HMAC methods are not allowed
+// because the keys of the gateway are public.
+var jwtMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// jwtError is returned if the token is not acceptable, status is the http status responded to the client
+type jwtError struct {
+	status int
+	msg    string
+}
+
+func (e *jwtError) Error() string {
+	return e.msg
+}
+
+func unauthorized(format string, args ...interface{}) error {
+	return &jwtError{status: http.StatusUnauthorized, msg: fmt.Sprintf(format, args...)}
+}
+
+func forbidden(format string, args ...interface{}) error {
+	return &jwtError{status: http.StatusForbidden, msg: fmt.Sprintf(format, args...)}
+}
+
+// publicKey a key to verify the JWT, kid is empty if the key is not from a JSON web key set
+type publicKey struct {
+	kid string
+	key crypto.PublicKey
+}
+
+func (a *authenticator) jwtAuth(w http.ResponseWriter, r *http.Request, cfg *auth.Config) {
+	claims, err := a.verifyJWT(bearerToken(r), cfg)
+	if err != nil {
+		status := http.StatusUnauthorized
+		if je, ok := err.(*jwtError); ok {
+			status = je.status
+		}
+		logrus.Debugf("jwt of %s is rejected: %v", r.Header.Get("X-Original-URI"), err)
+		if status == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		}
+		w.WriteHeader(status)
+		return
+	}
+	for claim, header := range cfg.ClaimHeaders {
+		if value, ok := claimString(claims[claim]); ok {
+			w.Header().Set(header, value)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func bearerToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) > 7 && strings.EqualFold(authorization[:7], "bearer ") {
+		return strings.TrimSpace(authorization[7:])
+	}
+	return ""
+}
+
+// claimString formats the claim passed to the upstream
+func claimString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return fmt.Sprint(v), true
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	}
+}
+
+// verifyJWT verifies the signature and the claims of the token
+func (a *authenticator) verifyJWT(token string, cfg *auth.Config) (jwt.MapClaims, error) {
+	if token == "" {
+		return nil, unauthorized("missing bearer token")
+	}
+	// the claims are validated with the leeway below
+	parser := jwt.NewParser(jwt.WithValidMethods(jwtMethods), jwt.WithJSONNumber(), jwt.WithoutClaimsValidation())
+	unverified, _, err := parser.ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return nil, unauthorized("malformed token: %v", err)
+	}
+	kid, _ := unverified.Header["kid"].(string)
+
+	var keys []publicKey
+	keys = append(keys, a.jwtPublicKeys(cfg.Keys)...)
+	if cfg.JWKSURL != "" {
+		keys = append(keys, a.jwks.get(cfg.JWKSURL, kid)...)
+	}
+	var claims jwt.MapClaims
+	err = fmt.Errorf("no key to verify the token")
+	for _, key := range keys {
+		if kid != "" && key.kid != "" && key.kid != kid {
+			continue
+		}
+		claims = jwt.MapClaims{}
+		if _, err = parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+			return key.key, nil
+		}); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, unauthorized("invalid token of alg %s: %v", unverified.Method.Alg(), err)
+	}
+	if err := validateClaims(claims, cfg, time.Now()); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func validateClaims(claims jwt.MapClaims, cfg *auth.Config, now time.Time) error {
+	if !claims.VerifyExpiresAt(now.Add(-jwtLeeway).Unix(), false) {
+		return unauthorized("token is expired")
+	}
+	if !claims.VerifyNotBefore(now.Add(jwtLeeway).Unix(), false) {
+		return unauthorized("token is not valid yet")
+	}
+	if cfg.Issuer != "" && !claims.VerifyIssuer(cfg.Issuer, true) {
+		return forbidden("unexpected issuer %v", claims["iss"])
+	}
+	if cfg.Audience != "" && !claims.VerifyAudience(cfg.Audience, true) {
+		return forbidden("unexpected audience")
+	}
+	return nil
+}
+
+// jwtPublicKeys the public keys in the PEM of the jwt configs, they are parsed when the configs are updated
+func (a *authenticator) jwtPublicKeys(data string) []publicKey {
+	if data == "" {
+		return nil
+	}
+	a.lock.RLock()
+	keys, ok := a.jwtKeys[data]
+	a.lock.RUnlock()
+	if ok {
+		return keys
+	}
+	keys, err := parsePublicKeys(data)
+	if err != nil {
+		logrus.Warningf("parse jwt public keys: %v", err)
+	}
+	return keys
+}
+
+// parsePublicKeys parses the PEM encoded public keys or certificates
+func parsePublicKeys(data string) ([]publicKey, error) {
+	var keys []publicKey
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return keys, nil
+		}
+		var key crypto.PublicKey
+		var err error
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		case "CERTIFICATE":
+			var cert *x509.Certificate
+			if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+				key = cert.PublicKey
+			}
+		default:
+			err = fmt.Errorf("unsupported pem block %s", block.Type)
+		}
+		if err != nil {
+			return keys, err
+		}
+		keys = append(keys, publicKey{key: key})
+	}
+}
+
+type jwksEntry struct {
+	keys      []publicKey
+	fetchedAt time.Time
+	triedAt   time.Time
+	// fetching is closed when the fetch in flight is done
+	fetching chan struct{}
+}
+
+// jwksCache caches the JSON web key sets by the url
+type jwksCache struct {
+	client  *http.Client
+	lock    sync.Mutex
+	entries map[string]*jwksEntry
+}
+
+func newJWKSCache() *jwksCache {
+	return &jwksCache{
+		client:  &http.Client{Timeout: 5 * time.Second},
+		entries: make(map[string]*jwksEntry),
+	}
+}
+
+// get returns the keys of the JSON web key set, it is fetched again if it is expired,
+// or the kid is unknown and it is not fetched in the refetch interval.
+// The key set is fetched without holding the lock, the concurrent requests of the same url
+// wait for the fetch in flight, and the requests of the other urls are not blocked.
+func (c *jwksCache) get(url, kid string) []publicKey {
+	c.lock.Lock()
+	now := time.Now()
+	entry, ok := c.entries[url]
+	if !ok {
+		entry = &jwksEntry{}
+		c.entries[url] = entry
+	}
+	fresh := !entry.fetchedAt.IsZero() && now.Sub(entry.fetchedAt) < jwksTTL
+	if fresh && (kid == "" || hasKid(entry.keys, kid)) {
+		defer c.lock.Unlock()
+		return entry.keys
+	}
+	if fetching := entry.fetching; fetching != nil {
+		c.lock.Unlock()
+		<-fetching
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return entry.keys
+	}
+	if now.Sub(entry.triedAt) < jwksRefetchInterval {
+		defer c.lock.Unlock()
+		return entry.keys
+	}
+	entry.triedAt = now
+	fetching := make(chan struct{})
+	entry.fetching = fetching
+	c.lock.Unlock()
+
+	keys, err := c.fetch(url)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err != nil {
+		// the stale keys are used until the key set is fetched successfully
+		logrus.Warningf("fetch jwks %s: %v", url, err)
+	} else {
+		entry.keys = keys
+		entry.fetchedAt = now
+	}
+	entry.fetching = nil
+	close(fetching)
+	return entry.keys
+}
+
+func hasKid(keys []publicKey, kid string) bool {
+	for _, key := range keys {
+		if key.kid == kid {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *jwksCache) fetch(url string) ([]publicKey, error) {
+	res, err := c.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	// the keys are decoded one by one, so that an unsupported key does not fail the whole set
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(&set); err != nil {
+		return nil, err
+	}
+	var keys []publicKey
+	for i, raw := range set.Keys {
+		var key jose.JSONWebKey
+		if err := key.UnmarshalJSON(raw); err != nil {
+			logrus.Warningf("skip the key %d of jwks %s: %v", i, url, err)
+			continue
+		}
+		if (key.Use != "" && key.Use != "sig") || !key.IsPublic() {
+			continue
+		}
+		keys = append(keys, publicKey{kid: key.KeyID, key: key.Key})
+	}
+	return keys, nil
+}
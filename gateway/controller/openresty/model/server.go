@@ -4,7 +4,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"github.com/goodrain/rainbond/gateway/annotations/authtls"
+	"github.com/goodrain/rainbond/gateway/annotations/ipaccess"
 	"github.com/goodrain/rainbond/gateway/annotations/proxy"
+	"github.com/goodrain/rainbond/gateway/annotations/proxyssl"
+	"github.com/goodrain/rainbond/gateway/annotations/ratelimit"
 	"github.com/goodrain/rainbond/gateway/annotations/rewrite"
 	v1 "github.com/goodrain/rainbond/gateway/v1"
 )
@@ -28,12 +33,18 @@ type Server struct {
 	SSLCertificate          string // Specifies a file with the certificate in the PEM format.
 	SSLCertificateKey       string // Specifies a file with the secret key in the PEM format.
 	EnableSSLStapling       bool
+	SSLClientCertificate    string // Specifies a file with the CA bundle the client certificates are verified by.
+	SSLVerifyClient         string // Enables verification of the client certificates.
+	SSLVerifyDepth          int    // Sets the verification depth in the client certificates chain.
 	ForceSSLRedirect        bool
 	Return                  Return
 	Rewrites                []Rewrite
 	Locations               []*Location
 	OptionValue             map[string]string
 	UpstreamName            string //used for tcp and udp server
+	EnableAuth              bool   //enables the internal location the requests are authenticated by
+	EnableAuthTLS           bool   //enables the internal location the client certificates are parsed by
+	EnableACMEChallenge     bool   //enables the location the http-01 challenges of the auto TLS certificates are served by
 
 	// Sets the number of datagrams expected from the proxied server in response
 	// to the client request if the UDP protocol is used.
@@ -117,6 +128,20 @@ type Location struct {
 	//PathRewrite if true, path will not passed to the upstream
 	PathRewrite   bool
 	NameCondition map[string]*v1.Condition
+	// WakeTimeout seconds to hold the request while the idle component is waking up, 0 means not hold
+	WakeTimeout int
+	// RateLimit limits the requests of the location
+	RateLimit ratelimit.Config
+	// IPAccess allows or denies the client networks of the location
+	IPAccess ipaccess.Config
+	// Auth authenticates the requests of the location
+	Auth auth.Config
+	// AuthID identifies the authentication config of the location in the authenticator
+	AuthID string
+	// AuthTLS verifies the client certificates of the location
+	AuthTLS authtls.Config
+	// ProxySSL connects the upstream of the location by TLS
+	ProxySSL proxyssl.Config
 
 	// Proxy contains information about timeouts and buffer sizes
 	// to be used in connections against endpoints
@@ -39,6 +39,7 @@ import (
 	"github.com/goodrain/rainbond/gateway/controller/openresty/model"
 	"github.com/goodrain/rainbond/gateway/controller/openresty/template"
 	v1 "github.com/goodrain/rainbond/gateway/v1"
+	"github.com/goodrain/rainbond/mq/client"
 	"github.com/goodrain/rainbond/util"
 	"github.com/sirupsen/logrus"
 )
@@ -53,13 +54,19 @@ type OrService struct {
 	ocfg          *option.Config
 	nginxProgress *os.Process
 	configManage  *template.NginxConfigFileTemplete
+	// mqclient sends the wake tasks of the idle components, nil means the requests are not held
+	mqclient client.MQClient
+	waker    *waker
+	// authenticator authenticates the requests of the locations with authentication
+	authenticator *authenticator
 }
 
 //CreateOpenrestyService create openresty service
-func CreateOpenrestyService(config *option.Config, isShuttingDown *bool) *OrService {
+func CreateOpenrestyService(config *option.Config, isShuttingDown *bool, mqclient client.MQClient) *OrService {
 	gws := &OrService{
 		IsShuttingDown: isShuttingDown,
 		ocfg:           config,
+		mqclient:       mqclient,
 	}
 	return gws
 }
@@ -111,6 +118,22 @@ func (o *OrService) Start(errCh chan error) error {
 		return err
 	}
 	logrus.Infof("init openresty config success")
+	if o.mqclient != nil {
+		waker, err := newWaker(o.mqclient, o.ocfg.NginxUser)
+		if err != nil {
+			logrus.Errorf("create wake listener failure %s", err.Error())
+			return err
+		}
+		o.waker = waker
+		go o.waker.start()
+	}
+	authenticator, err := newAuthenticator(o.ocfg.NginxUser)
+	if err != nil {
+		logrus.Errorf("create auth listener failure %s", err.Error())
+		return err
+	}
+	o.authenticator = authenticator
+	go o.authenticator.start()
 	go func() {
 		for {
 			logrus.Infof("start openresty progress")
@@ -135,6 +158,12 @@ func (o *OrService) Start(errCh chan error) error {
 func (o *OrService) Stop() error {
 	// send stop signal to openresty
 	logrus.Info("Stopping openresty process")
+	if o.waker != nil {
+		o.waker.stop()
+	}
+	if o.authenticator != nil {
+		o.authenticator.stop()
+	}
 	if o.nginxProgress != nil {
 		if err := o.nginxProgress.Signal(syscall.SIGTERM); err != nil {
 			return err
@@ -146,6 +175,10 @@ func (o *OrService) Stop() error {
 // PersistConfig persists ocfg
 func (o *OrService) PersistConfig(conf *v1.Config) error {
 	l7srv, l4srv := o.getNgxServer(conf)
+	if o.authenticator != nil {
+		// the configs must be ready before nginx reloads
+		o.authenticator.update(authConfigs(l7srv))
+	}
 	// http server
 	o.configManage.WriteServer(*o.ocfg, "http", "", l7srv...)
 	// tcp and udp server
@@ -220,8 +253,11 @@ func (o *OrService) getNgxServer(conf *v1.Config) (l7srv []*model.Server, l4srv
 			server.SSLCertificate = vs.SSLCert.CertificatePem
 			server.SSLCertificateKey = vs.SSLCert.CertificatePem
 			server.EnableSSLStapling = o.ocfg.EnableSSLStapling
-
+			server.SSLClientCertificate = vs.SSLClientCertificate
+			server.SSLVerifyClient = vs.SSLVerifyClient
+			server.SSLVerifyDepth = vs.SSLVerifyDepth
 		}
+		server.EnableACMEChallenge = vs.EnableACMEChallenge
 		for _, loc := range vs.Locations {
 			location := &model.Location{
 				DisableAccessLog: o.ocfg.AccessLogPath == "",
@@ -234,6 +270,19 @@ func (o *OrService) getNgxServer(conf *v1.Config) (l7srv []*model.Server, l4srv
 				Rewrite:          loc.Rewrite,
 				PathRewrite:      loc.PathRewrite,
 				DisableProxyPass: loc.DisableProxyPass,
+				WakeTimeout:      loc.WakeTimeout,
+				RateLimit:        loc.RateLimit,
+				IPAccess:         loc.IPAccess,
+				Auth:             loc.Auth,
+				AuthTLS:          loc.AuthTLS,
+				ProxySSL:         loc.ProxySSL,
+			}
+			if location.Auth.Enabled() {
+				location.AuthID = authID(server.ServerName, loc.Path)
+				server.EnableAuth = true
+			}
+			if location.AuthTLS.Enabled() {
+				server.EnableAuthTLS = true
 			}
 			server.Locations = append(server.Locations, location)
 		}
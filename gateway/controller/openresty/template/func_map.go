@@ -20,11 +20,13 @@ package template
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 	text_template "text/template"
 
 	"github.com/golang/glog"
+	"github.com/goodrain/rainbond/gateway/annotations/ratelimit"
 	"github.com/goodrain/rainbond/gateway/controller/openresty/model"
 	v1 "github.com/goodrain/rainbond/gateway/v1"
 	"github.com/sirupsen/logrus"
@@ -58,6 +60,8 @@ func buildLuaHeaderRouter(input interface{}) string {
 	}
 	_ = loc
 	out := []string{"access_by_lua_block {"}
+	// the clients are checked before the routing
+	out = append(out, buildLuaAccessControl(loc)...)
 
 	priority := make([]string, 3)
 	for name, c := range loc.NameCondition {
@@ -123,12 +127,81 @@ func buildLuaHeaderRouter(input interface{}) string {
 			out = append(out, priority[i])
 		}
 	}
+	// hold the request while the idle component is waking up
+	if loc.WakeTimeout > 0 {
+		out = append(out, fmt.Sprintf("\t\t\twake.hold(%d)", loc.WakeTimeout))
+	}
 
 	out = append(out, "\t\t}")
 
 	return strings.Join(out, "\n\r")
 }
 
+// buildLuaAccessControl checks the client networks and certificates, limits and authenticates the requests of the location
+func buildLuaAccessControl(loc *model.Location) []string {
+	var out []string
+	if loc.IPAccess.DenyAll {
+		out = append(out, "\t\t\taccess.deny()")
+	} else if loc.IPAccess.Enabled() {
+		out = append(out, fmt.Sprintf("\t\t\taccess.check_ip(%s, %s)", buildLuaNetworks(loc.IPAccess.Allow), buildLuaNetworks(loc.IPAccess.Deny)))
+	}
+	if loc.AuthTLS.Enabled() {
+		out = append(out, fmt.Sprintf("\t\t\tauthtls.check(%q)", loc.AuthTLS.VerifyClient))
+	}
+	if loc.RateLimit.Enabled() {
+		key := "ngx.var.binary_remote_addr"
+		if loc.RateLimit.Key == ratelimit.KeyPath {
+			key = "ngx.var.uri"
+		} else if header := loc.RateLimit.Header(); header != "" {
+			key = "ngx.var.http_" + strings.ToLower(strings.Replace(header, "-", "_", -1))
+		}
+		out = append(out, fmt.Sprintf("\t\t\taccess.limit_rate(%q, %d, %d, %s)", loc.Path, loc.RateLimit.RPS, loc.RateLimit.Burst, key))
+	}
+	if loc.AuthID != "" {
+		out = append(out, fmt.Sprintf("\t\t\tauth.check(%q, %s)", loc.AuthID, buildLuaStrings(loc.Auth.UpstreamHeaders())))
+	}
+	return out
+}
+
+// buildLuaStrings renders the strings as a lua table
+func buildLuaStrings(values []string) string {
+	var quoted []string
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return "{" + strings.Join(quoted, ", ") + "}"
+}
+
+// buildLuaNetworks renders the CIDRs as a lua table of the binary ips and masks,
+// which are matched with ngx.var.binary_remote_addr
+func buildLuaNetworks(cidrs []string) string {
+	var networks []string
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			logrus.Warningf("skip invalid CIDR %s", cidr)
+			continue
+		}
+		ip := network.IP
+		if len(network.Mask) == net.IPv4len {
+			ip = ip.To4()
+		}
+		networks = append(networks, fmt.Sprintf("{%s, %s}", luaBytes(ip), luaBytes(network.Mask)))
+	}
+	return "{" + strings.Join(networks, ", ") + "}"
+}
+
+// luaBytes renders the bytes as a lua string of decimal escapes
+func luaBytes(b []byte) string {
+	var out strings.Builder
+	out.WriteString(`"`)
+	for _, c := range b {
+		fmt.Fprintf(&out, "\\%d", c)
+	}
+	out.WriteString(`"`)
+	return out.String()
+}
+
 // refer to http://nginx.org/en/docs/syntax.html
 // Nginx differentiates between size and offset
 // offset directives support gigabytes in addition
@@ -0,0 +1,128 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package openresty
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/sirupsen/logrus"
+)
+
+// wakeSocket the unix socket to receive the wake messages from wake.lua
+const wakeSocket = "/tmp/wake-nginx.socket"
+
+// wakeInterval the gateway sends at most one wake task of a component in the interval
+var wakeInterval = 10 * time.Second
+
+// wakeMessage is sent by openresty when it holds a request of an idle component
+type wakeMessage struct {
+	TenantID  string `json:"tenant_id"`
+	ServiceID string `json:"service_id"`
+	Host      string `json:"host"`
+}
+
+// waker sends the 'wake_component' tasks to the worker for the requests held by openresty
+type waker struct {
+	mqclient client.MQClient
+	listener net.Listener
+	lock     sync.Mutex
+	lastSent map[string]time.Time
+}
+
+func newWaker(mqclient client.MQClient, nginxUser string) (*waker, error) {
+	listener, err := listenWorkerSocket(wakeSocket, nginxUser)
+	if err != nil {
+		return nil, err
+	}
+	return &waker{
+		mqclient: mqclient,
+		listener: listener,
+		lastSent: make(map[string]time.Time),
+	}, nil
+}
+
+func (w *waker) start() {
+	for {
+		conn, err := w.listener.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			logrus.Infof("wake listener is closed: %v", err)
+			return
+		}
+		go w.handle(conn)
+	}
+}
+
+func (w *waker) stop() {
+	w.listener.Close()
+}
+
+func (w *waker) handle(conn net.Conn) {
+	defer conn.Close()
+	data, err := ioutil.ReadAll(conn)
+	if err != nil {
+		return
+	}
+	var msg wakeMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		logrus.Warningf("unexpected wake message %s: %v", string(data), err)
+		return
+	}
+	if msg.ServiceID == "" || !w.shouldSend(msg.ServiceID) {
+		return
+	}
+	logrus.Infof("hold the request of %s, wake up component %s", msg.Host, msg.ServiceID)
+	err = w.mqclient.SendBuilderTopic(client.TaskStruct{
+		TaskType: "wake_component",
+		TaskBody: msg,
+		Topic:    client.WorkerTopic,
+		Priority: client.PriorityHigh,
+		TenantID: msg.TenantID,
+	})
+	if err != nil {
+		logrus.Errorf("send wake task of component %s: %v", msg.ServiceID, err)
+		w.lock.Lock()
+		delete(w.lastSent, msg.ServiceID)
+		w.lock.Unlock()
+	}
+}
+
+// shouldSend returns true if no wake task of the component is sent in the wake interval
+func (w *waker) shouldSend(serviceID string) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	now := time.Now()
+	if last, ok := w.lastSent[serviceID]; ok && now.Sub(last) < wakeInterval {
+		return false
+	}
+	for id, last := range w.lastSent {
+		if now.Sub(last) >= wakeInterval {
+			delete(w.lastSent, id)
+		}
+	}
+	w.lastSent[serviceID] = now
+	return true
+}
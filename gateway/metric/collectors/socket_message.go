@@ -26,6 +26,7 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -51,6 +52,8 @@ type socketData struct {
 	Namespace      string  `json:"namespace"`
 	ServiceID      string  `json:"service_id"`
 	Path           string  `json:"path"`
+	// Limited the reason the request is rejected by the access control, ip, rate, unauthorized or forbidden
+	Limited string `json:"limited"`
 }
 
 // SocketCollector stores prometheus metrics and ingress meta-data
@@ -63,6 +66,8 @@ type SocketCollector struct {
 	upstreamLatency *prometheus.SummaryVec
 	bytesSent       *prometheus.HistogramVec
 	requests        *prometheus.CounterVec
+	lastRequest     *prometheus.GaugeVec
+	limitedRequests *prometheus.CounterVec
 	listener        net.Listener
 	metricMapping   map[string]interface{}
 	hosts           sets.String
@@ -166,6 +171,26 @@ func NewSocketCollector(gatewayHost string, metricsPerHost bool) (*SocketCollect
 			requestTags,
 		),
 
+		lastRequest: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "last_request_timestamp_seconds",
+				Help:        "The unix time of the last client request of the service, used to find the idle services.",
+				Namespace:   PrometheusNamespace,
+				ConstLabels: constLabels,
+			},
+			[]string{"namespace", "service_id"},
+		),
+
+		limitedRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "limited_requests_total",
+				Help:        "The total number of client requests rejected by the rate limit, the ip access control or the authentication.",
+				Namespace:   PrometheusNamespace,
+				ConstLabels: constLabels,
+			},
+			[]string{"namespace", "service_id", "host", "reason"},
+		),
+
 		upstreamLatency: prometheus.NewSummaryVec(
 			prometheus.SummaryOpts{
 				Name:        "upstream_latency_seconds",
@@ -206,6 +231,7 @@ func (sc *SocketCollector) handleMessage(msg []byte) {
 		logrus.Errorf("Unexpected error deserializing JSON payload: %v. Payload:\n%v", err, string(msg))
 		return
 	}
+	now := float64(time.Now().Unix())
 	for _, stats := range statsBatch {
 		if !sc.hosts.HasAny(stats.Host, "tls"+stats.Host) {
 			logrus.Debugf("skiping metric for host %v that is not being served", stats.Host)
@@ -241,6 +267,28 @@ func (sc *SocketCollector) handleMessage(msg []byte) {
 		} else {
 			requestsMetric.Inc()
 		}
+		lastRequestMetric, err := sc.lastRequest.GetMetricWith(prometheus.Labels{
+			"namespace":  stats.Namespace,
+			"service_id": stats.ServiceID,
+		})
+		if err != nil {
+			logrus.Errorf("Error fetching last request metric: %v", err)
+		} else {
+			lastRequestMetric.Set(now)
+		}
+		if stats.Limited != "" {
+			limitedMetric, err := sc.limitedRequests.GetMetricWith(prometheus.Labels{
+				"namespace":  stats.Namespace,
+				"service_id": stats.ServiceID,
+				"host":       stats.Host,
+				"reason":     stats.Limited,
+			})
+			if err != nil {
+				logrus.Errorf("Error fetching limited requests metric: %v", err)
+			} else {
+				limitedMetric.Inc()
+			}
+		}
 		if stats.Latency != -1 {
 			latencyMetric, err := sc.upstreamLatency.GetMetricWith(latencyLabels)
 			if err != nil {
@@ -359,6 +407,8 @@ func (sc SocketCollector) Describe(ch chan<- *prometheus.Desc) {
 	sc.requestTime.Describe(ch)
 	sc.requestLength.Describe(ch)
 	sc.requests.Describe(ch)
+	sc.lastRequest.Describe(ch)
+	sc.limitedRequests.Describe(ch)
 	sc.upstreamLatency.Describe(ch)
 	sc.responseTime.Describe(ch)
 	sc.responseLength.Describe(ch)
@@ -370,6 +420,8 @@ func (sc SocketCollector) Collect(ch chan<- prometheus.Metric) {
 	sc.requestTime.Collect(ch)
 	sc.requestLength.Collect(ch)
 	sc.requests.Collect(ch)
+	sc.lastRequest.Collect(ch)
+	sc.limitedRequests.Collect(ch)
 	sc.upstreamLatency.Collect(ch)
 	sc.responseTime.Collect(ch)
 	sc.responseLength.Collect(ch)
@@ -20,7 +20,9 @@ package store
 
 import (
 	"bytes"
+	"crypto/sha1"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
@@ -36,8 +38,11 @@ import (
 	"github.com/eapache/channels"
 	"github.com/goodrain/rainbond/cmd/gateway/option"
 	"github.com/goodrain/rainbond/gateway/annotations"
+	"github.com/goodrain/rainbond/gateway/annotations/authtls"
 	"github.com/goodrain/rainbond/gateway/annotations/l4"
 	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/proxyssl"
+	"github.com/goodrain/rainbond/gateway/annotations/resolver"
 	"github.com/goodrain/rainbond/gateway/annotations/rewrite"
 	"github.com/goodrain/rainbond/gateway/cluster"
 	"github.com/goodrain/rainbond/gateway/controller/config"
@@ -67,6 +72,8 @@ const (
 	UpdateEvent EventType = "UPDATE"
 	// DeleteEvent event associated when an object is removed from an informer
 	DeleteEvent EventType = "DELETE"
+	// ACMEChallengeLabel the label of the secrets of the http-01 challenges, which are created by the acme controller of the worker
+	ACMEChallengeLabel = "rainbond.io/acme-challenge"
 	// CertificatePath is the default path of certificate file
 	CertificatePath = "/run/nginx/conf/certificate"
 	// DefVirSrvName is the default virtual service name
@@ -98,6 +105,9 @@ type Storer interface {
 
 	// GetDefaultBackend returns the default backend configuration
 	GetDefaultBackend() defaults.Backend
+
+	// GetACMEChallenge returns the key authorization of the http-01 challenge token
+	GetACMEChallenge(token string) (string, bool)
 }
 
 type backend struct {
@@ -192,6 +202,9 @@ func New(client kubernetes.Interface,
 
 	store.informers.Secret = store.sharedInformer.Core().V1().Secrets().Informer()
 	store.listers.Secret.Store = store.informers.Secret.GetStore()
+	if err := store.informers.Secret.AddIndexers(cache.Indexers{acmeTokenIndex: acmeChallengeTokens}); err != nil {
+		logrus.Errorf("add the index of the acme challenges: %v", err)
+	}
 
 	ingEventHandler := cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -247,6 +260,14 @@ func New(client kubernetes.Interface,
 			sec := obj.(*corev1.Secret)
 			key := ik8s.MetaNamespaceKey(sec)
 
+			if store.syncAuthSecret(sec) {
+				logrus.Infof("secret %v was added and it is used in ingress authentication. Parsing...", key)
+				updateCh.In() <- Event{
+					Type: CreateEvent,
+					Obj:  obj,
+				}
+			}
+
 			// find references in ingresses and update local ssl certs
 			if ings := store.secretIngressMap.getSecretKeys(key); len(ings) > 0 {
 				logrus.Infof("secret %v was added and it is used in ingress annotations. Parsing...", key)
@@ -274,6 +295,14 @@ func New(client kubernetes.Interface,
 				}
 				key := ik8s.MetaNamespaceKey(curSec)
 
+				if store.syncAuthSecret(curSec) {
+					logrus.Infof("secret %v was updated and it is used in ingress authentication. Parsing...", key)
+					updateCh.In() <- Event{
+						Type: UpdateEvent,
+						Obj:  cur,
+					}
+				}
+
 				// find references in ingresses and update local ssl certs
 				if ings := store.secretIngressMap.getSecretKeys(key); len(ings) > 0 {
 					logrus.Infof("secret %v was updated and it is used in ingress annotations. Parsing...", key)
@@ -312,6 +341,14 @@ func New(client kubernetes.Interface,
 
 			key := ik8s.MetaNamespaceKey(sec)
 
+			if store.syncAuthSecret(sec) {
+				logrus.Infof("secret %v was deleted and it is used in ingress authentication. Parsing...", key)
+				updateCh.In() <- Event{
+					Type: DeleteEvent,
+					Obj:  obj,
+				}
+			}
+
 			// find references in ingresses
 			if ings := store.secretIngressMap.getSecretKeys(key); len(ings) > 0 {
 				logrus.Infof("secret %v was deleted and it is used in ingress annotations. Parsing...", key)
@@ -651,6 +688,9 @@ func (s *k8sStore) ListVirtualService() (l7vs []*v1.VirtualService, l4vs []*v1.V
 						l7vs = append(l7vs, vs)
 					}
 
+					if autoTLS, _ := parser.GetBoolAnnotation("auto-tls", &anns.ObjectMeta); autoTLS {
+						vs.EnableACMEChallenge = true
+					}
 					for _, path := range rule.IngressRuleValue.HTTP.Paths {
 						locKey := fmt.Sprintf("%s_%s", virSrvName, path.Path)
 						location := srvLocMap[locKey]
@@ -671,10 +711,17 @@ func (s *k8sStore) ListVirtualService() (l7vs []*v1.VirtualService, l4vs []*v1.V
 							if pathRewrite {
 								location.PathRewrite = true
 							}
+							location.WakeTimeout, _ = parser.GetIntAnnotation("wake-timeout", &ing.ObjectMeta)
 							srvLocMap[locKey] = location
 							vs.Locations = append(vs.Locations, location)
 							// the first ingress proxy takes effect
 							location.Proxy = anns.Proxy
+							location.RateLimit = anns.RateLimit
+							location.IPAccess = anns.IPAccess
+							location.Auth = anns.Auth
+							location.AuthTLS = anns.AuthTLS
+							location.ProxySSL = anns.ProxySSL
+							s.setClientVerification(vs, location)
 						}
 						// If their ServiceName is the same, then the new one will overwrite the old one.
 						nameCondition := &v1.Condition{}
@@ -763,6 +810,9 @@ func (s *k8sStore) ListVirtualService() (l7vs []*v1.VirtualService, l4vs []*v1.V
 						l7vs = append(l7vs, vs)
 					}
 
+					if autoTLS, _ := parser.GetBoolAnnotation("auto-tls", &anns.ObjectMeta); autoTLS {
+						vs.EnableACMEChallenge = true
+					}
 					for _, path := range rule.IngressRuleValue.HTTP.Paths {
 						locKey := fmt.Sprintf("%s_%s", virSrvName, path.Path)
 						location := srvLocMap[locKey]
@@ -784,10 +834,17 @@ func (s *k8sStore) ListVirtualService() (l7vs []*v1.VirtualService, l4vs []*v1.V
 							if pathRewrite {
 								location.PathRewrite = true
 							}
+							location.WakeTimeout, _ = parser.GetIntAnnotation("wake-timeout", &ing.ObjectMeta)
 							srvLocMap[locKey] = location
 							vs.Locations = append(vs.Locations, location)
 							// the first ingress proxy takes effect
 							location.Proxy = anns.Proxy
+							location.RateLimit = anns.RateLimit
+							location.IPAccess = anns.IPAccess
+							location.Auth = anns.Auth
+							location.AuthTLS = anns.AuthTLS
+							location.ProxySSL = anns.ProxySSL
+							s.setClientVerification(vs, location)
 						}
 						// If their ServiceName is the same, then the new one will overwrite the old one.
 						nameCondition := &v1.Condition{}
@@ -859,6 +916,9 @@ func (s *k8sStore) ListVirtualService() (l7vs []*v1.VirtualService, l4vs []*v1.V
 						l7vs = append(l7vs, vs)
 					}
 
+					if autoTLS, _ := parser.GetBoolAnnotation("auto-tls", &anns.ObjectMeta); autoTLS {
+						vs.EnableACMEChallenge = true
+					}
 					for _, path := range rule.IngressRuleValue.HTTP.Paths {
 						locKey := fmt.Sprintf("%s_%s", virSrvName, path.Path)
 						location := srvLocMap[locKey]
@@ -900,6 +960,9 @@ func (s *k8sStore) ListVirtualService() (l7vs []*v1.VirtualService, l4vs []*v1.V
 						l7vs = append(l7vs, vs)
 					}
 
+					if autoTLS, _ := parser.GetBoolAnnotation("auto-tls", &anns.ObjectMeta); autoTLS {
+						vs.EnableACMEChallenge = true
+					}
 					for _, path := range rule.IngressRuleValue.HTTP.Paths {
 						locKey := fmt.Sprintf("%s_%s", virSrvName, path.Path)
 						location := srvLocMap[locKey]
@@ -1130,6 +1193,134 @@ func (s *k8sStore) getCertificatePem(secrKey string) (*v1.SSLCert, error) {
 	}, nil
 }
 
+// GetSecret returns the secret by the key namespace/name
+func (s *k8sStore) GetSecret(key string) (*corev1.Secret, error) {
+	item, exists, err := s.listers.Secret.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("the secret named %s does not exists", key)
+	}
+	return item.(*corev1.Secret), nil
+}
+
+// GetAuthCertificate writes the CA bundles of the secret by the key namespace/name into files
+func (s *k8sStore) GetAuthCertificate(key string) (*resolver.AuthSSLCert, error) {
+	secret, err := s.GetSecret(key)
+	if err != nil {
+		return nil, err
+	}
+	ca := secret.Data[authtls.SecretCAKey]
+	upstreamCA := secret.Data[proxyssl.SecretUpstreamCAKey]
+	if len(ca) == 0 && len(upstreamCA) == 0 {
+		return nil, fmt.Errorf("no CA bundle found in the secret named %s", key)
+	}
+	if e := os.MkdirAll(CertificatePath, 0777); e != nil {
+		return nil, fmt.Errorf("cant not create directory %s: %v", CertificatePath, e)
+	}
+	name := strings.Replace(key, "/", "-", 1)
+	cert := &resolver.AuthSSLCert{Secret: key}
+	if len(ca) != 0 {
+		cert.CAFileName = fmt.Sprintf("%s/%s-ca.crt", CertificatePath, name)
+		if e := ioutil.WriteFile(cert.CAFileName, ca, 0666); e != nil {
+			return nil, fmt.Errorf("cant not write data to %s: %v", cert.CAFileName, e)
+		}
+	}
+	if len(upstreamCA) != 0 {
+		cert.UpstreamCAFileName = fmt.Sprintf("%s/%s-upstream-ca.crt", CertificatePath, name)
+		if e := ioutil.WriteFile(cert.UpstreamCAFileName, upstreamCA, 0666); e != nil {
+			return nil, fmt.Errorf("cant not write data to %s: %v", cert.UpstreamCAFileName, e)
+		}
+	}
+	// the configs are reloaded if the CA bundles are changed
+	hash := sha1.New()
+	hash.Write(ca)
+	hash.Write([]byte("\n"))
+	hash.Write(upstreamCA)
+	cert.PemSHA = hex.EncodeToString(hash.Sum(nil))
+	return cert, nil
+}
+
+// acmeTokenIndex the index of the secrets of the http-01 challenges by the tokens
+const acmeTokenIndex = "acmeToken"
+
+// acmeChallengeTokens indexes the secret of the http-01 challenges by its tokens, which are the keys of the data
+func acmeChallengeTokens(obj interface{}) ([]string, error) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok || secret.Labels[ACMEChallengeLabel] == "" {
+		return nil, nil
+	}
+	tokens := make([]string, 0, len(secret.Data))
+	for token := range secret.Data {
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+// GetACMEChallenge returns the key authorization of the http-01 challenge token
+func (s *k8sStore) GetACMEChallenge(token string) (string, bool) {
+	items, err := s.informers.Secret.GetIndexer().ByIndex(acmeTokenIndex, token)
+	if err != nil {
+		logrus.Warningf("get the acme challenge of %s: %v", token, err)
+		return "", false
+	}
+	for _, item := range items {
+		if response, ok := item.(*corev1.Secret).Data[token]; ok {
+			return string(response), true
+		}
+	}
+	return "", false
+}
+
+// syncAuthSecret extracts the annotations of the ingresses authenticating or verifying certificates
+// with the given secret again, returns true if there is any of them.
+func (s *k8sStore) syncAuthSecret(sec *corev1.Secret) bool {
+	var synced bool
+	for _, item := range s.listers.Ingress.List() {
+		var meta *metav1.ObjectMeta
+		if k8sutil.IsHighVersion() {
+			meta = &item.(*networkingv1.Ingress).ObjectMeta
+		} else {
+			meta = &item.(*betav1.Ingress).ObjectMeta
+		}
+		if meta.Namespace != sec.Namespace {
+			continue
+		}
+		authSecret, _ := parser.GetStringAnnotation("auth-secret", meta)
+		caSecret, _ := parser.GetStringAnnotation("ca-secret", meta)
+		if authSecret != sec.Name && caSecret != sec.Name {
+			continue
+		}
+		s.extractAnnotations(item)
+		synced = true
+	}
+	return synced
+}
+
+// setClientVerification verifies the client certificates of the virtual service by the CA bundle of the location,
+// nginx verifies them by the server, so the CA bundle of a domain is shared by its locations.
+func (s *k8sStore) setClientVerification(vs *v1.VirtualService, location *v1.Location) {
+	if !location.AuthTLS.Enabled() || location.AuthTLS.CAFileName == "" {
+		return
+	}
+	if vs.SSLCert == nil {
+		logrus.Warningf("virtual service %s verifies the client certificates without a certificate", vs.ServerName)
+		return
+	}
+	if vs.SSLClientCertificate == "" {
+		vs.SSLClientCertificate = location.AuthTLS.CAFileName
+	} else if vs.SSLClientCertificate != location.AuthTLS.CAFileName {
+		logrus.Warningf("virtual service %s verifies the client certificates by %s, the CA bundle %s is ignored",
+			vs.ServerName, vs.SSLClientCertificate, location.AuthTLS.CAFileName)
+	}
+	// the locations reject the requests without a verified client certificate by themselves
+	vs.SSLVerifyClient = authtls.VerifyOptional
+	if location.AuthTLS.VerifyDepth > vs.SSLVerifyDepth {
+		vs.SSLVerifyDepth = location.AuthTLS.VerifyDepth
+	}
+}
+
 // GetDefaultBackend returns the default backend
 func (s *k8sStore) GetDefaultBackend() defaults.Backend {
 	return s.GetBackendConfiguration().Backend
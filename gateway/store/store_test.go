@@ -9,6 +9,9 @@ import (
 	api "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 )
 
 func TestRbdStore_checkIngress(t *testing.T) {
@@ -68,3 +71,30 @@ func buildIngress() *networkingv1.Ingress {
 		},
 	}
 }
+
+func TestGetACMEChallenge(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme-challenge", Namespace: "rbd-system", Labels: map[string]string{ACMEChallengeLabel: "true"}},
+		Data:       map[string][]byte{"token1": []byte("token1.key")},
+	}, &api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "rbd-system"},
+		Data:       map[string][]byte{"token2": []byte("token2.key")},
+	})
+	informer := informers.NewSharedInformerFactory(clientset, 0).Core().V1().Secrets().Informer()
+	if err := informer.AddIndexers(cache.Indexers{acmeTokenIndex: acmeChallengeTokens}); err != nil {
+		t.Fatal(err)
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		t.Fatal("the secrets are not synced")
+	}
+	s := &k8sStore{informers: &Informer{Secret: informer}}
+	for token, want := range map[string]string{"token1": "token1.key", "token2": "", "token3": ""} {
+		response, ok := s.GetACMEChallenge(token)
+		if response != want || ok != (want != "") {
+			t.Errorf("expected the challenge %q of %s, but got %q", want, token, response)
+		}
+	}
+}
@@ -19,7 +19,12 @@
 package v1
 
 import (
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"github.com/goodrain/rainbond/gateway/annotations/authtls"
+	"github.com/goodrain/rainbond/gateway/annotations/ipaccess"
 	"github.com/goodrain/rainbond/gateway/annotations/proxy"
+	"github.com/goodrain/rainbond/gateway/annotations/proxyssl"
+	"github.com/goodrain/rainbond/gateway/annotations/ratelimit"
 	"github.com/goodrain/rainbond/gateway/annotations/rewrite"
 )
 
@@ -48,6 +53,18 @@ type Location struct {
 	Proxy            proxy.Config `json:"proxy,omitempty"`
 	DisableProxyPass bool
 	PathRewrite      bool `json:"pathRewrite"`
+	// WakeTimeout seconds to hold the request while the idle component is waking up
+	WakeTimeout int `json:"wakeTimeout"`
+	// RateLimit limits the requests of the location
+	RateLimit ratelimit.Config `json:"rateLimit,omitempty"`
+	// IPAccess allows or denies the client networks of the location
+	IPAccess ipaccess.Config `json:"ipAccess,omitempty"`
+	// Auth authenticates the requests of the location
+	Auth auth.Config `json:"auth,omitempty"`
+	// AuthTLS verifies the client certificates of the location
+	AuthTLS authtls.Config `json:"authTLS,omitempty"`
+	// ProxySSL connects the upstream of the location by TLS
+	ProxySSL proxyssl.Config `json:"proxySSL,omitempty"`
 }
 
 // Condition is the condition that the traffic can reach the specified backend
@@ -88,6 +105,24 @@ func (l *Location) Equals(c *Location) bool {
 	if l.PathRewrite != c.PathRewrite {
 		return false
 	}
+	if l.WakeTimeout != c.WakeTimeout {
+		return false
+	}
+	if !l.RateLimit.Equal(&c.RateLimit) {
+		return false
+	}
+	if !l.IPAccess.Equal(&c.IPAccess) {
+		return false
+	}
+	if !l.Auth.Equal(&c.Auth) {
+		return false
+	}
+	if !l.AuthTLS.Equal(&c.AuthTLS) {
+		return false
+	}
+	if !l.ProxySSL.Equal(&c.ProxySSL) {
+		return false
+	}
 	return true
 }
 
@@ -60,6 +60,15 @@ type VirtualService struct {
 	Locations        []*Location            `json:"locations"`
 	ForceSSLRedirect bool                   `json:"force_ssl_redirect"`
 	ExtensionConfig  map[string]interface{} `json:"extension_config"`
+	// SSLClientCertificate the CA bundle the client certificates are verified by
+	SSLClientCertificate string `json:"ssl_client_certificate"`
+	// SSLVerifyClient the verification of the client certificates, it is optional if any location
+	// verifies them, and the locations reject the requests by themselves
+	SSLVerifyClient string `json:"ssl_verify_client"`
+	// SSLVerifyDepth the max depth of the client certificate chain
+	SSLVerifyDepth int `json:"ssl_verify_depth"`
+	// EnableACMEChallenge serves the http-01 challenges of the certificates issued automatically
+	EnableACMEChallenge bool `json:"enable_acme_challenge"`
 }
 
 //Equals equals vs
@@ -175,9 +184,16 @@ func (v *VirtualService) Equals(c *VirtualService) bool {
 	if !v.SSLCert.Equals(c.SSLCert) {
 		return false
 	}
+	if v.SSLClientCertificate != c.SSLClientCertificate || v.SSLVerifyClient != c.SSLVerifyClient ||
+		v.SSLVerifyDepth != c.SSLVerifyDepth {
+		return false
+	}
 	if v.ForceSSLRedirect != c.ForceSSLRedirect {
 		return false
 	}
+	if v.EnableACMEChallenge != c.EnableACMEChallenge {
+		return false
+	}
 	if len(v.ExtensionConfig) != len(c.ExtensionConfig) {
 		return false
 	}
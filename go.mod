@@ -110,15 +110,18 @@ require (
 )
 
 require (
+	github.com/BurntSushi/toml v0.4.1
 	github.com/coreos/etcd v3.3.13+incompatible
+	github.com/golang-jwt/jwt/v4 v4.5.2
 	github.com/helm/helm v2.17.0+incompatible
+	github.com/segmentio/kafka-go v0.4.30
 	golang.org/x/sync v0.0.0-20220513210516-0976fa681c29
+	gopkg.in/square/go-jose.v2 v2.6.0
 	k8s.io/klog/v2 v2.60.1
 )
 
 require (
 	github.com/Azure/go-ansiterm v0.0.0-20210617225240-d185dfc1b5a1 // indirect
-	github.com/BurntSushi/toml v0.4.1 // indirect
 	github.com/MakeNowJust/heredoc v0.0.0-20170808103936-bb23615498cd // indirect
 	github.com/Masterminds/goutils v1.1.1 // indirect
 	github.com/Masterminds/semver v1.5.0 // indirect
@@ -226,6 +229,7 @@ require (
 	github.com/opencontainers/selinux v1.10.1 // indirect
 	github.com/pelletier/go-toml v1.9.4 // indirect
 	github.com/peterbourgon/diskv v2.0.1+incompatible // indirect
+	github.com/pierrec/lz4/v4 v4.1.14 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/rubenv/sql-migrate v0.0.0-20210614095031-55d5740dbbcc // indirect
 	github.com/russross/blackfriday v1.6.0 // indirect
@@ -275,7 +279,7 @@ require (
 	github.com/google/go-cmp v0.5.8 // indirect
 	github.com/google/gofuzz v1.2.0 // indirect
 	github.com/kevinburke/ssh_config v0.0.0-20201106050909-4977a11b4351 // indirect
-	github.com/klauspost/compress v1.15.4 // indirect
+	github.com/klauspost/compress v1.15.4
 	github.com/mattn/go-sqlite3 v1.14.8 // indirect
 	github.com/mitchellh/hashstructure/v2 v2.0.1
 	github.com/onsi/ginkgo/v2 v2.1.4 // indirect
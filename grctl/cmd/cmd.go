@@ -45,6 +45,7 @@ func GetCmds() []cli.Command {
 	cmds = append(cmds, NewCmdConfig())
 	cmds = append(cmds, NewCmdRegistry())
 	cmds = append(cmds, NewCmdReplace())
+	cmds = append(cmds, NewCmdMigrate())
 	return cmds
 }
 
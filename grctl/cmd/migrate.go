@@ -0,0 +1,215 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	rainbondv1alpha1 "github.com/goodrain/rainbond-operator/api/v1alpha1"
+	"github.com/goodrain/rainbond/db/config"
+	"github.com/goodrain/rainbond/db/migrate"
+	"github.com/goodrain/rainbond/db/mysql"
+	"github.com/goodrain/rainbond/grctl/clients"
+	"github.com/goodrain/rainbond/util/termtables"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NewCmdMigrate migrate cmd
+func NewCmdMigrate() cli.Command {
+	dbFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:   "namespace, ns",
+			Usage:  "rainbond namespace",
+			EnvVar: "RBDNamespace",
+			Value:  "rbd-system",
+		},
+		cli.StringFlag{
+			Name:  "db-type",
+			Usage: "the type of the region database: mysql, cockroachdb or sqlite",
+			Value: "mysql",
+		},
+		cli.StringFlag{
+			Name:  "dsn",
+			Usage: "the connection info of the region database, it is read from the rainbond cluster of mysql if not set",
+		},
+	}
+	c := cli.Command{
+		Name:  "migrate",
+		Usage: "grctl migrate [command]",
+		Subcommands: []cli.Command{
+			{
+				Name:  "status",
+				Usage: "Show the schema version and the migrations of the region database",
+				Flags: dbFlags,
+				Action: func(c *cli.Context) error {
+					migrator, err := newRegionMigrator(c)
+					if err != nil {
+						return err
+					}
+					return showMigrationStatus(migrator)
+				},
+			},
+			{
+				Name:  "up",
+				Usage: "Apply the migrations not applied yet",
+				Flags: append([]cli.Flag{
+					cli.IntFlag{
+						Name:  "to",
+						Usage: "apply the migrations up to the version, all of them if it is 0",
+					},
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "only print the statements to run",
+					},
+				}, dbFlags...),
+				Action: func(c *cli.Context) error {
+					migrator, err := newRegionMigrator(c)
+					if err != nil {
+						return err
+					}
+					steps, err := migrator.Up(c.Int("to"), c.Bool("dry-run"))
+					printMigrationSteps("apply", steps, c.Bool("dry-run"))
+					return err
+				},
+			},
+			{
+				Name:  "down",
+				Usage: "Roll back the applied migrations",
+				Flags: append([]cli.Flag{
+					cli.IntFlag{
+						Name:  "to",
+						Usage: "roll back the migrations after the version, only the last one if it is not set",
+						Value: -1,
+					},
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "only print the statements to run",
+					},
+				}, dbFlags...),
+				Action: func(c *cli.Context) error {
+					migrator, err := newRegionMigrator(c)
+					if err != nil {
+						return err
+					}
+					target := c.Int("to")
+					if target < 0 {
+						if target, err = previousVersion(migrator); err != nil {
+							return err
+						}
+					}
+					steps, err := migrator.Down(target, c.Bool("dry-run"))
+					printMigrationSteps("roll back", steps, c.Bool("dry-run"))
+					return err
+				},
+			},
+		},
+	}
+	return c
+}
+
+func newRegionMigrator(c *cli.Context) (*migrate.Migrator, error) {
+	dbType := c.String("db-type")
+	dsn := c.String("dsn")
+	if dsn == "" && dbType == "mysql" {
+		CommonWithoutRegion(c)
+		var cluster rainbondv1alpha1.RainbondCluster
+		if err := clients.RainbondKubeClient.Get(context.Background(), types.NamespacedName{Namespace: c.String("namespace"), Name: "rainbondcluster"}, &cluster); err != nil {
+			return nil, errors.Wrap(err, "get configuration from rainbond cluster")
+		}
+		var err error
+		if dsn, err = databaseDSN(&cluster); err != nil {
+			return nil, errors.Wrap(err, "get database dsn")
+		}
+	}
+	db, err := mysql.OpenDB(config.Config{
+		MysqlConnectionInfo: dsn,
+		DBType:              dbType,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "open region database")
+	}
+	return migrate.NewMigrator(db, dbType), nil
+}
+
+func showMigrationStatus(migrator *migrate.Migrator) error {
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+	version, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Schema version: %d, latest version: %d\n", version, migrator.Latest())
+	table := termtables.CreateTable()
+	table.AddHeaders("Version", "Description", "Status", "AppliedAt")
+	for _, s := range status {
+		state, appliedAt := "pending", ""
+		if s.Applied {
+			state, appliedAt = "applied", s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		if s.Unknown {
+			state = "applied by a newer version"
+		}
+		table.AddRow(s.Version, s.Description, state, appliedAt)
+	}
+	fmt.Println(table.Render())
+	return nil
+}
+
+//previousVersion the version before the last applied migration
+func previousVersion(migrator *migrate.Migrator) (int, error) {
+	status, err := migrator.Status()
+	if err != nil {
+		return 0, err
+	}
+	var applied []int
+	for _, s := range status {
+		if s.Applied {
+			applied = append(applied, s.Version)
+		}
+	}
+	if len(applied) < 2 {
+		return 0, nil
+	}
+	return applied[len(applied)-2], nil
+}
+
+func printMigrationSteps(action string, steps []migrate.Step, dryRun bool) {
+	if len(steps) == 0 {
+		fmt.Printf("No migration to %s\n", action)
+		return
+	}
+	for _, step := range steps {
+		if !dryRun {
+			fmt.Printf("Migration %d done: %s\n", step.Version, step.Description)
+			continue
+		}
+		fmt.Printf("Migration %d to %s: %s\n", step.Version, action, step.Description)
+		if len(step.Statements) == 0 {
+			fmt.Println("\tnothing to run")
+		}
+		for _, statement := range step.Statements {
+			fmt.Printf("\t%s;\n", statement)
+		}
+	}
+}
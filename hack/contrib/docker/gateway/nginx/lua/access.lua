@@ -0,0 +1,82 @@
+local bit = require("bit")
+local limit_req = require("resty.limit.req")
+
+-- the shared dict to store the rate limit states of all nginx workers
+local LIMIT_DICT = "rate_limit_store"
+
+-- the limiters of the rate and burst
+local limiters = {}
+
+local _M = {}
+
+-- reject rejects the request, the reason is reported to the socket collector by monitor.lua
+local function reject(status, reason)
+  ngx.ctx.limited = reason
+  return ngx.exit(status)
+end
+
+-- match returns whether the binary address is in the network of the binary ip and mask
+local function match(addr, network)
+  local ip, mask = network[1], network[2]
+  if #addr ~= #ip then
+    return false
+  end
+  for i = 1, #ip do
+    if bit.band(string.byte(addr, i), string.byte(mask, i)) ~= string.byte(ip, i) then
+      return false
+    end
+  end
+  return true
+end
+
+local function match_any(addr, networks)
+  for _, network in ipairs(networks) do
+    if match(addr, network) then
+      return true
+    end
+  end
+  return false
+end
+
+-- deny denies all the clients
+function _M.deny()
+  return reject(ngx.HTTP_FORBIDDEN, "ip")
+end
+
+-- check_ip denies the client in the deny networks, or not in the allow networks if they are not empty
+function _M.check_ip(allow, deny)
+  local addr = ngx.var.binary_remote_addr
+  if not addr then
+    return
+  end
+  if match_any(addr, deny) or (#allow > 0 and not match_any(addr, allow)) then
+    return reject(ngx.HTTP_FORBIDDEN, "ip")
+  end
+end
+
+-- limit_rate rejects the requests of the key beyond the rate, the burst requests are not delayed
+function _M.limit_rate(zone, rate, burst, key)
+  local name = rate .. ":" .. burst
+  local limiter = limiters[name]
+  if not limiter then
+    local err
+    limiter, err = limit_req.new(LIMIT_DICT, rate, burst)
+    if not limiter then
+      ngx.log(ngx.ERR, "failed to create the rate limiter: ", err)
+      return
+    end
+    limiters[name] = limiter
+  end
+  if not key or key == "" then
+    key = ngx.var.binary_remote_addr
+  end
+  local delay, err = limiter:incoming(ngx.var.server_name .. zone .. ":" .. key, true)
+  if not delay then
+    if err == "rejected" then
+      return reject(429, "rate")
+    end
+    ngx.log(ngx.ERR, "failed to limit the request: ", err)
+  end
+end
+
+return _M
@@ -0,0 +1,43 @@
+-- the internal location the auth subrequests are proxied to the authenticator of the gateway
+local AUTH_LOCATION = "/_rbd_auth"
+
+-- the headers of the rejection responses returned to the client
+local REJECT_HEADERS = {"WWW-Authenticate", "Location", "Set-Cookie", "Content-Type"}
+
+local _M = {}
+
+-- check authenticates the request by the auth config of the id, the headers of the auth response
+-- are passed to the upstream, the ones sent by the client are cleared.
+function _M.check(id, headers)
+  local res = ngx.location.capture(AUTH_LOCATION, {method = ngx.HTTP_GET, args = {id = id}})
+  if res.status >= 200 and res.status < 300 then
+    for _, name in ipairs(headers) do
+      ngx.req.set_header(name, res.header[name])
+    end
+    return
+  end
+
+  if res.status == ngx.HTTP_UNAUTHORIZED or res.status == ngx.HTTP_FORBIDDEN
+      or (res.status >= 300 and res.status < 400) then
+    if res.status == ngx.HTTP_FORBIDDEN then
+      ngx.ctx.limited = "forbidden"
+    else
+      ngx.ctx.limited = "unauthorized"
+    end
+    for _, name in ipairs(REJECT_HEADERS) do
+      if res.header[name] then
+        ngx.header[name] = res.header[name]
+      end
+    end
+    ngx.status = res.status
+    if res.body and res.body ~= "" then
+      ngx.print(res.body)
+    end
+    return ngx.exit(ngx.HTTP_OK)
+  end
+
+  ngx.log(ngx.ERR, "unexpected status of the auth subrequest: ", res.status)
+  return ngx.exit(ngx.HTTP_INTERNAL_SERVER_ERROR)
+end
+
+return _M
@@ -0,0 +1,65 @@
+-- the internal location the client certificates are parsed by the authenticator of the gateway
+local CLIENT_CERT_LOCATION = "/_rbd_client_cert"
+
+-- the shared dict to cache the SAN of the client certificates by their fingerprints
+local SAN_DICT = "client_cert_san"
+local SAN_TTL = 3600
+
+-- the headers of the client certificate passed to the upstream, the ones sent by the client are cleared
+local HEADER_VERIFY = "X-Client-Verify"
+local HEADER_SUBJECT = "X-Client-Subject-DN"
+local HEADER_ISSUER = "X-Client-Issuer-DN"
+local HEADER_SERIAL = "X-Client-Serial"
+local HEADER_FINGERPRINT = "X-Client-Fingerprint"
+local HEADER_SAN = "X-Client-SAN"
+local HEADERS = {HEADER_VERIFY, HEADER_SUBJECT, HEADER_ISSUER, HEADER_SERIAL, HEADER_FINGERPRINT, HEADER_SAN}
+
+local _M = {}
+
+-- san returns the comma separated subject alternative names of the verified client certificate
+local function san(fingerprint)
+  local dict = ngx.shared[SAN_DICT]
+  local value = dict:get(fingerprint)
+  if value then
+    return value
+  end
+  local res = ngx.location.capture(CLIENT_CERT_LOCATION, {method = ngx.HTTP_GET})
+  if res.status ~= ngx.HTTP_OK then
+    ngx.log(ngx.ERR, "unexpected status of the client certificate subrequest: ", res.status)
+    return ""
+  end
+  value = res.header[HEADER_SAN] or ""
+  dict:set(fingerprint, value, SAN_TTL)
+  return value
+end
+
+-- check rejects the request without a verified client certificate if the verify is on,
+-- the verified subject and SAN of the client certificate are passed to the upstream.
+function _M.check(verify)
+  for _, name in ipairs(HEADERS) do
+    ngx.req.clear_header(name)
+  end
+
+  local result = ngx.var.ssl_client_verify or "NONE"
+  if result ~= "SUCCESS" then
+    if verify == "on" then
+      ngx.ctx.limited = "forbidden"
+      return ngx.exit(ngx.HTTP_FORBIDDEN)
+    end
+    ngx.req.set_header(HEADER_VERIFY, result)
+    return
+  end
+
+  local fingerprint = ngx.var.ssl_client_fingerprint
+  ngx.req.set_header(HEADER_VERIFY, result)
+  ngx.req.set_header(HEADER_SUBJECT, ngx.var.ssl_client_s_dn)
+  ngx.req.set_header(HEADER_ISSUER, ngx.var.ssl_client_i_dn)
+  ngx.req.set_header(HEADER_SERIAL, ngx.var.ssl_client_serial)
+  ngx.req.set_header(HEADER_FINGERPRINT, fingerprint)
+  local names = san(fingerprint)
+  if names ~= "" then
+    ngx.req.set_header(HEADER_SAN, names)
+  end
+end
+
+return _M
@@ -18,6 +18,8 @@ local IMPLEMENTATIONS = {
 local _M = {}
 -- save all backend balancer data
 local balancers = {}
+-- save the endpoint number of all backends
+local peer_counts = {}
 
 -- measured in seconds
 -- for an Nginx worker to pick up the new list of upstream peers
@@ -52,6 +54,7 @@ end
 
 --  sync_backend sync define backend data 
 local function sync_backend(backend)
+  peer_counts[backend.name] = #(backend.endpoints or {})
   local implementation = get_implementation(backend)
   local balancer = balancers[backend.name]
 
@@ -77,6 +80,7 @@ local function sync_backends()
   local backends_data = config.get_backends_data()
   if not backends_data then
     balancers = {}
+    peer_counts = {}
     return
   end
 
@@ -95,6 +99,7 @@ local function sync_backends()
   for backend_name, _ in pairs(balancers) do
     if not balancers_to_keep[backend_name] then
       balancers[backend_name] = nil
+      peer_counts[backend_name] = nil
     end
   end
 end
@@ -115,6 +120,12 @@ function _M.rewrite()
   end
 end
 
+-- has_peers returns whether the backend of the request has any endpoint
+function _M.has_peers()
+  local count = peer_counts[ngx.var.target]
+  return count ~= nil and count > 0
+end
+
 function _M.balance()
   local balancer = get_balancer()
   if not balancer then
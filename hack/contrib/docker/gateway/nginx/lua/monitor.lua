@@ -34,6 +34,8 @@ local function metrics()
     upstreamLatency = tonumber(ngx.var.upstream_connect_time) or -1,
     upstreamResponseTime = tonumber(ngx.var.upstream_response_time) or -1,
     upstreamResponseLength = tonumber(ngx.var.upstream_response_length) or -1,
+    -- the reason the request is rejected by access.lua
+    limited = ngx.ctx.limited or "",
     --upstreamStatus = ngx.var.upstream_status or "-",
   }
 end
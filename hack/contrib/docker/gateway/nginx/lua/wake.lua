@@ -0,0 +1,69 @@
+local socket = ngx.socket.tcp
+local cjson = require("cjson.safe")
+local balancer = require("balancer")
+
+-- the interval to check whether the component is woken up
+local CHECK_INTERVAL = 0.5 -- second
+-- a nginx worker sends at most one wake message of a component in the interval
+local SEND_INTERVAL = 5 -- second
+
+-- the last time a wake message of the component is sent
+local sent = {}
+
+local _M = {}
+
+local function send(payload)
+  local s = socket()
+  local ok, err = s:connect("unix:/tmp/wake-nginx.socket")
+  if not ok then
+    ngx.log(ngx.ERR, "error while connecting to the wake socket: ", err)
+    return
+  end
+  ok, err = s:send(payload)
+  if not ok then
+    ngx.log(ngx.ERR, "error while sending wake message: ", err)
+  end
+  s:close()
+end
+
+local function wake(service_id)
+  local now = ngx.now()
+  if sent[service_id] and now - sent[service_id] < SEND_INTERVAL then
+    return
+  end
+  sent[service_id] = now
+  local payload, err = cjson.encode({
+    tenant_id = ngx.var.tenant_id or "",
+    service_id = service_id,
+    host = ngx.var.host or "",
+  })
+  if not payload then
+    ngx.log(ngx.ERR, "error while encoding wake message: ", err)
+    return
+  end
+  send(payload)
+end
+
+-- hold holds the request of the idle component until it has any endpoint, or the timeout(seconds) is reached
+function _M.hold(timeout)
+  if balancer.has_peers() then
+    return
+  end
+  local service_id = ngx.var.service_id
+  if not service_id or service_id == "" then
+    return
+  end
+  local deadline = ngx.now() + timeout
+  while ngx.now() < deadline do
+    wake(service_id)
+    ngx.sleep(CHECK_INTERVAL)
+    if balancer.has_peers() then
+      return
+    end
+  end
+  ngx.log(ngx.WARN, string.format("component %s is not woken up in %ss", service_id, timeout))
+  ngx.status = ngx.HTTP_SERVICE_UNAVAILABLE
+  return ngx.exit(ngx.status)
+end
+
+return _M
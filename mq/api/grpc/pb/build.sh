@@ -1 +1,2 @@
-protoc --go_out=plugins=grpc:./  message.proto
\ No newline at end of file
+# run in the root of the repository
+protoc --go_out=plugins=grpc:. mq/api/grpc/pb/message.proto
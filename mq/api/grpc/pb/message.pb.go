@@ -1,320 +1,953 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// source: message.proto
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: mq/api/grpc/pb/message.proto
 
 package pb
 
 import (
 	context "context"
-	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
-	math "math"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
 )
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
 type TaskMessage struct {
-	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType             string   `protobuf:"bytes,2,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
-	TaskBody             []byte   `protobuf:"bytes,3,opt,name=task_body,json=taskBody,proto3" json:"task_body,omitempty"`
-	CreateTime           string   `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	User                 string   `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *TaskMessage) Reset()         { *m = TaskMessage{} }
-func (m *TaskMessage) String() string { return proto.CompactTextString(m) }
-func (*TaskMessage) ProtoMessage()    {}
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId     string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType   string `protobuf:"bytes,2,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	TaskBody   []byte `protobuf:"bytes,3,opt,name=task_body,json=taskBody,proto3" json:"task_body,omitempty"`
+	CreateTime string `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	User       string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// the number of times the task has been leased
+	DeliveryCount int32 `protobuf:"varint,6,opt,name=delivery_count,json=deliveryCount,proto3" json:"delivery_count,omitempty"`
+	// tasks of higher priority are dequeued first, the default is 0
+	Priority int32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// tasks of the same priority are dequeued fairly across tenants
+	TenantId string `protobuf:"bytes,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *TaskMessage) Reset() {
+	*x = TaskMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskMessage) ProtoMessage() {}
+
+func (x *TaskMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskMessage.ProtoReflect.Descriptor instead.
 func (*TaskMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{0}
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{0}
 }
 
-func (m *TaskMessage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TaskMessage.Unmarshal(m, b)
+func (x *TaskMessage) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
 }
-func (m *TaskMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TaskMessage.Marshal(b, m, deterministic)
+
+func (x *TaskMessage) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
 }
-func (m *TaskMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskMessage.Merge(m, src)
+
+func (x *TaskMessage) GetTaskBody() []byte {
+	if x != nil {
+		return x.TaskBody
+	}
+	return nil
 }
-func (m *TaskMessage) XXX_Size() int {
-	return xxx_messageInfo_TaskMessage.Size(m)
+
+func (x *TaskMessage) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
 }
-func (m *TaskMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskMessage.DiscardUnknown(m)
+
+func (x *TaskMessage) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *TaskMessage) GetDeliveryCount() int32 {
+	if x != nil {
+		return x.DeliveryCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_TaskMessage proto.InternalMessageInfo
+func (x *TaskMessage) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
 
-func (m *TaskMessage) GetTaskId() string {
-	if m != nil {
-		return m.TaskId
+func (x *TaskMessage) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
 	}
 	return ""
 }
 
-func (m *TaskMessage) GetTaskType() string {
-	if m != nil {
-		return m.TaskType
+type EnqueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic   string       `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Message *TaskMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *EnqueueRequest) Reset() {
+	*x = EnqueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueRequest) ProtoMessage() {}
+
+func (x *EnqueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueRequest) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnqueueRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
 	}
 	return ""
 }
 
-func (m *TaskMessage) GetTaskBody() []byte {
-	if m != nil {
-		return m.TaskBody
+func (x *EnqueueRequest) GetMessage() *TaskMessage {
+	if x != nil {
+		return x.Message
 	}
 	return nil
 }
 
-func (m *TaskMessage) GetCreateTime() string {
-	if m != nil {
-		return m.CreateTime
+type DequeueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic      string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	ClientHost string `protobuf:"bytes,2,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+}
+
+func (x *DequeueRequest) Reset() {
+	*x = DequeueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DequeueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueRequest) ProtoMessage() {}
+
+func (x *DequeueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueRequest.ProtoReflect.Descriptor instead.
+func (*DequeueRequest) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DequeueRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
 	}
 	return ""
 }
 
-func (m *TaskMessage) GetUser() string {
-	if m != nil {
-		return m.User
+func (x *DequeueRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
 	}
 	return ""
 }
 
-type EnqueueRequest struct {
-	Topic                string       `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Message              *TaskMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type LeaseDequeueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic      string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	ClientHost string `protobuf:"bytes,2,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	// seconds, the default of the server is used if it is zero
+	VisibilityTimeout int64 `protobuf:"varint,3,opt,name=visibility_timeout,json=visibilityTimeout,proto3" json:"visibility_timeout,omitempty"`
 }
 
-func (m *EnqueueRequest) Reset()         { *m = EnqueueRequest{} }
-func (m *EnqueueRequest) String() string { return proto.CompactTextString(m) }
-func (*EnqueueRequest) ProtoMessage()    {}
-func (*EnqueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{1}
+func (x *LeaseDequeueRequest) Reset() {
+	*x = LeaseDequeueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (m *EnqueueRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EnqueueRequest.Unmarshal(m, b)
+func (x *LeaseDequeueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *EnqueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EnqueueRequest.Marshal(b, m, deterministic)
+
+func (*LeaseDequeueRequest) ProtoMessage() {}
+
+func (x *LeaseDequeueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *EnqueueRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EnqueueRequest.Merge(m, src)
+
+// Deprecated: Use LeaseDequeueRequest.ProtoReflect.Descriptor instead.
+func (*LeaseDequeueRequest) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LeaseDequeueRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
 }
-func (m *EnqueueRequest) XXX_Size() int {
-	return xxx_messageInfo_EnqueueRequest.Size(m)
+
+func (x *LeaseDequeueRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
 }
-func (m *EnqueueRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EnqueueRequest.DiscardUnknown(m)
+
+func (x *LeaseDequeueRequest) GetVisibilityTimeout() int64 {
+	if x != nil {
+		return x.VisibilityTimeout
+	}
+	return 0
 }
 
-var xxx_messageInfo_EnqueueRequest proto.InternalMessageInfo
+type TaskLease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseId string       `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Message *TaskMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// unix seconds
+	Deadline int64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
 
-func (m *EnqueueRequest) GetTopic() string {
-	if m != nil {
-		return m.Topic
+func (x *TaskLease) Reset() {
+	*x = TaskLease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskLease) ProtoMessage() {}
+
+func (x *TaskLease) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskLease.ProtoReflect.Descriptor instead.
+func (*TaskLease) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TaskLease) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
 	}
 	return ""
 }
 
-func (m *EnqueueRequest) GetMessage() *TaskMessage {
-	if m != nil {
-		return m.Message
+func (x *TaskLease) GetMessage() *TaskMessage {
+	if x != nil {
+		return x.Message
 	}
 	return nil
 }
 
-type DequeueRequest struct {
-	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	ClientHost           string   `protobuf:"bytes,2,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *TaskLease) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
 }
 
-func (m *DequeueRequest) Reset()         { *m = DequeueRequest{} }
-func (m *DequeueRequest) String() string { return proto.CompactTextString(m) }
-func (*DequeueRequest) ProtoMessage()    {}
-func (*DequeueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{2}
+type ExtendLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic             string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	LeaseId           string `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	VisibilityTimeout int64  `protobuf:"varint,3,opt,name=visibility_timeout,json=visibilityTimeout,proto3" json:"visibility_timeout,omitempty"`
 }
 
-func (m *DequeueRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DequeueRequest.Unmarshal(m, b)
+func (x *ExtendLeaseRequest) Reset() {
+	*x = ExtendLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *DequeueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DequeueRequest.Marshal(b, m, deterministic)
+
+func (x *ExtendLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *DequeueRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DequeueRequest.Merge(m, src)
+
+func (*ExtendLeaseRequest) ProtoMessage() {}
+
+func (x *ExtendLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ExtendLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExtendLeaseRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ExtendLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *ExtendLeaseRequest) GetVisibilityTimeout() int64 {
+	if x != nil {
+		return x.VisibilityTimeout
+	}
+	return 0
+}
+
+type AckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic   string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
 }
-func (m *DequeueRequest) XXX_Size() int {
-	return xxx_messageInfo_DequeueRequest.Size(m)
+
+func (x *AckRequest) Reset() {
+	*x = AckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *DequeueRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DequeueRequest.DiscardUnknown(m)
+
+func (x *AckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_DequeueRequest proto.InternalMessageInfo
+func (*AckRequest) ProtoMessage() {}
+
+func (x *AckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckRequest.ProtoReflect.Descriptor instead.
+func (*AckRequest) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{6}
+}
 
-func (m *DequeueRequest) GetTopic() string {
-	if m != nil {
-		return m.Topic
+func (x *AckRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
 	}
 	return ""
 }
 
-func (m *DequeueRequest) GetClientHost() string {
-	if m != nil {
-		return m.ClientHost
+func (x *AckRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
 	}
 	return ""
 }
 
-type TaskReply struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Topics               []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type NackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic   string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *TaskReply) Reset()         { *m = TaskReply{} }
-func (m *TaskReply) String() string { return proto.CompactTextString(m) }
-func (*TaskReply) ProtoMessage()    {}
-func (*TaskReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{3}
+func (x *NackRequest) Reset() {
+	*x = NackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NackRequest) ProtoMessage() {}
+
+func (x *NackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (m *TaskReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TaskReply.Unmarshal(m, b)
+// Deprecated: Use NackRequest.ProtoReflect.Descriptor instead.
+func (*NackRequest) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{7}
 }
-func (m *TaskReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TaskReply.Marshal(b, m, deterministic)
+
+func (x *NackRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *NackRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
 }
-func (m *TaskReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskReply.Merge(m, src)
+
+func (x *NackRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
-func (m *TaskReply) XXX_Size() int {
-	return xxx_messageInfo_TaskReply.Size(m)
+
+type TaskReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Topics  []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
 }
-func (m *TaskReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskReply.DiscardUnknown(m)
+
+func (x *TaskReply) Reset() {
+	*x = TaskReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var xxx_messageInfo_TaskReply proto.InternalMessageInfo
+func (x *TaskReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (m *TaskReply) GetStatus() string {
-	if m != nil {
-		return m.Status
+func (*TaskReply) ProtoMessage() {}
+
+func (x *TaskReply) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskReply.ProtoReflect.Descriptor instead.
+func (*TaskReply) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TaskReply) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (m *TaskReply) GetMessage() string {
-	if m != nil {
-		return m.Message
+func (x *TaskReply) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (m *TaskReply) GetTopics() []string {
-	if m != nil {
-		return m.Topics
+func (x *TaskReply) GetTopics() []string {
+	if x != nil {
+		return x.Topics
 	}
 	return nil
 }
 
 type TopicRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (m *TopicRequest) Reset()         { *m = TopicRequest{} }
-func (m *TopicRequest) String() string { return proto.CompactTextString(m) }
-func (*TopicRequest) ProtoMessage()    {}
-func (*TopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{4}
+func (x *TopicRequest) Reset() {
+	*x = TopicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_api_grpc_pb_message_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (m *TopicRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TopicRequest.Unmarshal(m, b)
+func (x *TopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *TopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TopicRequest.Marshal(b, m, deterministic)
-}
-func (m *TopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicRequest.Merge(m, src)
+
+func (*TopicRequest) ProtoMessage() {}
+
+func (x *TopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_api_grpc_pb_message_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *TopicRequest) XXX_Size() int {
-	return xxx_messageInfo_TopicRequest.Size(m)
+
+// Deprecated: Use TopicRequest.ProtoReflect.Descriptor instead.
+func (*TopicRequest) Descriptor() ([]byte, []int) {
+	return file_mq_api_grpc_pb_message_proto_rawDescGZIP(), []int{9}
 }
-func (m *TopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicRequest.DiscardUnknown(m)
+
+var File_mq_api_grpc_pb_message_proto protoreflect.FileDescriptor
+
+var file_mq_api_grpc_pb_message_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6d, 0x71, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x22, 0xf5, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x61, 0x73,
+	0x6b, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x0e, 0x45, 0x6e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x29, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a,
+	0x0e, 0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x22, 0x6d, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x22, 0x74, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3d, 0x0a, 0x0a, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x0b, 0x4e, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x55, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0xde, 0x02, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x07, 0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x12, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x0b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a,
+	0x04, 0x4e, 0x61, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e, 0x6d, 0x71, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
-var xxx_messageInfo_TopicRequest proto.InternalMessageInfo
+var (
+	file_mq_api_grpc_pb_message_proto_rawDescOnce sync.Once
+	file_mq_api_grpc_pb_message_proto_rawDescData = file_mq_api_grpc_pb_message_proto_rawDesc
+)
 
-func init() {
-	proto.RegisterType((*TaskMessage)(nil), "pb.TaskMessage")
-	proto.RegisterType((*EnqueueRequest)(nil), "pb.EnqueueRequest")
-	proto.RegisterType((*DequeueRequest)(nil), "pb.DequeueRequest")
-	proto.RegisterType((*TaskReply)(nil), "pb.TaskReply")
-	proto.RegisterType((*TopicRequest)(nil), "pb.TopicRequest")
+func file_mq_api_grpc_pb_message_proto_rawDescGZIP() []byte {
+	file_mq_api_grpc_pb_message_proto_rawDescOnce.Do(func() {
+		file_mq_api_grpc_pb_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_mq_api_grpc_pb_message_proto_rawDescData)
+	})
+	return file_mq_api_grpc_pb_message_proto_rawDescData
 }
 
-func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
+var file_mq_api_grpc_pb_message_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_mq_api_grpc_pb_message_proto_goTypes = []interface{}{
+	(*TaskMessage)(nil),         // 0: pb.TaskMessage
+	(*EnqueueRequest)(nil),      // 1: pb.EnqueueRequest
+	(*DequeueRequest)(nil),      // 2: pb.DequeueRequest
+	(*LeaseDequeueRequest)(nil), // 3: pb.LeaseDequeueRequest
+	(*TaskLease)(nil),           // 4: pb.TaskLease
+	(*ExtendLeaseRequest)(nil),  // 5: pb.ExtendLeaseRequest
+	(*AckRequest)(nil),          // 6: pb.AckRequest
+	(*NackRequest)(nil),         // 7: pb.NackRequest
+	(*TaskReply)(nil),           // 8: pb.TaskReply
+	(*TopicRequest)(nil),        // 9: pb.TopicRequest
+}
+var file_mq_api_grpc_pb_message_proto_depIdxs = []int32{
+	0, // 0: pb.EnqueueRequest.message:type_name -> pb.TaskMessage
+	0, // 1: pb.TaskLease.message:type_name -> pb.TaskMessage
+	1, // 2: pb.TaskQueue.Enqueue:input_type -> pb.EnqueueRequest
+	9, // 3: pb.TaskQueue.Topics:input_type -> pb.TopicRequest
+	2, // 4: pb.TaskQueue.Dequeue:input_type -> pb.DequeueRequest
+	3, // 5: pb.TaskQueue.LeaseDequeue:input_type -> pb.LeaseDequeueRequest
+	5, // 6: pb.TaskQueue.ExtendLease:input_type -> pb.ExtendLeaseRequest
+	6, // 7: pb.TaskQueue.Ack:input_type -> pb.AckRequest
+	7, // 8: pb.TaskQueue.Nack:input_type -> pb.NackRequest
+	8, // 9: pb.TaskQueue.Enqueue:output_type -> pb.TaskReply
+	8, // 10: pb.TaskQueue.Topics:output_type -> pb.TaskReply
+	0, // 11: pb.TaskQueue.Dequeue:output_type -> pb.TaskMessage
+	4, // 12: pb.TaskQueue.LeaseDequeue:output_type -> pb.TaskLease
+	8, // 13: pb.TaskQueue.ExtendLease:output_type -> pb.TaskReply
+	8, // 14: pb.TaskQueue.Ack:output_type -> pb.TaskReply
+	8, // 15: pb.TaskQueue.Nack:output_type -> pb.TaskReply
+	9, // [9:16] is the sub-list for method output_type
+	2, // [2:9] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
 
-var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x45, 0x9b, 0x3e, 0x12, 0x32, 0x7d, 0x80, 0x46, 0x08, 0xac, 0xb2, 0xa0, 0xf2, 0xaa, 0x08,
-	0x29, 0x42, 0xe5, 0x0f, 0x10, 0x08, 0x58, 0xb0, 0x68, 0x14, 0xd6, 0x55, 0xd2, 0x8c, 0x20, 0xea,
-	0xc3, 0x6e, 0xed, 0x2c, 0xf2, 0x21, 0x6c, 0xf8, 0x5a, 0x64, 0x3b, 0xad, 0x5a, 0xd8, 0xb0, 0xf3,
-	0xcc, 0xcd, 0xcc, 0x9c, 0x7b, 0x15, 0xe8, 0xaf, 0x48, 0xa9, 0xf4, 0x83, 0x22, 0xb9, 0x15, 0x5a,
-	0x60, 0x53, 0x66, 0xfc, 0xcb, 0x83, 0x6e, 0x92, 0xaa, 0xc5, 0x9b, 0x53, 0xf0, 0x12, 0x02, 0x9d,
-	0xaa, 0xc5, 0xac, 0xc8, 0x99, 0x37, 0xf2, 0xc6, 0x61, 0xec, 0x9b, 0xf2, 0x35, 0xc7, 0x2b, 0x08,
-	0xad, 0xa0, 0x2b, 0x49, 0xac, 0x69, 0xa5, 0x13, 0xd3, 0x48, 0x2a, 0x49, 0x7b, 0x31, 0x13, 0x79,
-	0xc5, 0x5a, 0x23, 0x6f, 0xdc, 0x73, 0xe2, 0x83, 0xc8, 0x2b, 0xbc, 0x86, 0xee, 0x7c, 0x4b, 0xa9,
-	0xa6, 0x99, 0x2e, 0x56, 0xc4, 0xda, 0x76, 0x16, 0x5c, 0x2b, 0x29, 0x56, 0x84, 0x08, 0xed, 0x52,
-	0xd1, 0x96, 0x75, 0xac, 0x62, 0xdf, 0x7c, 0x0a, 0x83, 0xa7, 0xf5, 0xa6, 0xa4, 0x92, 0x62, 0xda,
-	0x94, 0xa4, 0x34, 0x9e, 0x43, 0x47, 0x0b, 0x59, 0xcc, 0x6b, 0x2e, 0x57, 0xe0, 0x0d, 0x04, 0xb5,
-	0x29, 0x0b, 0xd5, 0x9d, 0x9c, 0x46, 0x32, 0x8b, 0x0e, 0x1c, 0xc5, 0x3b, 0x9d, 0x3f, 0xc3, 0xe0,
-	0x91, 0xfe, 0xb1, 0xd2, 0xf0, 0x2e, 0x0b, 0x5a, 0xeb, 0xd9, 0xa7, 0x50, 0xba, 0xf6, 0x0a, 0xae,
-	0xf5, 0x22, 0x94, 0xe6, 0xef, 0x10, 0x9a, 0x03, 0x31, 0xc9, 0x65, 0x85, 0x17, 0xe0, 0x2b, 0x9d,
-	0xea, 0x52, 0xed, 0xf2, 0x72, 0x15, 0xb2, 0x63, 0xb0, 0x70, 0xcf, 0x61, 0x26, 0xec, 0x21, 0xc5,
-	0x5a, 0xa3, 0x96, 0x4d, 0xd8, 0x56, 0x7c, 0x00, 0xbd, 0xc4, 0xbc, 0x6a, 0xba, 0xc9, 0xb7, 0xe7,
-	0xee, 0x4c, 0x0d, 0x32, 0x46, 0x10, 0xd4, 0x81, 0x20, 0x1a, 0x8b, 0xc7, 0xe9, 0x0c, 0xfb, 0x3b,
-	0xdb, 0x96, 0x8a, 0x37, 0xf0, 0x16, 0x7c, 0xbb, 0x4d, 0xe1, 0x99, 0x95, 0x0e, 0x36, 0xff, 0xfd,
-	0xf8, 0x0e, 0x82, 0x3a, 0x1a, 0xb7, 0xfc, 0x38, 0xa7, 0xe1, 0xef, 0x4c, 0x79, 0x23, 0xf3, 0xed,
-	0x2f, 0x74, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x5f, 0x1b, 0x28, 0x53, 0x02, 0x00, 0x00,
+func init() { file_mq_api_grpc_pb_message_proto_init() }
+func file_mq_api_grpc_pb_message_proto_init() {
+	if File_mq_api_grpc_pb_message_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mq_api_grpc_pb_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DequeueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseDequeueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskLease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_api_grpc_pb_message_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mq_api_grpc_pb_message_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mq_api_grpc_pb_message_proto_goTypes,
+		DependencyIndexes: file_mq_api_grpc_pb_message_proto_depIdxs,
+		MessageInfos:      file_mq_api_grpc_pb_message_proto_msgTypes,
+	}.Build()
+	File_mq_api_grpc_pb_message_proto = out.File
+	file_mq_api_grpc_pb_message_proto_rawDesc = nil
+	file_mq_api_grpc_pb_message_proto_goTypes = nil
+	file_mq_api_grpc_pb_message_proto_depIdxs = nil
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // TaskQueueClient is the client API for TaskQueue service.
 //
@@ -323,13 +956,21 @@ type TaskQueueClient interface {
 	Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (*TaskReply, error)
 	Topics(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*TaskReply, error)
 	Dequeue(ctx context.Context, in *DequeueRequest, opts ...grpc.CallOption) (*TaskMessage, error)
+	// LeaseDequeue hands out a task without removing it. The task is redelivered
+	// if it is not acked before the visibility timeout.
+	LeaseDequeue(ctx context.Context, in *LeaseDequeueRequest, opts ...grpc.CallOption) (*TaskLease, error)
+	ExtendLease(ctx context.Context, in *ExtendLeaseRequest, opts ...grpc.CallOption) (*TaskReply, error)
+	Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*TaskReply, error)
+	// Nack redelivers the task at once, or moves it to the dead-letter topic
+	// after too many deliveries.
+	Nack(ctx context.Context, in *NackRequest, opts ...grpc.CallOption) (*TaskReply, error)
 }
 
 type taskQueueClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewTaskQueueClient(cc *grpc.ClientConn) TaskQueueClient {
+func NewTaskQueueClient(cc grpc.ClientConnInterface) TaskQueueClient {
 	return &taskQueueClient{cc}
 }
 
@@ -360,11 +1001,81 @@ func (c *taskQueueClient) Dequeue(ctx context.Context, in *DequeueRequest, opts
 	return out, nil
 }
 
+func (c *taskQueueClient) LeaseDequeue(ctx context.Context, in *LeaseDequeueRequest, opts ...grpc.CallOption) (*TaskLease, error) {
+	out := new(TaskLease)
+	err := c.cc.Invoke(ctx, "/pb.TaskQueue/LeaseDequeue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskQueueClient) ExtendLease(ctx context.Context, in *ExtendLeaseRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, "/pb.TaskQueue/ExtendLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskQueueClient) Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, "/pb.TaskQueue/Ack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskQueueClient) Nack(ctx context.Context, in *NackRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, "/pb.TaskQueue/Nack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskQueueServer is the server API for TaskQueue service.
 type TaskQueueServer interface {
 	Enqueue(context.Context, *EnqueueRequest) (*TaskReply, error)
 	Topics(context.Context, *TopicRequest) (*TaskReply, error)
 	Dequeue(context.Context, *DequeueRequest) (*TaskMessage, error)
+	// LeaseDequeue hands out a task without removing it. The task is redelivered
+	// if it is not acked before the visibility timeout.
+	LeaseDequeue(context.Context, *LeaseDequeueRequest) (*TaskLease, error)
+	ExtendLease(context.Context, *ExtendLeaseRequest) (*TaskReply, error)
+	Ack(context.Context, *AckRequest) (*TaskReply, error)
+	// Nack redelivers the task at once, or moves it to the dead-letter topic
+	// after too many deliveries.
+	Nack(context.Context, *NackRequest) (*TaskReply, error)
+}
+
+// UnimplementedTaskQueueServer can be embedded to have forward compatible implementations.
+type UnimplementedTaskQueueServer struct {
+}
+
+func (*UnimplementedTaskQueueServer) Enqueue(context.Context, *EnqueueRequest) (*TaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enqueue not implemented")
+}
+func (*UnimplementedTaskQueueServer) Topics(context.Context, *TopicRequest) (*TaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Topics not implemented")
+}
+func (*UnimplementedTaskQueueServer) Dequeue(context.Context, *DequeueRequest) (*TaskMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dequeue not implemented")
+}
+func (*UnimplementedTaskQueueServer) LeaseDequeue(context.Context, *LeaseDequeueRequest) (*TaskLease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseDequeue not implemented")
+}
+func (*UnimplementedTaskQueueServer) ExtendLease(context.Context, *ExtendLeaseRequest) (*TaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLease not implemented")
+}
+func (*UnimplementedTaskQueueServer) Ack(context.Context, *AckRequest) (*TaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ack not implemented")
+}
+func (*UnimplementedTaskQueueServer) Nack(context.Context, *NackRequest) (*TaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nack not implemented")
 }
 
 func RegisterTaskQueueServer(s *grpc.Server, srv TaskQueueServer) {
@@ -425,6 +1136,78 @@ func _TaskQueue_Dequeue_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskQueue_LeaseDequeue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseDequeueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServer).LeaseDequeue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.TaskQueue/LeaseDequeue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServer).LeaseDequeue(ctx, req.(*LeaseDequeueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskQueue_ExtendLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServer).ExtendLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.TaskQueue/ExtendLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServer).ExtendLease(ctx, req.(*ExtendLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskQueue_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.TaskQueue/Ack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServer).Ack(ctx, req.(*AckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskQueue_Nack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServer).Nack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.TaskQueue/Nack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServer).Nack(ctx, req.(*NackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TaskQueue_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.TaskQueue",
 	HandlerType: (*TaskQueueServer)(nil),
@@ -441,7 +1224,23 @@ var _TaskQueue_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Dequeue",
 			Handler:    _TaskQueue_Dequeue_Handler,
 		},
+		{
+			MethodName: "LeaseDequeue",
+			Handler:    _TaskQueue_LeaseDequeue_Handler,
+		},
+		{
+			MethodName: "ExtendLease",
+			Handler:    _TaskQueue_ExtendLease_Handler,
+		},
+		{
+			MethodName: "Ack",
+			Handler:    _TaskQueue_Ack_Handler,
+		},
+		{
+			MethodName: "Nack",
+			Handler:    _TaskQueue_Nack_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "message.proto",
+	Metadata: "mq/api/grpc/pb/message.proto",
 }
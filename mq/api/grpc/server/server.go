@@ -20,6 +20,7 @@ package server
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/goodrain/rainbond/util"
 
@@ -86,6 +87,49 @@ func (s *mqServer) Dequeue(ctx context.Context, in *pb.DequeueRequest) (*pb.Task
 	return &task, nil
 }
 
+func (s *mqServer) LeaseDequeue(ctx context.Context, in *pb.LeaseDequeueRequest) (*pb.TaskLease, error) {
+	if in.Topic == "" || !s.actionMQ.TopicIsExist(in.Topic) {
+		return nil, fmt.Errorf("topic %s is not support", in.Topic)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	lease, err := s.actionMQ.LeaseDequeue(ctx, in.Topic, in.ClientHost, time.Duration(in.VisibilityTimeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	var task pb.TaskMessage
+	if err := proto.Unmarshal([]byte(lease.Message), &task); err != nil {
+		return nil, err
+	}
+	logrus.Debugf("task (%s) leased by (%s), delivery count %d.", task.GetTaskType(), in.ClientHost, task.GetDeliveryCount())
+	return &pb.TaskLease{
+		LeaseId:  lease.ID,
+		Message:  &task,
+		Deadline: lease.Deadline.Unix(),
+	}, nil
+}
+
+func (s *mqServer) ExtendLease(ctx context.Context, in *pb.ExtendLeaseRequest) (*pb.TaskReply, error) {
+	if _, err := s.actionMQ.ExtendLease(ctx, in.Topic, in.LeaseId, time.Duration(in.VisibilityTimeout)*time.Second); err != nil {
+		return nil, err
+	}
+	return &pb.TaskReply{Status: "success"}, nil
+}
+
+func (s *mqServer) Ack(ctx context.Context, in *pb.AckRequest) (*pb.TaskReply, error) {
+	if err := s.actionMQ.Ack(ctx, in.Topic, in.LeaseId); err != nil {
+		return nil, err
+	}
+	return &pb.TaskReply{Status: "success"}, nil
+}
+
+func (s *mqServer) Nack(ctx context.Context, in *pb.NackRequest) (*pb.TaskReply, error) {
+	if err := s.actionMQ.Nack(ctx, in.Topic, in.LeaseId, in.Reason); err != nil {
+		return nil, err
+	}
+	return &pb.TaskReply{Status: "success"}, nil
+}
+
 //RegisterServer 注册服务
 func RegisterServer(server *grpc1.Server, actionMQ mq.ActionMQ) {
 	pb.RegisterTaskQueueServer(server, &mqServer{actionMQ})
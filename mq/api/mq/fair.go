@@ -0,0 +1,190 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package mq
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/goodrain/rainbond/mq/api/grpc/pb"
+)
+
+//defaultTenant the tenant of the tasks without tenant id
+const defaultTenant = "default"
+
+//QueueDepth the number of tasks of a tenant at a priority in a topic
+type QueueDepth struct {
+	Priority int32
+	TenantID string
+	Size     int64
+}
+
+//subQueue the tasks of a tenant at a priority in a topic.
+//The key of a task is <topic>/<priority>/<tenant>/<unique>,
+//the key <topic>/<unique> of the old version is taken as priority 0 of the default tenant.
+type subQueue struct {
+	priority int32
+	tenantID string
+	head     *mvccpb.KeyValue
+	size     int64
+}
+
+//subQueuePath the key path of the sub queue of the task under its topic
+func subQueuePath(task *pb.TaskMessage) string {
+	var priority int32
+	tenantID := defaultTenant
+	if task != nil {
+		priority = task.Priority
+		if task.TenantId != "" {
+			tenantID = url.PathEscape(task.TenantId)
+		}
+	}
+	return strconv.Itoa(int(priority)) + "/" + tenantID
+}
+
+//parseSubQueueKey the priority and tenant of the task key under prefix
+func parseSubQueueKey(prefix, key string) (int32, string) {
+	var priority int32
+	tenantID := defaultTenant
+	segments := strings.Split(strings.TrimPrefix(key, prefix), "/")
+	if len(segments) == 3 {
+		if p, err := strconv.Atoi(segments[0]); err == nil {
+			priority = int32(p)
+			if t, err := url.PathUnescape(segments[1]); err == nil {
+				tenantID = t
+			}
+		}
+	}
+	return priority, tenantID
+}
+
+//subQueueID identifies the sub queue of a tenant at a priority
+func subQueueID(priority int32, tenantID string) string {
+	return strconv.Itoa(int(priority)) + "/" + tenantID
+}
+
+//parseSubQueues groups the keys under prefix by priority and tenant.
+//kvs must be sorted by create revision, the head of a sub queue is its oldest task.
+func parseSubQueues(prefix string, kvs []*mvccpb.KeyValue) []*subQueue {
+	var queues []*subQueue
+	index := make(map[string]*subQueue)
+	for _, kv := range kvs {
+		priority, tenantID := parseSubQueueKey(prefix, string(kv.Key))
+		id := subQueueID(priority, tenantID)
+		queue, ok := index[id]
+		if !ok {
+			queue = &subQueue{priority: priority, tenantID: tenantID, head: kv}
+			index[id] = queue
+			queues = append(queues, queue)
+		}
+		queue.size++
+	}
+	return queues
+}
+
+//fairScheduler picks the sub queue to dequeue from.
+//Tasks of a higher priority are always dequeued first. Tenants of the same priority share
+//a topic by their weights with stride scheduling: every dequeue of a tenant advances its
+//pass by 1/weight, and the tenant with the smallest pass goes next.
+//The passes live in memory, so with several mq instances the fairness is per instance.
+type fairScheduler struct {
+	lock    sync.Mutex
+	weights map[string]int
+	//topic -> tenant -> pass
+	passes map[string]map[string]float64
+}
+
+func newFairScheduler(weights map[string]int) *fairScheduler {
+	return &fairScheduler{
+		weights: weights,
+		passes:  make(map[string]map[string]float64),
+	}
+}
+
+func (f *fairScheduler) weight(tenantID string) int {
+	if w, ok := f.weights[tenantID]; ok && w > 0 {
+		return w
+	}
+	return 1
+}
+
+//pick returns the sub queue to dequeue from, nil if queues is empty
+func (f *fairScheduler) pick(topic string, queues []*subQueue) *subQueue {
+	if len(queues) == 0 {
+		return nil
+	}
+	top := queues[0].priority
+	for _, queue := range queues {
+		if queue.priority > top {
+			top = queue.priority
+		}
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	passes := f.passes[topic]
+	if passes == nil {
+		passes = make(map[string]float64)
+		f.passes[topic] = passes
+	}
+	var candidates []*subQueue
+	active := make(map[string]bool)
+	for _, queue := range queues {
+		if queue.priority == top {
+			candidates = append(candidates, queue)
+			active[queue.tenantID] = true
+		}
+	}
+	// forget the tenants without waiting tasks, so that they can not save up passes
+	for tenantID := range passes {
+		if !active[tenantID] {
+			delete(passes, tenantID)
+		}
+	}
+	// a tenant joins with the smallest pass of the others
+	var minPass float64
+	var found bool
+	for _, queue := range candidates {
+		if pass, ok := passes[queue.tenantID]; ok && (!found || pass < minPass) {
+			minPass, found = pass, true
+		}
+	}
+	var picked *subQueue
+	for _, queue := range candidates {
+		if _, ok := passes[queue.tenantID]; !ok {
+			passes[queue.tenantID] = minPass
+		}
+		if picked == nil || passes[queue.tenantID] < passes[picked.tenantID] ||
+			(passes[queue.tenantID] == passes[picked.tenantID] && queue.head.CreateRevision < picked.head.CreateRevision) {
+			picked = queue
+		}
+	}
+	return picked
+}
+
+//charge advances the pass of the tenant after a task of it is dequeued
+func (f *fairScheduler) charge(topic, tenantID string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if pass, ok := f.passes[topic][tenantID]; ok {
+		f.passes[topic][tenantID] = pass + 1/float64(f.weight(tenantID))
+	}
+}
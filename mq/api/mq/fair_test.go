@@ -0,0 +1,98 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package mq
+
+import (
+	"testing"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/goodrain/rainbond/mq/api/grpc/pb"
+)
+
+func TestParseSubQueues(t *testing.T) {
+	prefix := "/mq/builder/"
+	var kvs []*mvccpb.KeyValue
+	for i, key := range []string{
+		"1",
+		prefix + subQueuePath(&pb.TaskMessage{TenantId: "a"}) + "/2",
+		prefix + subQueuePath(nil) + "/3",
+		prefix + subQueuePath(&pb.TaskMessage{TenantId: "a", Priority: -1}) + "/4",
+		prefix + subQueuePath(&pb.TaskMessage{TenantId: "a"}) + "/5",
+	} {
+		if i == 0 {
+			// the key of the old version
+			key = prefix + key
+		}
+		kvs = append(kvs, &mvccpb.KeyValue{Key: []byte(key), CreateRevision: int64(i + 1)})
+	}
+	queues := parseSubQueues(prefix, kvs)
+	if len(queues) != 3 {
+		t.Fatalf("want 3 sub queues, but got %d", len(queues))
+	}
+	tests := []struct {
+		priority int32
+		tenantID string
+		head     int64
+		size     int64
+	}{
+		{0, defaultTenant, 1, 2},
+		{0, "a", 2, 2},
+		{-1, "a", 4, 1},
+	}
+	for i, tc := range tests {
+		q := queues[i]
+		if q.priority != tc.priority || q.tenantID != tc.tenantID || q.head.CreateRevision != tc.head || q.size != tc.size {
+			t.Errorf("sub queue %d: want %+v, but got priority %d tenant %s head %d size %d", i, tc, q.priority, q.tenantID, q.head.CreateRevision, q.size)
+		}
+	}
+}
+
+func TestFairSchedulerPick(t *testing.T) {
+	newQueue := func(priority int32, tenantID string, rev int64) *subQueue {
+		return &subQueue{priority: priority, tenantID: tenantID, head: &mvccpb.KeyValue{CreateRevision: rev}, size: 100}
+	}
+	scheduler := newFairScheduler(map[string]int{"b": 2})
+	// the higher priority goes first, whatever the weight and the revision are
+	queues := []*subQueue{newQueue(-1, "a", 1), newQueue(0, "c", 3)}
+	if picked := scheduler.pick("builder", queues); picked.tenantID != "c" {
+		t.Fatalf("want tenant c, but got %s", picked.tenantID)
+	}
+	// tenant b of weight 2 gets twice the tasks of tenant a
+	queues = []*subQueue{newQueue(0, "a", 1), newQueue(0, "b", 2)}
+	counts := make(map[string]int)
+	for i := 0; i < 30; i++ {
+		picked := scheduler.pick("builder", queues)
+		scheduler.charge("builder", picked.tenantID)
+		counts[picked.tenantID]++
+	}
+	if counts["a"] != 10 || counts["b"] != 20 {
+		t.Errorf("want a 10 and b 20, but got %v", counts)
+	}
+	// a new tenant joins with the smallest pass, it does not take all the turns
+	queues = append(queues, newQueue(0, "d", 3))
+	counts = make(map[string]int)
+	for i := 0; i < 40; i++ {
+		picked := scheduler.pick("builder", queues)
+		scheduler.charge("builder", picked.tenantID)
+		counts[picked.tenantID]++
+	}
+	if counts["a"] != 10 || counts["b"] != 20 || counts["d"] != 10 {
+		t.Errorf("want a 10, b 20 and d 10, but got %v", counts)
+	}
+}
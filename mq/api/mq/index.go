@@ -0,0 +1,157 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package mq
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+//topicIndex the keys of the waiting tasks of a topic grouped by sub queue.
+//It is loaded once and then kept up to date by watching the topic,
+//so that a dequeue does not list the whole topic.
+type topicIndex struct {
+	prefix string
+	lock   sync.Mutex
+	queues map[string]*indexedQueue
+	//key -> id of the sub queue
+	keys map[string]string
+	//closed when a task is put
+	changed chan struct{}
+}
+
+//indexedQueue the keys of a sub queue in the order of their create revisions
+type indexedQueue struct {
+	priority int32
+	tenantID string
+	kvs      []*mvccpb.KeyValue
+}
+
+func newTopicIndex(prefix string) *topicIndex {
+	return &topicIndex{
+		prefix:  prefix,
+		queues:  make(map[string]*indexedQueue),
+		keys:    make(map[string]string),
+		changed: make(chan struct{}),
+	}
+}
+
+//reset replaces the tasks by kvs, kvs must be sorted by create revision
+func (t *topicIndex) reset(kvs []*mvccpb.KeyValue) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.queues = make(map[string]*indexedQueue)
+	t.keys = make(map[string]string)
+	for _, kv := range kvs {
+		t.put(kv)
+	}
+	t.notify()
+}
+
+//apply updates the tasks by the watched events
+func (t *topicIndex) apply(events []*clientv3.Event) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var put bool
+	for _, ev := range events {
+		switch ev.Type {
+		case mvccpb.PUT:
+			if t.put(ev.Kv) {
+				put = true
+			}
+		case mvccpb.DELETE:
+			t.remove(string(ev.Kv.Key))
+		}
+	}
+	if put {
+		t.notify()
+	}
+}
+
+//forget removes the task dequeued or found missing at once, without waiting for its delete event
+func (t *topicIndex) forget(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.remove(key)
+}
+
+//subQueues returns the sub queues with waiting tasks sorted by the revisions of their heads,
+//and a channel closed when a task is put.
+func (t *topicIndex) subQueues() ([]*subQueue, <-chan struct{}) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var queues []*subQueue
+	for _, queue := range t.queues {
+		queues = append(queues, &subQueue{
+			priority: queue.priority,
+			tenantID: queue.tenantID,
+			head:     queue.kvs[0],
+			size:     int64(len(queue.kvs)),
+		})
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].head.CreateRevision < queues[j].head.CreateRevision
+	})
+	return queues, t.changed
+}
+
+//put adds the task, it returns false if the task is known
+func (t *topicIndex) put(kv *mvccpb.KeyValue) bool {
+	key := string(kv.Key)
+	if _, ok := t.keys[key]; ok {
+		return false
+	}
+	priority, tenantID := parseSubQueueKey(t.prefix, key)
+	id := subQueueID(priority, tenantID)
+	queue, ok := t.queues[id]
+	if !ok {
+		queue = &indexedQueue{priority: priority, tenantID: tenantID}
+		t.queues[id] = queue
+	}
+	// the value is read when the task is dequeued, only the key is kept
+	queue.kvs = append(queue.kvs, &mvccpb.KeyValue{Key: kv.Key, CreateRevision: kv.CreateRevision})
+	t.keys[key] = id
+	return true
+}
+
+func (t *topicIndex) remove(key string) {
+	id, ok := t.keys[key]
+	if !ok {
+		return
+	}
+	delete(t.keys, key)
+	queue := t.queues[id]
+	for i, kv := range queue.kvs {
+		if string(kv.Key) == key {
+			queue.kvs = append(queue.kvs[:i], queue.kvs[i+1:]...)
+			break
+		}
+	}
+	if len(queue.kvs) == 0 {
+		delete(t.queues, id)
+	}
+}
+
+func (t *topicIndex) notify() {
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
@@ -0,0 +1,78 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package mq
+
+import (
+	"testing"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/goodrain/rainbond/mq/api/grpc/pb"
+)
+
+func TestTopicIndex(t *testing.T) {
+	prefix := "/mq/builder/"
+	newKV := func(task *pb.TaskMessage, unique string, rev int64) *mvccpb.KeyValue {
+		return &mvccpb.KeyValue{Key: []byte(prefix + subQueuePath(task) + "/" + unique), CreateRevision: rev}
+	}
+	a1 := newKV(&pb.TaskMessage{TenantId: "a"}, "1", 1)
+	b2 := newKV(&pb.TaskMessage{TenantId: "b"}, "2", 2)
+	a3 := newKV(&pb.TaskMessage{TenantId: "a"}, "3", 3)
+	index := newTopicIndex(prefix)
+	queues, changed := index.subQueues()
+	if len(queues) != 0 {
+		t.Fatalf("want no sub queues before loading, but got %d", len(queues))
+	}
+	index.reset([]*mvccpb.KeyValue{a1, b2})
+	select {
+	case <-changed:
+	default:
+		t.Fatal("want the waiters notified after loading")
+	}
+	_, changed = index.subQueues()
+	index.apply([]*clientv3.Event{
+		{Type: mvccpb.PUT, Kv: a3},
+		{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: b2.Key}},
+	})
+	select {
+	case <-changed:
+	default:
+		t.Fatal("want the waiters notified after a task is put")
+	}
+	queues, changed = index.subQueues()
+	if len(queues) != 1 || queues[0].tenantID != "a" || queues[0].size != 2 || queues[0].head.CreateRevision != 1 {
+		t.Fatalf("want sub queue of tenant a with 2 tasks, but got %+v", queues)
+	}
+	// the dequeued task is gone before its delete event, and the event is ignored later
+	index.forget(string(a1.Key))
+	index.apply([]*clientv3.Event{{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: a1.Key}}})
+	select {
+	case <-changed:
+		t.Fatal("want the waiters not notified without new tasks")
+	default:
+	}
+	queues, _ = index.subQueues()
+	if len(queues) != 1 || queues[0].size != 1 || queues[0].head.CreateRevision != 3 {
+		t.Fatalf("want sub queue of tenant a with task 3, but got %+v", queues)
+	}
+	index.forget(string(a3.Key))
+	if queues, _ = index.subQueues(); len(queues) != 0 {
+		t.Fatalf("want no sub queues, but got %d", len(queues))
+	}
+}
@@ -19,19 +19,25 @@
 package mq
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/goodrain/rainbond/cmd/mq/option"
+	"github.com/goodrain/rainbond/mq/api/grpc/pb"
 	"github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/util"
 
 	"golang.org/x/net/context"
 
 	etcdutil "github.com/goodrain/rainbond/util/etcd"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 )
 
@@ -44,8 +50,33 @@ type ActionMQ interface {
 	Start() error
 	Stop() error
 	MessageQueueSize(topic string) int64
+	MessageQueueDepth(topic string) []QueueDepth
+	LeaseDequeue(ctx context.Context, topic, clientHost string, visibilityTimeout time.Duration) (*Lease, error)
+	ExtendLease(ctx context.Context, topic, leaseID string, visibilityTimeout time.Duration) (*Lease, error)
+	Ack(ctx context.Context, topic, leaseID string) error
+	Nack(ctx context.Context, topic, leaseID, reason string) error
 }
 
+//ErrLeaseNotFound the lease is acked, or it is expired and the task is redelivered
+var ErrLeaseNotFound = client.ErrLeaseNotFound
+
+//Lease a task handed out by LeaseDequeue, it must be acked before the deadline
+type Lease struct {
+	ID       string
+	Message  string
+	Deadline time.Time
+}
+
+//inflightTask the leased task saved in etcd
+type inflightTask struct {
+	Message    []byte `json:"message"`
+	ClientHost string `json:"client_host"`
+	Deadline   int64  `json:"deadline"`
+}
+
+//redeliverInterval the interval to check expired leases
+var redeliverInterval = time.Second * 5
+
 // EnqueueNumber enqueue number
 var EnqueueNumber float64 = 0
 
@@ -54,20 +85,28 @@ var DequeueNumber float64 = 0
 
 //NewActionMQ new etcd mq
 func NewActionMQ(ctx context.Context, c option.Config) ActionMQ {
+	ctx, cancel := context.WithCancel(ctx)
 	etcdQueue := etcdQueue{
-		config: c,
-		ctx:    ctx,
-		queues: make(map[string]string),
+		config:    c,
+		ctx:       ctx,
+		cancel:    cancel,
+		queues:    make(map[string]string),
+		indexes:   make(map[string]*topicIndex),
+		scheduler: newFairScheduler(c.TenantWeights),
 	}
 	return &etcdQueue
 }
 
 type etcdQueue struct {
-	config     option.Config
-	ctx        context.Context
-	queues     map[string]string
-	queuesLock sync.Mutex
-	client     *clientv3.Client
+	config      option.Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	queues      map[string]string
+	queuesLock  sync.Mutex
+	indexes     map[string]*topicIndex
+	indexesLock sync.Mutex
+	client      *clientv3.Client
+	scheduler   *fairScheduler
 }
 
 func (e *etcdQueue) Start() error {
@@ -95,6 +134,10 @@ func (e *etcdQueue) Start() error {
 	e.registerTopic(client.BuilderTopic)
 	e.registerTopic(client.WindowsBuilderTopic)
 	e.registerTopic(client.WorkerTopic)
+	for _, t := range e.GetAllTopics() {
+		e.registerTopic(client.DeadLetterTopic(t))
+	}
+	go e.redeliverExpired()
 	logrus.Info("etcd message queue client started success")
 	return nil
 }
@@ -113,6 +156,8 @@ func (e *etcdQueue) TopicIsExist(topic string) bool {
 	return ok
 }
 func (e *etcdQueue) GetAllTopics() []string {
+	e.queuesLock.Lock()
+	defer e.queuesLock.Unlock()
 	var topics []string
 	for k := range e.queues {
 		topics = append(topics, k)
@@ -121,6 +166,7 @@ func (e *etcdQueue) GetAllTopics() []string {
 }
 
 func (e *etcdQueue) Stop() error {
+	e.cancel()
 	if e.client != nil {
 		e.client.Close()
 	}
@@ -129,22 +175,119 @@ func (e *etcdQueue) Stop() error {
 func (e *etcdQueue) queueKey(topic string) string {
 	return e.config.EtcdPrefix + "/" + topic
 }
+
+//subQueueKey the key prefix of the sub queue of the task by its priority and tenant
+func (e *etcdQueue) subQueueKey(topic string, task *pb.TaskMessage) string {
+	return e.queueKey(topic) + "/" + subQueuePath(task)
+}
+
+//parseTask returns nil if the value is not a task message
+func parseTask(value string) *pb.TaskMessage {
+	var task pb.TaskMessage
+	if err := proto.Unmarshal([]byte(value), &task); err != nil {
+		return nil
+	}
+	return &task
+}
+
 func (e *etcdQueue) Enqueue(ctx context.Context, topic, value string) error {
 	EnqueueNumber++
-	queue := etcdutil.NewQueue(ctx, e.client, e.queueKey(topic))
+	queue := etcdutil.NewQueue(ctx, e.client, e.subQueueKey(topic, parseTask(value)))
 	return queue.Enqueue(value)
 }
 
 func (e *etcdQueue) Dequeue(ctx context.Context, topic string) (string, error) {
 	DequeueNumber++
-	queue := etcdutil.NewQueue(ctx, e.client, e.queueKey(topic))
-	return queue.Dequeue()
+	return e.dequeue(ctx, topic, nil)
+}
+
+//dequeue removes the task picked by the fair scheduler from topic, the ops built from the task
+//are committed in the same transaction. It blocks until a task is available or ctx is done.
+func (e *etcdQueue) dequeue(ctx context.Context, topic string, ops func(val string) []clientv3.Op) (string, error) {
+	index := e.topicIndex(topic)
+	queue := etcdutil.NewQueue(ctx, e.client, index.prefix)
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		queues, changed := index.subQueues()
+		picked := e.scheduler.pick(topic, queues)
+		if picked == nil {
+			// nothing yet; wait on tasks
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-changed:
+			}
+			continue
+		}
+		key := string(picked.head.Key)
+		head, err := e.client.Get(ctx, key)
+		if err != nil {
+			return "", err
+		}
+		if len(head.Kvs) == 0 {
+			// dequeued by others
+			index.forget(key)
+			continue
+		}
+		ok, err := queue.Claim(head.Kvs[0], ops)
+		if err != nil {
+			return "", err
+		}
+		index.forget(key)
+		if ok {
+			e.scheduler.charge(topic, picked.tenantID)
+			return string(head.Kvs[0].Value), nil
+		}
+	}
+}
+
+//topicIndex returns the index of the waiting tasks of topic, the topic is watched from the first call on
+func (e *etcdQueue) topicIndex(topic string) *topicIndex {
+	e.indexesLock.Lock()
+	defer e.indexesLock.Unlock()
+	index, ok := e.indexes[topic]
+	if !ok {
+		index = newTopicIndex(e.queueKey(topic) + "/")
+		e.indexes[topic] = index
+		go e.watchTopic(index)
+	}
+	return index
+}
+
+//watchTopic keeps the index up to date until the queue stops.
+//The index is reloaded if the watch fails, e.g. the revision to watch from is compacted.
+func (e *etcdQueue) watchTopic(index *topicIndex) {
+	for e.ctx.Err() == nil {
+		resp, err := e.client.Get(e.ctx, index.prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly(),
+			clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortAscend))
+		if err != nil {
+			logrus.Warningf("load message queue %s failure %s", index.prefix, err.Error())
+			select {
+			case <-e.ctx.Done():
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		index.reset(resp.Kvs)
+		ctx, cancel := context.WithCancel(e.ctx)
+		wc := e.client.Watch(clientv3.WithRequireLeader(ctx), index.prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1))
+		for wresp := range wc {
+			if err := wresp.Err(); err != nil {
+				logrus.Warningf("watch message queue %s failure %s", index.prefix, err.Error())
+				break
+			}
+			index.apply(wresp.Events)
+		}
+		cancel()
+	}
 }
 
 func (e *etcdQueue) MessageQueueSize(topic string) int64 {
 	ctx, cancel := context.WithCancel(e.ctx)
 	defer cancel()
-	res, err := e.client.Get(ctx, e.queueKey(topic), clientv3.WithPrefix())
+	res, err := e.client.Get(ctx, e.queueKey(topic)+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
 	if err != nil {
 		logrus.Errorf("get message queue size failure %s", err.Error())
 	}
@@ -153,3 +296,202 @@ func (e *etcdQueue) MessageQueueSize(topic string) int64 {
 	}
 	return 0
 }
+
+//MessageQueueDepth the number of tasks of topic by priority and tenant
+func (e *etcdQueue) MessageQueueDepth(topic string) []QueueDepth {
+	ctx, cancel := context.WithCancel(e.ctx)
+	defer cancel()
+	prefix := e.queueKey(topic) + "/"
+	res, err := e.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly(),
+		clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortAscend))
+	if err != nil {
+		logrus.Errorf("get message queue depth failure %s", err.Error())
+		return nil
+	}
+	var depths []QueueDepth
+	for _, queue := range parseSubQueues(prefix, res.Kvs) {
+		depths = append(depths, QueueDepth{Priority: queue.priority, TenantID: queue.tenantID, Size: queue.size})
+	}
+	return depths
+}
+
+func (e *etcdQueue) inflightPrefix() string {
+	return e.config.EtcdPrefix + "_inflight/"
+}
+
+func (e *etcdQueue) inflightKey(topic, leaseID string) string {
+	return e.inflightPrefix() + topic + "/" + leaseID
+}
+
+func (e *etcdQueue) visibilityTimeout(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	if e.config.VisibilityTimeout > 0 {
+		return time.Duration(e.config.VisibilityTimeout) * time.Second
+	}
+	return time.Minute
+}
+
+//LeaseDequeue moves the first task of the topic to the inflight tasks in one transaction,
+//so that the task is redelivered if the consumer crashes before acking it.
+func (e *etcdQueue) LeaseDequeue(ctx context.Context, topic, clientHost string, visibilityTimeout time.Duration) (*Lease, error) {
+	visibilityTimeout = e.visibilityTimeout(visibilityTimeout)
+	for {
+		var lease *Lease
+		_, err := e.dequeue(ctx, topic, func(val string) []clientv3.Op {
+			var task pb.TaskMessage
+			if err := proto.Unmarshal([]byte(val), &task); err != nil {
+				lease = nil
+				logrus.Warningf("move malformed message of topic %s to the dead letter topic: %v", topic, err)
+				return []clientv3.Op{clientv3.OpPut(e.newQueueKey(client.DeadLetterTopic(topic), nil), val)}
+			}
+			task.DeliveryCount++
+			message, _ := proto.Marshal(&task)
+			lease = &Lease{ID: util.NewUUID(), Message: string(message), Deadline: time.Now().Add(visibilityTimeout)}
+			record, _ := json.Marshal(&inflightTask{Message: message, ClientHost: clientHost, Deadline: lease.Deadline.Unix()})
+			return []clientv3.Op{clientv3.OpPut(e.inflightKey(topic, lease.ID), string(record))}
+		})
+		if err != nil {
+			return nil, err
+		}
+		if lease != nil {
+			DequeueNumber++
+			return lease, nil
+		}
+	}
+}
+
+//ExtendLease resets the deadline of the lease
+func (e *etcdQueue) ExtendLease(ctx context.Context, topic, leaseID string, visibilityTimeout time.Duration) (*Lease, error) {
+	kv, record, err := e.getInflightTask(ctx, topic, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(e.visibilityTimeout(visibilityTimeout))
+	record.Deadline = deadline.Unix()
+	value, _ := json.Marshal(record)
+	resp, err := e.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)).
+		Then(clientv3.OpPut(string(kv.Key), string(value))).Commit()
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Succeeded {
+		return nil, ErrLeaseNotFound
+	}
+	return &Lease{ID: leaseID, Message: string(record.Message), Deadline: deadline}, nil
+}
+
+//Ack removes the leased task
+func (e *etcdQueue) Ack(ctx context.Context, topic, leaseID string) error {
+	resp, err := e.client.Delete(ctx, e.inflightKey(topic, leaseID))
+	if err != nil {
+		return err
+	}
+	if resp.Deleted == 0 {
+		return ErrLeaseNotFound
+	}
+	return nil
+}
+
+//Nack redelivers the leased task at once
+func (e *etcdQueue) Nack(ctx context.Context, topic, leaseID, reason string) error {
+	kv, record, err := e.getInflightTask(ctx, topic, leaseID)
+	if err != nil {
+		return err
+	}
+	ok, err := e.redeliver(ctx, topic, kv, record, reason)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrLeaseNotFound
+	}
+	return nil
+}
+
+func (e *etcdQueue) getInflightTask(ctx context.Context, topic, leaseID string) (*mvccpb.KeyValue, *inflightTask, error) {
+	resp, err := e.client.Get(ctx, e.inflightKey(topic, leaseID))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil, ErrLeaseNotFound
+	}
+	var record inflightTask
+	if err := json.Unmarshal(resp.Kvs[0].Value, &record); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal inflight task: %v", err)
+	}
+	return resp.Kvs[0], &record, nil
+}
+
+//newQueueKey the key to put a task to the end of its sub queue of topic
+func (e *etcdQueue) newQueueKey(topic string, task *pb.TaskMessage) string {
+	return fmt.Sprintf("%s/%v", e.subQueueKey(topic, task), time.Now().UnixNano())
+}
+
+//redeliver puts the leased task back to its topic, or to the dead letter topic if it is delivered too many times.
+//It returns false if the lease is acked or redelivered by others.
+func (e *etcdQueue) redeliver(ctx context.Context, topic string, kv *mvccpb.KeyValue, record *inflightTask, reason string) (bool, error) {
+	var task pb.TaskMessage
+	var queued = &task
+	if err := proto.Unmarshal(record.Message, &task); err != nil {
+		logrus.Warningf("unmarshal inflight task %s: %v", kv.Key, err)
+		queued = nil
+	}
+	target := topic
+	maxDeliveries := e.config.MaxDeliveries
+	if maxDeliveries > 0 && int(task.DeliveryCount) >= maxDeliveries {
+		target = client.DeadLetterTopic(topic)
+		e.registerTopic(target)
+	}
+	resp, err := e.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)).
+		Then(clientv3.OpDelete(string(kv.Key)), clientv3.OpPut(e.newQueueKey(target, queued), string(record.Message))).Commit()
+	if err != nil {
+		return false, err
+	}
+	if resp.Succeeded {
+		logrus.Infof("task %s(%s) delivered %d times is put to topic %s, reason: %s", task.TaskId, task.TaskType, task.DeliveryCount, target, reason)
+	}
+	return resp.Succeeded, nil
+}
+
+//redeliverExpired redelivers the leased tasks which are not acked before the deadline
+func (e *etcdQueue) redeliverExpired() {
+	ticker := time.NewTicker(redeliverInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		ctx, cancel := context.WithTimeout(e.ctx, redeliverInterval)
+		resp, err := e.client.Get(ctx, e.inflightPrefix(), clientv3.WithPrefix())
+		if err != nil {
+			logrus.Warningf("list inflight tasks failure %s", err.Error())
+			cancel()
+			continue
+		}
+		now := time.Now().Unix()
+		for _, kv := range resp.Kvs {
+			var record inflightTask
+			if err := json.Unmarshal(kv.Value, &record); err != nil || record.Deadline > now {
+				continue
+			}
+			key := strings.TrimPrefix(string(kv.Key), e.inflightPrefix())
+			index := strings.LastIndex(key, "/")
+			if index < 0 {
+				continue
+			}
+			topic := key[:index]
+			reason := fmt.Sprintf("lease of %s expired", record.ClientHost)
+			if _, err := e.redeliver(ctx, topic, kv, &record, reason); err != nil {
+				logrus.Warningf("redeliver inflight task %s failure %s", kv.Key, err.Error())
+			}
+		}
+		cancel()
+	}
+}
@@ -22,6 +22,11 @@ import (
 	"context"
 	"github.com/goodrain/rainbond/cmd/mq/option"
 	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/goodrain/rainbond/mq/api/grpc/pb"
+	"github.com/goodrain/rainbond/mq/client"
 )
 
 func TestEnqueue(t *testing.T) {
@@ -40,3 +45,83 @@ func TestEnqueue(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestLeaseDequeue(t *testing.T) {
+	redeliverInterval = time.Millisecond * 500
+	mq := NewActionMQ(context.TODO(), option.Config{
+		EtcdEndPoints: []string{"http://127.0.0.1:2379"},
+		EtcdPrefix:    "/mq-lease-test",
+		EtcdTimeout:   5,
+		MaxDeliveries: 2,
+	})
+	if err := mq.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mq.Stop()
+	message, _ := proto.Marshal(&pb.TaskMessage{TaskId: "lease-test", TaskType: "test"})
+	if err := mq.Enqueue(context.Background(), "lease", string(message)); err != nil {
+		t.Fatal(err)
+	}
+	lease := func() (*Lease, *pb.TaskMessage) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		lease, err := mq.LeaseDequeue(ctx, "lease", "test", time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var task pb.TaskMessage
+		if err := proto.Unmarshal([]byte(lease.Message), &task); err != nil {
+			t.Fatal(err)
+		}
+		return lease, &task
+	}
+	// not acked, redelivered after the visibility timeout
+	_, task := lease()
+	if task.DeliveryCount != 1 {
+		t.Fatalf("expected delivery count 1, got %d", task.DeliveryCount)
+	}
+	l, task := lease()
+	if task.TaskId != "lease-test" || task.DeliveryCount != 2 {
+		t.Fatalf("expected redelivered task, got %v", task)
+	}
+	// delivered too many times, moved to the dead letter topic
+	if err := mq.Nack(context.Background(), "lease", l.ID, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mq.Ack(context.Background(), "lease", l.ID); err != ErrLeaseNotFound {
+		t.Fatalf("expected lease not found, got %v", err)
+	}
+	if size := mq.MessageQueueSize(client.DeadLetterTopic("lease")); size != 1 {
+		t.Fatalf("expected 1 message in dead letter topic, got %d", size)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	dead, err := mq.LeaseDequeue(ctx, client.DeadLetterTopic("lease"), "test", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mq.Ack(context.Background(), client.DeadLetterTopic("lease"), dead.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLeaseDequeueCanceled(t *testing.T) {
+	mq := NewActionMQ(context.TODO(), option.Config{
+		EtcdEndPoints: []string{"http://127.0.0.1:2379"},
+		EtcdPrefix:    "/mq-cancel-test",
+		EtcdTimeout:   5,
+	})
+	if err := mq.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mq.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+	defer cancel()
+	start := time.Now()
+	if _, err := mq.LeaseDequeue(ctx, "empty", "test", 0); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if cost := time.Since(start); cost > time.Second*2 {
+		t.Fatalf("expected to return once canceled, but took %s", cost)
+	}
+}
@@ -40,6 +40,11 @@ var WindowsBuilderTopic = "windows_builder"
 //WorkerTopic worker topic
 var WorkerTopic = "worker"
 
+//DeadLetterTopic the topic of the tasks which are failed too many times
+func DeadLetterTopic(topic string) string {
+	return topic + "_dead_letter"
+}
+
 //MQClient mq  client
 type MQClient interface {
 	pb.TaskQueueClient
@@ -89,11 +94,26 @@ func (m *mqClient) Close() {
 	m.cancel()
 }
 
+//TaskPriority the tasks of higher priority are dequeued first
+type TaskPriority int32
+
+const (
+	//PriorityLow batch tasks, such as building all components of an application
+	PriorityLow TaskPriority = -1
+	//PriorityNormal the default priority
+	PriorityNormal TaskPriority = 0
+	//PriorityHigh tasks a user is waiting for, such as waking up an idle component
+	PriorityHigh TaskPriority = 1
+)
+
 //TaskStruct task struct
 type TaskStruct struct {
 	Topic    string
 	TaskType string
 	TaskBody interface{}
+	Priority TaskPriority
+	//tasks of the same priority are dequeued fairly across tenants
+	TenantID string
 }
 
 //buildTask build task
@@ -110,6 +130,8 @@ func buildTask(t TaskStruct) (*pb.EnqueueRequest, error) {
 		CreateTime: time.Now().Format(time.RFC3339),
 		TaskBody:   taskJSON,
 		User:       "rainbond",
+		Priority:   int32(t.Priority),
+		TenantId:   t.TenantID,
 	}
 	return &er, nil
 }
@@ -0,0 +1,128 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goodrain/rainbond/mq/api/grpc/pb"
+	"github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+//ErrLeaseNotFound the lease is acked, or it is expired and the task is redelivered
+var ErrLeaseNotFound = errors.New("lease not found or expired")
+
+//DefaultVisibilityTimeout the visibility timeout of the leases kept by LeaseKeeper
+var DefaultVisibilityTimeout = time.Minute
+
+//LeaseKeeper leases tasks of a topic and keeps the leases alive until they are acked or nacked,
+//so that a task is redelivered only if the consumer crashes.
+type LeaseKeeper struct {
+	ctx               context.Context
+	client            pb.TaskQueueClient
+	topic             string
+	visibilityTimeout time.Duration
+	// task id -> lease id
+	leases sync.Map
+}
+
+//NewLeaseKeeper creates a lease keeper, it stops extending the leases when ctx is done
+func NewLeaseKeeper(ctx context.Context, client pb.TaskQueueClient, topic string) *LeaseKeeper {
+	l := &LeaseKeeper{
+		ctx:               ctx,
+		client:            client,
+		topic:             topic,
+		visibilityTimeout: DefaultVisibilityTimeout,
+	}
+	go l.keepalive()
+	return l
+}
+
+//Lease leases a task of the topic, it blocks until a task is available
+func (l *LeaseKeeper) Lease(ctx context.Context, clientHost string) (*pb.TaskMessage, error) {
+	lease, err := l.client.LeaseDequeue(ctx, &pb.LeaseDequeueRequest{
+		Topic:             l.topic,
+		ClientHost:        clientHost,
+		VisibilityTimeout: int64(l.visibilityTimeout / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	l.leases.Store(lease.Message.TaskId, lease.LeaseId)
+	return lease.Message, nil
+}
+
+//Ack acknowledges the task is done
+func (l *LeaseKeeper) Ack(taskID string) error {
+	leaseID, ok := l.leases.Load(taskID)
+	if !ok {
+		return ErrLeaseNotFound
+	}
+	l.leases.Delete(taskID)
+	ctx, cancel := context.WithTimeout(l.ctx, time.Second*5)
+	defer cancel()
+	_, err := l.client.Ack(ctx, &pb.AckRequest{Topic: l.topic, LeaseId: leaseID.(string)})
+	return err
+}
+
+//Nack gives the task back, it is redelivered at once or moved to the dead letter topic
+func (l *LeaseKeeper) Nack(taskID, reason string) error {
+	leaseID, ok := l.leases.Load(taskID)
+	if !ok {
+		return ErrLeaseNotFound
+	}
+	l.leases.Delete(taskID)
+	ctx, cancel := context.WithTimeout(l.ctx, time.Second*5)
+	defer cancel()
+	_, err := l.client.Nack(ctx, &pb.NackRequest{Topic: l.topic, LeaseId: leaseID.(string), Reason: reason})
+	return err
+}
+
+func (l *LeaseKeeper) keepalive() {
+	ticker := time.NewTicker(l.visibilityTimeout / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		l.leases.Range(func(taskID, leaseID interface{}) bool {
+			ctx, cancel := context.WithTimeout(l.ctx, time.Second*5)
+			defer cancel()
+			_, err := l.client.ExtendLease(ctx, &pb.ExtendLeaseRequest{
+				Topic:             l.topic,
+				LeaseId:           leaseID.(string),
+				VisibilityTimeout: int64(l.visibilityTimeout / time.Second),
+			})
+			if err != nil && strings.Contains(err.Error(), ErrLeaseNotFound.Error()) {
+				// the task has been redelivered, stop keeping it
+				logrus.Warningf("lease of task %s is lost, it may be handled again", taskID)
+				l.leases.Delete(taskID)
+			} else if err != nil {
+				logrus.Warningf("extend lease of task %s failure %s", taskID, err.Error())
+			}
+			return true
+		})
+	}
+}
@@ -19,6 +19,8 @@
 package monitor
 
 import (
+	"strconv"
+
 	"github.com/goodrain/rainbond/mq/api/mq"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -48,6 +50,8 @@ type Exporter struct {
 	lbPluginUp         prometheus.Gauge
 	queueMessageNumber *prometheus.GaugeVec
 	mqm                mq.ActionMQ
+	//the number of tasks by topic, priority and tenant
+	queueDepth *prometheus.GaugeVec
 }
 
 var healthDesc = prometheus.NewDesc(
@@ -88,6 +92,11 @@ func NewExporter(mqm mq.ActionMQ) *Exporter {
 			Name:      "queue_message_number",
 			Help:      "Message queue enqueue total.",
 		}, []string{"topic"}),
+		queueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_depth",
+			Help:      "The number of waiting tasks by topic, priority and tenant.",
+		}, []string{"topic", "priority", "tenant"}),
 	}
 }
 
@@ -118,6 +127,13 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		e.queueMessageNumber.WithLabelValues(topic).Set(float64(e.mqm.MessageQueueSize(topic)))
 	}
 	e.queueMessageNumber.Collect(ch)
+	e.queueDepth.Reset()
+	for _, topic := range e.mqm.GetAllTopics() {
+		for _, depth := range e.mqm.MessageQueueDepth(topic) {
+			e.queueDepth.WithLabelValues(topic, strconv.Itoa(int(depth.Priority)), depth.TenantID).Set(float64(depth.Size))
+		}
+	}
+	e.queueDepth.Collect(ch)
 }
 
 func (e *Exporter) scrape(ch chan<- prometheus.Metric) {
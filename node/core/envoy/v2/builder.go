@@ -24,6 +24,7 @@ import (
 
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/sirupsen/logrus"
 
@@ -33,11 +34,14 @@ import (
 	endpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	envoy_api_v2_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	fault "github.com/envoyproxy/go-control-plane/envoy/config/filter/fault/v2"
+	http_fault "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/fault/v2"
 	http_rate_limit "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/rate_limit/v2"
 	http_connection_manager "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
 	tcp_proxy "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
 	envoy_config_filter_udp_udp_proxy_v2alpha "github.com/envoyproxy/go-control-plane/envoy/config/filter/udp/udp_proxy/v2alpha"
 	configratelimit "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v2"
+	trace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v2"
 	corev1 "k8s.io/api/core/v1"
 
 	_type "github.com/envoyproxy/go-control-plane/envoy/type"
@@ -51,6 +55,12 @@ var DefaultLocalhostListenerAddress = "127.0.0.1"
 // DefaultLocalhostListenerPort -
 var DefaultLocalhostListenerPort uint32 = 80
 
+//DefaultTracingCollectorClusterName the cluster of zipkin collector
+var DefaultTracingCollectorClusterName = "rainbond_tracing_collector"
+
+//ZipkinTracerName the zipkin tracer of envoy
+const ZipkinTracerName = "envoy.tracers.zipkin"
+
 //CreateTCPListener listener builder
 func CreateTCPListener(name, clusterName, address, statPrefix string, port uint32, idleTimeout int64) *apiv2.Listener {
 	if address == "" {
@@ -165,7 +175,7 @@ func CreateHTTPRateLimit(option RateLimitOptions) *http_rate_limit.RateLimit {
 }
 
 //CreateHTTPConnectionManager create http connection manager
-func CreateHTTPConnectionManager(name, statPrefix string, rateOpt *RateLimitOptions, routes ...*route.VirtualHost) *http_connection_manager.HttpConnectionManager {
+func CreateHTTPConnectionManager(name, statPrefix string, rateOpt *RateLimitOptions, tracing *TracingOptions, routes ...*route.VirtualHost) *http_connection_manager.HttpConnectionManager {
 	var httpFilters []*http_connection_manager.HttpFilter
 	if rateOpt != nil && rateOpt.Enable {
 		httpFilters = append(httpFilters, &http_connection_manager.HttpFilter{
@@ -175,6 +185,13 @@ func CreateHTTPConnectionManager(name, statPrefix string, rateOpt *RateLimitOpti
 			},
 		})
 	}
+	if hasRouteFault(routes) {
+		// the fault of every route is set by its typed per filter config
+		httpFilters = append(httpFilters, &http_connection_manager.HttpFilter{
+			Name:       wellknown.Fault,
+			ConfigType: &http_connection_manager.HttpFilter_TypedConfig{TypedConfig: Message2Any(&http_fault.HTTPFault{})},
+		})
+	}
 	httpFilters = append(httpFilters, &http_connection_manager.HttpFilter{
 		Name: wellknown.Router,
 	})
@@ -188,6 +205,9 @@ func CreateHTTPConnectionManager(name, statPrefix string, rateOpt *RateLimitOpti
 		},
 		HttpFilters: httpFilters,
 	}
+	if tracing != nil {
+		hcm.Tracing = CreateTracing(*tracing)
+	}
 	if err := hcm.Validate(); err != nil {
 		logrus.Errorf("validate http connertion manager config failure %s", err.Error())
 		return nil
@@ -196,8 +216,8 @@ func CreateHTTPConnectionManager(name, statPrefix string, rateOpt *RateLimitOpti
 }
 
 //CreateHTTPListener create http manager listener
-func CreateHTTPListener(name, address, statPrefix string, port uint32, rateOpt *RateLimitOptions, routes ...*route.VirtualHost) *apiv2.Listener {
-	hcm := CreateHTTPConnectionManager(name, statPrefix, rateOpt, routes...)
+func CreateHTTPListener(name, address, statPrefix string, port uint32, rateOpt *RateLimitOptions, tracing *TracingOptions, routes ...*route.VirtualHost) *apiv2.Listener {
+	hcm := CreateHTTPConnectionManager(name, statPrefix, rateOpt, tracing, routes...)
 	if hcm == nil {
 		logrus.Warningf("create http connection manager failure %s", name)
 		return nil
@@ -234,6 +254,53 @@ func CreateHTTPListener(name, address, statPrefix string, port uint32, rateOpt *
 	return listener
 }
 
+//CreateTracing create the tracing of http connection manager
+//return nil for the otlp driver, the opentelemetry tracer of envoy is only configured by the v3 api
+func CreateTracing(options TracingOptions) *http_connection_manager.HttpConnectionManager_Tracing {
+	if options.Driver == TracingDriverOTLP {
+		logrus.Warningf("the otlp tracing driver needs the sidecar of the envoy v3 api, tracing is disabled")
+		return nil
+	}
+	tracing := &http_connection_manager.HttpConnectionManager_Tracing{
+		RandomSampling: &_type.Percent{Value: options.Sampling},
+	}
+	switch options.Driver {
+	case TracingDriverZipkin:
+		tracing.Provider = &trace.Tracing_Http{
+			Name: ZipkinTracerName,
+			ConfigType: &trace.Tracing_Http_TypedConfig{TypedConfig: Message2Any(&trace.ZipkinConfig{
+				CollectorCluster:         DefaultTracingCollectorClusterName,
+				CollectorEndpoint:        options.Collector.Path,
+				CollectorEndpointVersion: trace.ZipkinConfig_HTTP_JSON,
+				TraceId_128Bit:           true,
+			})},
+		}
+	}
+	return tracing
+}
+
+//CreateTracingCollectorCluster create the cluster of zipkin collector
+//return nil if the driver does not report spans by cluster
+func CreateTracingCollectorCluster(options TracingOptions) *apiv2.Cluster {
+	if options.Driver != TracingDriverZipkin {
+		return nil
+	}
+	address := CreateSocketAddress("tcp", options.Collector.Host, options.Collector.Port)
+	return CreateCluster(ClusterOptions{
+		Name:              DefaultTracingCollectorClusterName,
+		ClusterType:       apiv2.Cluster_STRICT_DNS,
+		ConnectionTimeout: ConverTimeDuration(1),
+		LoadAssignment: &apiv2.ClusterLoadAssignment{
+			ClusterName: DefaultTracingCollectorClusterName,
+			Endpoints: []*endpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*endpoint.LbEndpoint{{
+					HostIdentifier: &endpoint.LbEndpoint_Endpoint{Endpoint: &endpoint.Endpoint{Address: address}},
+				}},
+			}},
+		},
+	})
+}
+
 //CreateSocketAddress create socket address
 func CreateSocketAddress(protocol, address string, port uint32) *core.Address {
 	if strings.HasPrefix(address, "https://") {
@@ -273,6 +340,12 @@ func CreateCircuitBreaker(options RainbondPluginOptions) *cluster.CircuitBreaker
 			},
 		},
 	}
+	if options.RetryBudgetPercent > 0 {
+		circuitBreakers.Thresholds[0].RetryBudget = &cluster.CircuitBreakers_Thresholds_RetryBudget{
+			BudgetPercent:       &_type.Percent{Value: options.RetryBudgetPercent},
+			MinRetryConcurrency: ConversionUInt32(options.MinRetryConcurrency),
+		}
+	}
 	if err := circuitBreakers.Validate(); err != nil {
 		logrus.Errorf("validate envoy config circuitBreakers failure %s", err.Error())
 		return nil
@@ -295,6 +368,92 @@ func CreatOutlierDetection(options RainbondPluginOptions) *cluster.OutlierDetect
 	return outlierDetection
 }
 
+//CreateRetryPolicy create http route retry policy
+//return nil if no retry condition is set
+func CreateRetryPolicy(options RainbondPluginOptions) *route.RetryPolicy {
+	if options.RetryOn == "" {
+		return nil
+	}
+	retryPolicy := &route.RetryPolicy{
+		RetryOn:              options.RetryOn,
+		NumRetries:           ConversionUInt32(options.NumRetries),
+		RetriableStatusCodes: options.RetriableStatusCodes,
+	}
+	if options.PerTryTimeoutMS > 0 {
+		retryPolicy.PerTryTimeout = ConverTimeDurationMS(options.PerTryTimeoutMS)
+	}
+	if err := retryPolicy.Validate(); err != nil {
+		logrus.Errorf("validate route retry policy failure %s", err.Error())
+		return nil
+	}
+	return retryPolicy
+}
+
+//CreateHTTPFault create http fault injection config
+//return nil if neither delay nor abort is set
+func CreateHTTPFault(options RainbondPluginOptions) *http_fault.HTTPFault {
+	httpFault := &http_fault.HTTPFault{}
+	if options.FaultDelayPercent > 0 && options.FaultDelayMS > 0 {
+		httpFault.Delay = &fault.FaultDelay{
+			FaultDelaySecifier: &fault.FaultDelay_FixedDelay{
+				FixedDelay: ConverTimeDurationMS(options.FaultDelayMS),
+			},
+			Percentage: &_type.FractionalPercent{
+				Numerator:   options.FaultDelayPercent,
+				Denominator: _type.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+	if options.FaultAbortPercent > 0 {
+		httpFault.Abort = &http_fault.FaultAbort{
+			ErrorType: &http_fault.FaultAbort_HttpStatus{
+				HttpStatus: options.FaultAbortStatus,
+			},
+			Percentage: &_type.FractionalPercent{
+				Numerator:   options.FaultAbortPercent,
+				Denominator: _type.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+	if httpFault.Delay == nil && httpFault.Abort == nil {
+		return nil
+	}
+	if err := httpFault.Validate(); err != nil {
+		logrus.Errorf("validate http fault config failure %s", err.Error())
+		return nil
+	}
+	return httpFault
+}
+
+//SetRouteOptions set the timeout, retry policy and fault injection of a http route
+func SetRouteOptions(rout *route.Route, options RainbondPluginOptions) {
+	if rout == nil {
+		return
+	}
+	if action, ok := rout.Action.(*route.Route_Route); ok {
+		if options.RequestTimeoutMS != nil {
+			action.Route.Timeout = ConverTimeDurationMS(*options.RequestTimeoutMS)
+		}
+		action.Route.RetryPolicy = CreateRetryPolicy(options)
+	}
+	if httpFault := CreateHTTPFault(options); httpFault != nil {
+		rout.TypedPerFilterConfig = map[string]*any.Any{
+			wellknown.Fault: Message2Any(httpFault),
+		}
+	}
+}
+
+func hasRouteFault(virtualHosts []*route.VirtualHost) bool {
+	for _, vh := range virtualHosts {
+		for _, rout := range vh.GetRoutes() {
+			if _, ok := rout.GetTypedPerFilterConfig()[wellknown.Fault]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 //CreateRouteVirtualHost create route virtual host
 func CreateRouteVirtualHost(name string, domains []string, rateLimits []*route.RateLimit, routes ...*route.Route) *route.VirtualHost {
 	pvh := &route.VirtualHost{
@@ -24,6 +24,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/envoyproxy/go-control-plane/pkg/conversion"
 	"github.com/gogo/protobuf/proto"
@@ -40,6 +41,7 @@ import (
 	_struct "github.com/golang/protobuf/ptypes/struct"
 
 	v1 "github.com/goodrain/rainbond/node/core/envoy/v1"
+	"github.com/goodrain/rainbond/util"
 )
 
 // MessageToStruct converts from proto message to proto Struct
@@ -83,6 +85,11 @@ func ConverTimeDuration(second int64) *duration.Duration {
 	}
 }
 
+//ConverTimeDurationMS millisecond
+func ConverTimeDurationMS(millisecond int64) *duration.Duration {
+	return ptypes.DurationProto(time.Duration(millisecond) * time.Millisecond)
+}
+
 const (
 	//KeyPrefix request path prefix
 	KeyPrefix string = "Prefix"
@@ -134,8 +141,43 @@ const (
 	KeyHealthCheckTimeout string = "HealthCheckTimeout"
 	// cluster health check interval
 	KeyHealthCheckInterval string = "HealthCheckInterval"
+	//KeyRetryOn the conditions a request is retried on, comma separated, such as 5xx,connect-failure.
+	//retry is disabled if not set
+	KeyRetryOn string = "RetryOn"
+	//KeyRetriableStatusCodes the http status codes retried on by the retriable-status-codes condition, comma separated, such as 503,504
+	KeyRetriableStatusCodes string = "RetriableStatusCodes"
+	//KeyNumRetries the max number of retries of a request, default 2
+	KeyNumRetries string = "NumRetries"
+	//KeyPerTryTimeoutMS the timeout of every retry in milliseconds
+	KeyPerTryTimeoutMS string = "PerTryTimeoutMS"
+	//KeyRetryBudgetPercent the percent of the active requests that can be retries at the same time,
+	//it replaces MaxActiveRetries if set
+	KeyRetryBudgetPercent string = "RetryBudgetPercent"
+	//KeyMinRetryConcurrency the retries always allowed regardless of the retry budget, default 3
+	KeyMinRetryConcurrency string = "MinRetryConcurrency"
+	//KeyRequestTimeoutMS the timeout of a http request in milliseconds, 0 means disable. envoy default 15s
+	KeyRequestTimeoutMS string = "RequestTimeoutMS"
+	//KeyFaultDelayPercent the percent of the requests delayed by fault injection
+	KeyFaultDelayPercent string = "FaultDelayPercent"
+	//KeyFaultDelayMS the delay of the fault injected requests in milliseconds
+	KeyFaultDelayMS string = "FaultDelayMS"
+	//KeyFaultAbortPercent the percent of the requests aborted by fault injection
+	KeyFaultAbortPercent string = "FaultAbortPercent"
+	//KeyFaultAbortStatus the http status of the fault aborted requests, default 503
+	KeyFaultAbortStatus string = "FaultAbortStatus"
 )
 
+//retryOnConditions the envoy http retry conditions supported by the mesh
+var retryOnConditions = map[string]bool{
+	"5xx":                    true,
+	"gateway-error":          true,
+	"reset":                  true,
+	"connect-failure":        true,
+	"retriable-4xx":          true,
+	"refused-stream":         true,
+	"retriable-status-codes": true,
+}
+
 //RainbondPluginOptions rainbond plugin config struct
 type RainbondPluginOptions struct {
 	Prefix                   string
@@ -157,6 +199,18 @@ type RainbondPluginOptions struct {
 	GrpcHealthServiceName    string
 	HealthCheckTimeout       int64
 	HealthCheckInterval      int64
+	// retry, timeout and fault injection of http routes
+	RetryOn              string
+	RetriableStatusCodes []uint32
+	NumRetries           uint32
+	PerTryTimeoutMS      int64
+	RetryBudgetPercent   float64
+	MinRetryConcurrency  uint32
+	RequestTimeoutMS     *int64
+	FaultDelayPercent    uint32
+	FaultDelayMS         int64
+	FaultAbortPercent    uint32
+	FaultAbortStatus     uint32
 }
 
 //RainbondInboundPluginOptions rainbond inbound plugin options
@@ -197,6 +251,9 @@ func GetOptionValues(sr map[string]interface{}) RainbondPluginOptions {
 		TCPIdleTimeout:        60 * 60 * 2,
 		HealthCheckTimeout:    5,
 		HealthCheckInterval:   4,
+		NumRetries:            2,
+		MinRetryConcurrency:   3,
+		FaultAbortStatus:      503,
 	}
 	if sr == nil {
 		return rpo
@@ -297,11 +354,114 @@ func GetOptionValues(sr map[string]interface{}) RainbondPluginOptions {
 			}
 		case KeyGrpcHealthServiceName:
 			rpo.GrpcHealthServiceName = strings.TrimSpace(v.(string))
+		case KeyRetryOn:
+			rpo.RetryOn = parseRetryOn(v.(string))
+		case KeyRetriableStatusCodes:
+			rpo.RetriableStatusCodes = parseStatusCodes(v.(string))
+		case KeyNumRetries:
+			if i, err := strconv.Atoi(v.(string)); err == nil && i > 0 {
+				rpo.NumRetries = uint32(i)
+			}
+		case KeyPerTryTimeoutMS:
+			if i, err := strconv.Atoi(v.(string)); err == nil && i > 0 {
+				rpo.PerTryTimeoutMS = int64(i)
+			}
+		case KeyRetryBudgetPercent:
+			if f, err := strconv.ParseFloat(v.(string), 64); err == nil && f > 0 {
+				if f > 100 {
+					f = 100
+				}
+				rpo.RetryBudgetPercent = f
+			}
+		case KeyMinRetryConcurrency:
+			if i, err := strconv.Atoi(v.(string)); err == nil && i > 0 {
+				rpo.MinRetryConcurrency = uint32(i)
+			}
+		case KeyRequestTimeoutMS:
+			if i, err := strconv.Atoi(v.(string)); err == nil && i >= 0 {
+				value := int64(i)
+				rpo.RequestTimeoutMS = &value
+			}
+		case KeyFaultDelayPercent:
+			rpo.FaultDelayPercent = parsePercent(v.(string))
+		case KeyFaultDelayMS:
+			if i, err := strconv.Atoi(v.(string)); err == nil && i > 0 {
+				rpo.FaultDelayMS = int64(i)
+			}
+		case KeyFaultAbortPercent:
+			rpo.FaultAbortPercent = parsePercent(v.(string))
+		case KeyFaultAbortStatus:
+			if i, err := strconv.Atoi(v.(string)); err == nil && i >= 200 && i < 600 {
+				rpo.FaultAbortStatus = uint32(i)
+			}
 		}
 	}
+	rpo.RetryOn = checkRetriableStatusCodes(rpo.RetryOn, rpo.RetriableStatusCodes)
 	return rpo
 }
 
+//parseRetryOn keeps the supported retry conditions
+func parseRetryOn(value string) string {
+	var conditions []string
+	for _, condition := range strings.Split(value, ",") {
+		condition = strings.TrimSpace(condition)
+		if condition == "" {
+			continue
+		}
+		if !retryOnConditions[condition] {
+			logrus.Warningf("retry condition %s is not supported, ignore it", condition)
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return strings.Join(conditions, ",")
+}
+
+//parseStatusCodes parses the comma separated http status codes, the invalid ones are ignored
+func parseStatusCodes(value string) []uint32 {
+	var codes []uint32
+	for _, code := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(code))
+		if err != nil || i < 100 || i >= 600 {
+			if strings.TrimSpace(code) != "" {
+				logrus.Warningf("retriable status code %s is invalid, ignore it", code)
+			}
+			continue
+		}
+		codes = append(codes, uint32(i))
+	}
+	return codes
+}
+
+//checkRetriableStatusCodes removes the retriable-status-codes condition if no status code is set,
+//nothing is retried by it in that case
+func checkRetriableStatusCodes(retryOn string, codes []uint32) string {
+	if len(codes) > 0 || retryOn == "" {
+		return retryOn
+	}
+	var conditions []string
+	for _, condition := range strings.Split(retryOn, ",") {
+		if condition == "retriable-status-codes" {
+			logrus.Warningf("retry condition retriable-status-codes is ignored, no %s is set", KeyRetriableStatusCodes)
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return strings.Join(conditions, ",")
+}
+
+//parsePercent parse a percent between 0 and 100
+func parsePercent(value string) uint32 {
+	i, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || i < 0 {
+		return 0
+	}
+	if i > 100 {
+		return 100
+	}
+	return uint32(i)
+}
+
 //GetRainbondInboundPluginOptions get rainbond inbound plugin options
 func GetRainbondInboundPluginOptions(sr map[string]interface{}) (r RainbondInboundPluginOptions) {
 	for k, v := range sr {
@@ -317,6 +477,42 @@ func GetRainbondInboundPluginOptions(sr map[string]interface{}) (r RainbondInbou
 	return
 }
 
+//tracing drivers, the same as the app tracing driver
+const (
+	TracingDriverZipkin = "zipkin"
+	TracingDriverOTLP   = "otlp"
+)
+
+//TracingOptions the tracing of all http connection managers of a component
+type TracingOptions struct {
+	Driver    string
+	Collector *util.TracingCollector
+	//percentage of the requests to be traced
+	Sampling float64
+}
+
+//GetTracingOptions get tracing options from the annotations of plugin config
+//return nil if the tracing is disabled or invalid
+func GetTracingOptions(annotations map[string]string) *TracingOptions {
+	driver := annotations[util.TracingDriverAnnotation]
+	if driver != TracingDriverZipkin && driver != TracingDriverOTLP {
+		return nil
+	}
+	collector, err := util.ParseTracingCollector(annotations[util.TracingCollectorAnnotation])
+	if err != nil {
+		logrus.Warningf("parse tracing collector failure %s", err.Error())
+		return nil
+	}
+	if driver == TracingDriverZipkin && collector.Path == "" {
+		collector.Path = util.DefaultZipkinCollectorPath
+	}
+	sampling, _ := strconv.ParseFloat(annotations[util.TracingSamplingAnnotation], 64)
+	if sampling <= 0 || sampling > 100 {
+		sampling = 100
+	}
+	return &TracingOptions{Driver: driver, Collector: collector, Sampling: sampling}
+}
+
 //ParseLocalityLbEndpointsResource parse envoy xds server response ParseLocalityLbEndpointsResource
 func ParseLocalityLbEndpointsResource(resources []*any.Any) []v2.ClusterLoadAssignment {
 	var endpoints []v2.ClusterLoadAssignment
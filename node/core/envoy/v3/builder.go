@@ -0,0 +1,732 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package v3
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	udp_proxy "github.com/envoyproxy/go-control-plane/envoy/config/filter/udp/udp_proxy/v2alpha"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	configratelimit "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	trace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	http_rate_limit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	http_router "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	http_connection_manager "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tcp_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/goodrain/rainbond/node/core/envoy/v1"
+	envoyv2 "github.com/goodrain/rainbond/node/core/envoy/v2"
+)
+
+// DefaultLocalhostListenerAddress -
+var DefaultLocalhostListenerAddress = envoyv2.DefaultLocalhostListenerAddress
+
+// DefaultLocalhostListenerPort -
+var DefaultLocalhostListenerPort = envoyv2.DefaultLocalhostListenerPort
+
+// DefaultRateLimitServerClusterName default rate limit server cluster name
+var DefaultRateLimitServerClusterName = envoyv2.DefaultRateLimitServerClusterName
+
+// DefaultXDSClusterName the static cluster of the sidecar bootstrap that points to the xds server
+var DefaultXDSClusterName = "rainbond_xds_cluster"
+
+// DefaultTracingCollectorClusterName the cluster of zipkin collector
+var DefaultTracingCollectorClusterName = envoyv2.DefaultTracingCollectorClusterName
+
+// The canonical names of the envoy filters. The names of wellknown in the go-control-plane
+// of the v2 api are deprecated, and envoy since v1.17 rejects them.
+const (
+	HTTPConnectionManagerName = "envoy.filters.network.http_connection_manager"
+	TCPProxyName              = "envoy.filters.network.tcp_proxy"
+	RouterName                = "envoy.filters.http.router"
+	FaultName                 = "envoy.filters.http.fault"
+	HTTPRateLimitName         = "envoy.filters.http.ratelimit"
+)
+
+// RateLimitOptions rate limit options
+type RateLimitOptions = envoyv2.RateLimitOptions
+
+// TracingOptions tracing options
+type TracingOptions = envoyv2.TracingOptions
+
+// CreateTCPListener listener builder
+func CreateTCPListener(name, clusterName, address, statPrefix string, port uint32, idleTimeout int64) *listener.Listener {
+	if address == "" {
+		address = DefaultLocalhostListenerAddress
+	}
+	tcpProxy := &tcp_proxy.TcpProxy{
+		StatPrefix: statPrefix,
+		ClusterSpecifier: &tcp_proxy.TcpProxy_Cluster{
+			Cluster: clusterName,
+		},
+		IdleTimeout: envoyv2.ConverTimeDuration(idleTimeout),
+	}
+	if err := tcpProxy.Validate(); err != nil {
+		logrus.Errorf("validate listener tcp proxy config failure %s", err.Error())
+		return nil
+	}
+	l := &listener.Listener{
+		Name:    name,
+		Address: CreateSocketAddress("tcp", address, port),
+		FilterChains: []*listener.FilterChain{
+			{
+				Filters: []*listener.Filter{
+					{
+						Name:       TCPProxyName,
+						ConfigType: &listener.Filter_TypedConfig{TypedConfig: envoyv2.Message2Any(tcpProxy)},
+					},
+				},
+			},
+		},
+	}
+	if err := l.Validate(); err != nil {
+		logrus.Errorf("validate listener config failure %s", err.Error())
+		return nil
+	}
+	return l
+}
+
+// CreateUDPListener create udp listenner
+// udp proxy has no v3 config in this control plane version, envoy still accepts the v2alpha typed config.
+func CreateUDPListener(name, clusterName, address, statPrefix string, port uint32) *listener.Listener {
+	if address == "" {
+		address = DefaultLocalhostListenerAddress
+	}
+	config := &udp_proxy.UdpProxyConfig{
+		StatPrefix: statPrefix,
+		RouteSpecifier: &udp_proxy.UdpProxyConfig_Cluster{
+			Cluster: clusterName,
+		},
+	}
+	if err := config.Validate(); err != nil {
+		logrus.Errorf("validate listener udp config failure %s", err.Error())
+		return nil
+	}
+	l := &listener.Listener{
+		Name:    name,
+		Address: CreateSocketAddress("udp", address, port),
+		ListenerFilters: []*listener.ListenerFilter{
+			{
+				Name: "envoy.filters.udp_listener.udp_proxy",
+				ConfigType: &listener.ListenerFilter_TypedConfig{
+					TypedConfig: envoyv2.Message2Any(config),
+				},
+			},
+		},
+		// Listening on UDP without SO_REUSEPORT socket option may result to unstable packet proxying.
+		ReusePort: true,
+	}
+	if err := l.Validate(); err != nil {
+		logrus.Errorf("validate listener config failure %s", err.Error())
+		return nil
+	}
+	return l
+}
+
+// CreateHTTPRateLimit create http rate limit
+func CreateHTTPRateLimit(option RateLimitOptions) *http_rate_limit.RateLimit {
+	httpRateLimit := &http_rate_limit.RateLimit{
+		Domain: option.Domain,
+		Stage:  option.Stage,
+		RateLimitService: &configratelimit.RateLimitServiceConfig{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{
+						ClusterName: option.RateServerClusterName,
+					},
+				},
+			},
+		},
+	}
+	if err := httpRateLimit.Validate(); err != nil {
+		logrus.Errorf("create http rate limit failure %s", err.Error())
+		return nil
+	}
+	return httpRateLimit
+}
+
+// CreateHTTPConnectionManager create http connection manager
+func CreateHTTPConnectionManager(name, statPrefix string, rateOpt *RateLimitOptions, tracing *TracingOptions, routes ...*route.VirtualHost) *http_connection_manager.HttpConnectionManager {
+	var httpFilters []*http_connection_manager.HttpFilter
+	if rateOpt != nil && rateOpt.Enable {
+		rateLimit := CreateHTTPRateLimit(*rateOpt)
+		if rateLimit == nil {
+			return nil
+		}
+		httpFilters = append(httpFilters, &http_connection_manager.HttpFilter{
+			Name: HTTPRateLimitName,
+			ConfigType: &http_connection_manager.HttpFilter_TypedConfig{
+				TypedConfig: envoyv2.Message2Any(rateLimit),
+			},
+		})
+	}
+	if hasRouteFault(routes) {
+		// the fault of every route is set by its typed per filter config
+		httpFilters = append(httpFilters, &http_connection_manager.HttpFilter{
+			Name:       FaultName,
+			ConfigType: &http_connection_manager.HttpFilter_TypedConfig{TypedConfig: envoyv2.Message2Any(&http_fault.HTTPFault{})},
+		})
+	}
+	httpFilters = append(httpFilters, &http_connection_manager.HttpFilter{
+		Name:       RouterName,
+		ConfigType: &http_connection_manager.HttpFilter_TypedConfig{TypedConfig: envoyv2.Message2Any(&http_router.Router{})},
+	})
+	hcm := &http_connection_manager.HttpConnectionManager{
+		StatPrefix: statPrefix,
+		RouteSpecifier: &http_connection_manager.HttpConnectionManager_RouteConfig{
+			RouteConfig: &route.RouteConfiguration{
+				Name:         name,
+				VirtualHosts: routes,
+			},
+		},
+		HttpFilters: httpFilters,
+	}
+	if tracing != nil {
+		hcm.Tracing = CreateTracing(*tracing)
+	}
+	if err := hcm.Validate(); err != nil {
+		logrus.Errorf("validate http connertion manager config failure %s", err.Error())
+		return nil
+	}
+	return hcm
+}
+
+// CreateHTTPListener create http manager listener
+func CreateHTTPListener(name, address, statPrefix string, port uint32, rateOpt *RateLimitOptions, tracing *TracingOptions, routes ...*route.VirtualHost) *listener.Listener {
+	hcm := CreateHTTPConnectionManager(name, statPrefix, rateOpt, tracing, routes...)
+	if hcm == nil {
+		logrus.Warningf("create http connection manager failure %s", name)
+		return nil
+	}
+	l := &listener.Listener{
+		Name:    name,
+		Address: CreateSocketAddress("tcp", address, port),
+		FilterChains: []*listener.FilterChain{
+			{
+				Filters: []*listener.Filter{
+					{
+						Name:       HTTPConnectionManagerName,
+						ConfigType: &listener.Filter_TypedConfig{TypedConfig: envoyv2.Message2Any(hcm)},
+					},
+				},
+			},
+		},
+	}
+	if err := l.Validate(); err != nil {
+		logrus.Errorf("validate listener config failure %s", err.Error())
+		return nil
+	}
+	return l
+}
+
+// OpenTelemetryTracerName the opentelemetry tracer of envoy, which is supported since envoy v1.23
+const OpenTelemetryTracerName = "envoy.tracers.opentelemetry"
+
+// createOpenTelemetryConfig creates the config of the opentelemetry tracer exporting the spans to the grpc service.
+// The go-control-plane of the v2 api has no OpenTelemetryConfig, so the config is encoded by its field grpc_service(1).
+func createOpenTelemetryConfig(service *core.GrpcService) *any.Any {
+	value, err := proto.Marshal(service)
+	if err != nil {
+		logrus.Error(err.Error())
+		return &any.Any{}
+	}
+	config := protowire.AppendTag(nil, 1, protowire.BytesType)
+	config = protowire.AppendBytes(config, value)
+	return &any.Any{TypeUrl: "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig", Value: config}
+}
+
+// CreateTracing create the tracing of http connection manager
+// otlp driver reports the spans by the opentelemetry tracer of envoy to the otlp grpc receiver of the collector
+func CreateTracing(options TracingOptions) *http_connection_manager.HttpConnectionManager_Tracing {
+	tracing := &http_connection_manager.HttpConnectionManager_Tracing{
+		RandomSampling: &_type.Percent{Value: options.Sampling},
+	}
+	switch options.Driver {
+	case envoyv2.TracingDriverZipkin:
+		tracing.Provider = &trace.Tracing_Http{
+			Name: envoyv2.ZipkinTracerName,
+			ConfigType: &trace.Tracing_Http_TypedConfig{TypedConfig: envoyv2.Message2Any(&trace.ZipkinConfig{
+				CollectorCluster:         DefaultTracingCollectorClusterName,
+				CollectorEndpoint:        options.Collector.Path,
+				CollectorEndpointVersion: trace.ZipkinConfig_HTTP_JSON,
+				TraceId_128Bit:           true,
+			})},
+		}
+	case envoyv2.TracingDriverOTLP:
+		tracing.Provider = &trace.Tracing_Http{
+			Name: OpenTelemetryTracerName,
+			ConfigType: &trace.Tracing_Http_TypedConfig{TypedConfig: createOpenTelemetryConfig(&core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: DefaultTracingCollectorClusterName},
+				},
+			})},
+		}
+	}
+	return tracing
+}
+
+// CreateTracingCollectorCluster create the cluster of the collector, zipkin by http and otlp by grpc
+func CreateTracingCollectorCluster(options TracingOptions) *cluster.Cluster {
+	var protocol string
+	if options.Driver == envoyv2.TracingDriverOTLP {
+		protocol = "grpc"
+	}
+	address := CreateSocketAddress("tcp", options.Collector.Host, options.Collector.Port)
+	return CreateCluster(ClusterOptions{
+		Name:              DefaultTracingCollectorClusterName,
+		ClusterType:       cluster.Cluster_STRICT_DNS,
+		ConnectionTimeout: envoyv2.ConverTimeDuration(1),
+		LoadAssignment:    CreateStaticLoadAssignment(DefaultTracingCollectorClusterName, address),
+		Protocol:          protocol,
+	})
+}
+
+// CreateSocketAddress create socket address
+func CreateSocketAddress(protocol, address string, port uint32) *core.Address {
+	if strings.HasPrefix(address, "https://") {
+		address = strings.Split(address, "https://")[1]
+	}
+	if strings.HasPrefix(address, "http://") {
+		address = strings.Split(address, "http://")[1]
+	}
+	socketProtocol := core.SocketAddress_TCP
+	if protocol == "udp" {
+		socketProtocol = core.SocketAddress_UDP
+	}
+	return &core.Address{
+		Address: &core.Address_SocketAddress{
+			SocketAddress: &core.SocketAddress{
+				Protocol: socketProtocol,
+				Address:  address,
+				PortSpecifier: &core.SocketAddress_PortValue{
+					PortValue: port,
+				},
+			},
+		},
+	}
+}
+
+// CreateCircuitBreaker create down cluster circuitbreaker
+func CreateCircuitBreaker(options envoyv2.RainbondPluginOptions) *cluster.CircuitBreakers {
+	circuitBreakers := &cluster.CircuitBreakers{
+		Thresholds: []*cluster.CircuitBreakers_Thresholds{
+			{
+				Priority:           core.RoutingPriority_DEFAULT,
+				MaxConnections:     envoyv2.ConversionUInt32(uint32(options.MaxConnections)),
+				MaxRequests:        envoyv2.ConversionUInt32(uint32(options.MaxRequests)),
+				MaxRetries:         envoyv2.ConversionUInt32(uint32(options.MaxActiveRetries)),
+				MaxPendingRequests: envoyv2.ConversionUInt32(uint32(options.MaxPendingRequests)),
+			},
+		},
+	}
+	if options.RetryBudgetPercent > 0 {
+		circuitBreakers.Thresholds[0].RetryBudget = &cluster.CircuitBreakers_Thresholds_RetryBudget{
+			BudgetPercent:       &_type.Percent{Value: options.RetryBudgetPercent},
+			MinRetryConcurrency: envoyv2.ConversionUInt32(options.MinRetryConcurrency),
+		}
+	}
+	if err := circuitBreakers.Validate(); err != nil {
+		logrus.Errorf("validate envoy config circuitBreakers failure %s", err.Error())
+		return nil
+	}
+	return circuitBreakers
+}
+
+// CreatOutlierDetection create up cluster OutlierDetection
+func CreatOutlierDetection(options envoyv2.RainbondPluginOptions) *cluster.OutlierDetection {
+	outlierDetection := &cluster.OutlierDetection{
+		Interval:           envoyv2.ConverTimeDuration(options.Interval),
+		BaseEjectionTime:   envoyv2.ConverTimeDuration(options.BaseEjectionTimeMS / 1000),
+		MaxEjectionPercent: envoyv2.ConversionUInt32(uint32(options.MaxEjectionPercent)),
+		Consecutive_5Xx:    envoyv2.ConversionUInt32(uint32(options.ConsecutiveErrors)),
+	}
+	if err := outlierDetection.Validate(); err != nil {
+		logrus.Errorf("validate envoy config outlierDetection failure %s", err.Error())
+		return nil
+	}
+	return outlierDetection
+}
+
+// CreateRetryPolicy create http route retry policy
+// return nil if no retry condition is set
+func CreateRetryPolicy(options envoyv2.RainbondPluginOptions) *route.RetryPolicy {
+	if options.RetryOn == "" {
+		return nil
+	}
+	retryPolicy := &route.RetryPolicy{
+		RetryOn:              options.RetryOn,
+		NumRetries:           envoyv2.ConversionUInt32(options.NumRetries),
+		RetriableStatusCodes: options.RetriableStatusCodes,
+	}
+	if options.PerTryTimeoutMS > 0 {
+		retryPolicy.PerTryTimeout = envoyv2.ConverTimeDurationMS(options.PerTryTimeoutMS)
+	}
+	if err := retryPolicy.Validate(); err != nil {
+		logrus.Errorf("validate route retry policy failure %s", err.Error())
+		return nil
+	}
+	return retryPolicy
+}
+
+// CreateHTTPFault create http fault injection config
+// return nil if neither delay nor abort is set
+func CreateHTTPFault(options envoyv2.RainbondPluginOptions) *http_fault.HTTPFault {
+	httpFault := &http_fault.HTTPFault{}
+	if options.FaultDelayPercent > 0 && options.FaultDelayMS > 0 {
+		httpFault.Delay = &fault.FaultDelay{
+			FaultDelaySecifier: &fault.FaultDelay_FixedDelay{
+				FixedDelay: envoyv2.ConverTimeDurationMS(options.FaultDelayMS),
+			},
+			Percentage: &_type.FractionalPercent{
+				Numerator:   options.FaultDelayPercent,
+				Denominator: _type.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+	if options.FaultAbortPercent > 0 {
+		httpFault.Abort = &http_fault.FaultAbort{
+			ErrorType: &http_fault.FaultAbort_HttpStatus{
+				HttpStatus: options.FaultAbortStatus,
+			},
+			Percentage: &_type.FractionalPercent{
+				Numerator:   options.FaultAbortPercent,
+				Denominator: _type.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+	if httpFault.Delay == nil && httpFault.Abort == nil {
+		return nil
+	}
+	if err := httpFault.Validate(); err != nil {
+		logrus.Errorf("validate http fault config failure %s", err.Error())
+		return nil
+	}
+	return httpFault
+}
+
+// SetRouteOptions set the timeout, retry policy and fault injection of a http route
+func SetRouteOptions(rout *route.Route, options envoyv2.RainbondPluginOptions) {
+	if rout == nil {
+		return
+	}
+	if action, ok := rout.Action.(*route.Route_Route); ok {
+		if options.RequestTimeoutMS != nil {
+			action.Route.Timeout = envoyv2.ConverTimeDurationMS(*options.RequestTimeoutMS)
+		}
+		action.Route.RetryPolicy = CreateRetryPolicy(options)
+	}
+	if httpFault := CreateHTTPFault(options); httpFault != nil {
+		rout.TypedPerFilterConfig = map[string]*any.Any{
+			FaultName: envoyv2.Message2Any(httpFault),
+		}
+	}
+}
+
+func hasRouteFault(virtualHosts []*route.VirtualHost) bool {
+	for _, vh := range virtualHosts {
+		for _, rout := range vh.GetRoutes() {
+			if _, ok := rout.GetTypedPerFilterConfig()[FaultName]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CreateRouteVirtualHost create route virtual host
+func CreateRouteVirtualHost(name string, domains []string, rateLimits []*route.RateLimit, routes ...*route.Route) *route.VirtualHost {
+	pvh := &route.VirtualHost{
+		Name:       name,
+		Domains:    domains,
+		Routes:     routes,
+		RateLimits: rateLimits,
+	}
+	if err := pvh.Validate(); err != nil {
+		logrus.Errorf("route virtualhost config validate failure %s domains %s", err.Error(), domains)
+		return nil
+	}
+	return pvh
+}
+
+// CreateRouteWithHostRewrite create route with hostRewrite
+func CreateRouteWithHostRewrite(host, clusterName, prefix string, headers []*route.HeaderMatcher, weight uint32) *route.Route {
+	if host == "" {
+		return nil
+	}
+	if strings.HasPrefix(host, "https://") {
+		host = strings.Split(host, "https://")[1]
+	}
+	if strings.HasPrefix(host, "http://") {
+		host = strings.Split(host, "http://")[1]
+	}
+	rout := &route.Route{
+		Match: &route.RouteMatch{
+			PathSpecifier: &route.RouteMatch_Prefix{
+				Prefix: prefix,
+			},
+			Headers: headers,
+		},
+		Action: &route.Route_Route{
+			Route: &route.RouteAction{
+				ClusterSpecifier: &route.RouteAction_Cluster{
+					Cluster: clusterName,
+				},
+				Priority: core.RoutingPriority_DEFAULT,
+				HostRewriteSpecifier: &route.RouteAction_HostRewriteLiteral{
+					HostRewriteLiteral: host,
+				},
+			},
+		},
+	}
+	if err := rout.Validate(); err != nil {
+		logrus.Errorf("route http route config validate failure %s", err.Error())
+		return nil
+	}
+	return rout
+}
+
+// CreateRoute create http route
+func CreateRoute(clusterName, prefix string, headers []*route.HeaderMatcher, weight uint32) *route.Route {
+	rout := &route.Route{
+		Match: &route.RouteMatch{
+			PathSpecifier: &route.RouteMatch_Prefix{
+				Prefix: prefix,
+			},
+			Headers: headers,
+		},
+		Action: &route.Route_Route{
+			Route: &route.RouteAction{
+				ClusterSpecifier: &route.RouteAction_WeightedClusters{
+					WeightedClusters: &route.WeightedCluster{
+						Clusters: []*route.WeightedCluster_ClusterWeight{
+							{
+								Name:   clusterName,
+								Weight: envoyv2.ConversionUInt32(weight),
+							},
+						},
+					},
+				},
+				Priority: core.RoutingPriority_DEFAULT,
+			},
+		},
+	}
+	if err := rout.Validate(); err != nil {
+		logrus.Errorf("route http route config validate failure %s", err.Error())
+		return nil
+	}
+	return rout
+}
+
+// CreateHeaderMatcher create http route config header matcher
+func CreateHeaderMatcher(header v1.Header) *route.HeaderMatcher {
+	if header.Name == "" {
+		return nil
+	}
+	headerMatcher := &route.HeaderMatcher{
+		Name: header.Name,
+		HeaderMatchSpecifier: &route.HeaderMatcher_PrefixMatch{
+			PrefixMatch: header.Value,
+		},
+	}
+	if err := headerMatcher.Validate(); err != nil {
+		logrus.Errorf("route http header(%s) matcher config validate failure %s", header.Name, err.Error())
+		return nil
+	}
+	return headerMatcher
+}
+
+// CreateEDSClusterConfig create grpc eds cluster config
+// the endpoints are requested by the v3 transport and resource api
+func CreateEDSClusterConfig(serviceName string) *cluster.Cluster_EdsClusterConfig {
+	edsClusterConfig := &cluster.Cluster_EdsClusterConfig{
+		EdsConfig: &core.ConfigSource{
+			ResourceApiVersion: core.ApiVersion_V3,
+			ConfigSourceSpecifier: &core.ConfigSource_ApiConfigSource{
+				ApiConfigSource: &core.ApiConfigSource{
+					ApiType:             core.ApiConfigSource_GRPC,
+					TransportApiVersion: core.ApiVersion_V3,
+					GrpcServices: []*core.GrpcService{
+						{
+							TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+								EnvoyGrpc: &core.GrpcService_EnvoyGrpc{
+									ClusterName: DefaultXDSClusterName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ServiceName: serviceName,
+	}
+	if err := edsClusterConfig.Validate(); err != nil {
+		logrus.Errorf("validate eds cluster config failure %s", err.Error())
+		return nil
+	}
+	return edsClusterConfig
+}
+
+// ClusterOptions cluster options
+type ClusterOptions struct {
+	Name                     string
+	ServiceName              string
+	ConnectionTimeout        *duration.Duration
+	ClusterType              cluster.Cluster_DiscoveryType
+	MaxRequestsPerConnection *uint32
+	OutlierDetection         *cluster.OutlierDetection
+	CircuitBreakers          *cluster.CircuitBreakers
+	HealthyPanicThreshold    int64
+	TransportSocket          *core.TransportSocket
+	LoadAssignment           *endpoint.ClusterLoadAssignment
+	Protocol                 string
+	// grpc service name of health check
+	GrpcHealthServiceName string
+	//health check
+	HealthTimeout  int64
+	HealthInterval int64
+}
+
+// CreateCluster create cluster config
+func CreateCluster(options ClusterOptions) *cluster.Cluster {
+	var edsClusterConfig *cluster.Cluster_EdsClusterConfig
+	if options.ClusterType == cluster.Cluster_EDS {
+		edsClusterConfig = CreateEDSClusterConfig(options.ServiceName)
+		if edsClusterConfig == nil {
+			logrus.Errorf("create eds cluster config failure")
+			return nil
+		}
+	}
+	c := &cluster.Cluster{
+		Name:                 options.Name,
+		ClusterDiscoveryType: &cluster.Cluster_Type{Type: options.ClusterType},
+		ConnectTimeout:       options.ConnectionTimeout,
+		LbPolicy:             cluster.Cluster_ROUND_ROBIN,
+		EdsClusterConfig:     edsClusterConfig,
+		LoadAssignment:       options.LoadAssignment,
+		OutlierDetection:     options.OutlierDetection,
+		CircuitBreakers:      options.CircuitBreakers,
+		TransportSocket:      options.TransportSocket,
+		CommonLbConfig: &cluster.Cluster_CommonLbConfig{
+			HealthyPanicThreshold: &_type.Percent{Value: float64(options.HealthyPanicThreshold) / 100},
+		},
+	}
+	if options.Protocol == "http2" || options.Protocol == "grpc" {
+		c.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+		// set grpc health check
+		if options.Protocol == "grpc" && options.GrpcHealthServiceName != "" {
+			c.HealthChecks = append(c.HealthChecks, &core.HealthCheck{
+				Timeout:            envoyv2.ConverTimeDuration(options.HealthTimeout),
+				Interval:           envoyv2.ConverTimeDuration(options.HealthInterval),
+				UnhealthyThreshold: envoyv2.ConversionUInt32(2),
+				HealthyThreshold:   envoyv2.ConversionUInt32(1),
+				HealthChecker: &core.HealthCheck_GrpcHealthCheck_{
+					GrpcHealthCheck: &core.HealthCheck_GrpcHealthCheck{
+						ServiceName: options.GrpcHealthServiceName,
+					},
+				}})
+		}
+	}
+	if options.MaxRequestsPerConnection != nil {
+		c.MaxRequestsPerConnection = envoyv2.ConversionUInt32(*options.MaxRequestsPerConnection)
+	}
+	if err := c.Validate(); err != nil {
+		logrus.Errorf("validate cluster config failure %s", err.Error())
+		return nil
+	}
+	return c
+}
+
+// CreateStaticLoadAssignment create the load assignment of a static cluster
+// v3 clusters no longer support hosts, the addresses must be set as endpoints
+func CreateStaticLoadAssignment(clusterName string, addresses ...*core.Address) *endpoint.ClusterLoadAssignment {
+	var lbe []*endpoint.LbEndpoint
+	for _, address := range addresses {
+		lbe = append(lbe, &endpoint.LbEndpoint{
+			HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+				Endpoint: &endpoint.Endpoint{
+					Address: address,
+				},
+			},
+		})
+	}
+	return &endpoint.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints:   []*endpoint.LocalityLbEndpoints{{LbEndpoints: lbe}},
+	}
+}
+
+// CreateDNSLoadAssignment create dns loadAssignment
+func CreateDNSLoadAssignment(serviceAlias, namespace, domain string, service *corev1.Service) *endpoint.ClusterLoadAssignment {
+	destServiceAlias := envoyv2.GetServiceAliasByService(service)
+	if destServiceAlias == "" {
+		logrus.Errorf("service alias is empty in k8s service %s", service.Name)
+		return nil
+	}
+	clusterName := fmt.Sprintf("%s_%s_%s_%d", namespace, serviceAlias, destServiceAlias, service.Spec.Ports[0].Port)
+	protocol := service.Labels["port_protocol"]
+	port := service.Spec.Ports[0].Port
+	lbe := []*endpoint.LbEndpoint{
+		{
+			HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+				Endpoint: &endpoint.Endpoint{
+					Address:           CreateSocketAddress(protocol, domain, uint32(port)),
+					HealthCheckConfig: &endpoint.Endpoint_HealthCheckConfig{PortValue: uint32(port)},
+				},
+			},
+		},
+	}
+	cla := &endpoint.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints:   []*endpoint.LocalityLbEndpoints{{LbEndpoints: lbe}},
+	}
+	if err := cla.Validate(); err != nil {
+		logrus.Errorf("endpoints discover validate failure %s", err.Error())
+	}
+	return cla
+}
+
+// CheckWeightSum check all cluster weight sum
+func CheckWeightSum(clusters []*route.WeightedCluster_ClusterWeight, weight uint32) uint32 {
+	var sum uint32
+	for _, cluster := range clusters {
+		sum += cluster.Weight.GetValue()
+	}
+	if sum >= 100 {
+		return 0
+	}
+	if (sum + weight) > 100 {
+		return 100 - sum
+	}
+	return weight
+}
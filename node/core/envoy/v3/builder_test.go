@@ -0,0 +1,249 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package v3
+
+import (
+	"reflect"
+	"testing"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	http_connection_manager "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	envoyv2 "github.com/goodrain/rainbond/node/core/envoy/v2"
+	"github.com/goodrain/rainbond/util"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestCreateStaticCluster(t *testing.T) {
+	options := envoyv2.GetOptionValues(nil)
+	c := CreateCluster(ClusterOptions{
+		Name:              "ns_alias_5000",
+		ClusterType:       cluster.Cluster_STATIC,
+		ConnectionTimeout: envoyv2.ConverTimeDuration(options.ConnectionTimeout),
+		CircuitBreakers:   CreateCircuitBreaker(options),
+		OutlierDetection:  CreatOutlierDetection(options),
+		LoadAssignment:    CreateStaticLoadAssignment("ns_alias_5000", CreateSocketAddress("tcp", "127.0.0.1", 5000)),
+	})
+	if c == nil {
+		t.Fatal("create static cluster failure")
+	}
+	if got := c.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress().GetPortValue(); got != 5000 {
+		t.Errorf("expected endpoint port 5000, got %d", got)
+	}
+}
+
+func TestCreateEDSCluster(t *testing.T) {
+	c := CreateCluster(ClusterOptions{
+		Name:              "ns_alias_dep_5000",
+		ServiceName:       "ns_alias_dep_5000",
+		ClusterType:       cluster.Cluster_EDS,
+		ConnectionTimeout: envoyv2.ConverTimeDuration(1),
+	})
+	if c == nil {
+		t.Fatal("create eds cluster failure")
+	}
+	source := c.GetEdsClusterConfig().GetEdsConfig()
+	if source.GetResourceApiVersion() != core.ApiVersion_V3 || source.GetApiConfigSource().GetTransportApiVersion() != core.ApiVersion_V3 {
+		t.Errorf("expected eds config requested by v3 api, got %v", source)
+	}
+}
+
+func TestCreateHTTPListenerWithRateLimit(t *testing.T) {
+	route := CreateRoute("ns_alias_5000", "/", nil, 100)
+	vh := CreateRouteVirtualHost("ns_alias_5000", []string{"*"}, nil, route)
+	l := CreateHTTPListener("ns_alias_5000", "0.0.0.0", "alias_5000", 5000, &RateLimitOptions{
+		Enable:                true,
+		Domain:                "limit",
+		RateServerClusterName: DefaultRateLimitServerClusterName,
+	}, nil, vh)
+	if l == nil {
+		t.Fatal("create http listener failure")
+	}
+}
+
+func TestFilterNames(t *testing.T) {
+	const typePrefix = "type.googleapis.com/"
+	rout := CreateRoute("ns_alias_5000", "/", nil, 100)
+	SetRouteOptions(rout, envoyv2.GetOptionValues(map[string]interface{}{envoyv2.KeyFaultAbortPercent: "10"}))
+	vh := CreateRouteVirtualHost("ns_alias_5000", []string{"*"}, nil, rout)
+	l := CreateHTTPListener("ns_alias_5000", "0.0.0.0", "alias_5000", 5000, &RateLimitOptions{
+		Enable:                true,
+		Domain:                "limit",
+		RateServerClusterName: DefaultRateLimitServerClusterName,
+	}, nil, vh)
+	if l == nil {
+		t.Fatal("create http listener failure")
+	}
+	filter := l.GetFilterChains()[0].GetFilters()[0]
+	if filter.GetName() != "envoy.filters.network.http_connection_manager" ||
+		filter.GetTypedConfig().GetTypeUrl() != typePrefix+"envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager" {
+		t.Fatalf("unexpected network filter %s of %s", filter.GetName(), filter.GetTypedConfig().GetTypeUrl())
+	}
+	var hcm http_connection_manager.HttpConnectionManager
+	if err := ptypes.UnmarshalAny(filter.GetTypedConfig(), &hcm); err != nil {
+		t.Fatal(err)
+	}
+	want := [][2]string{
+		{"envoy.filters.http.ratelimit", "envoy.extensions.filters.http.ratelimit.v3.RateLimit"},
+		{"envoy.filters.http.fault", "envoy.extensions.filters.http.fault.v3.HTTPFault"},
+		{"envoy.filters.http.router", "envoy.extensions.filters.http.router.v3.Router"},
+	}
+	if len(hcm.GetHttpFilters()) != len(want) {
+		t.Fatalf("expected %d http filters, got %v", len(want), hcm.GetHttpFilters())
+	}
+	for i, httpFilter := range hcm.GetHttpFilters() {
+		if httpFilter.GetName() != want[i][0] || httpFilter.GetTypedConfig().GetTypeUrl() != typePrefix+want[i][1] {
+			t.Errorf("expected http filter %s of %s, got %s of %s", want[i][0], want[i][1], httpFilter.GetName(), httpFilter.GetTypedConfig().GetTypeUrl())
+		}
+	}
+	tcp := CreateTCPListener("ns_alias_3306", "ns_alias_3306", "0.0.0.0", "alias_3306", 3306, 60)
+	if tcp == nil {
+		t.Fatal("create tcp listener failure")
+	}
+	filter = tcp.GetFilterChains()[0].GetFilters()[0]
+	if filter.GetName() != "envoy.filters.network.tcp_proxy" ||
+		filter.GetTypedConfig().GetTypeUrl() != typePrefix+"envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy" {
+		t.Errorf("unexpected network filter %s of %s", filter.GetName(), filter.GetTypedConfig().GetTypeUrl())
+	}
+}
+
+func TestSetRouteOptions(t *testing.T) {
+	options := envoyv2.GetOptionValues(map[string]interface{}{
+		envoyv2.KeyRetryOn:           "5xx, connect-failure,unknown",
+		envoyv2.KeyPerTryTimeoutMS:   "500",
+		envoyv2.KeyRequestTimeoutMS:  "3000",
+		envoyv2.KeyFaultDelayPercent: "10",
+		envoyv2.KeyFaultDelayMS:      "200",
+		envoyv2.KeyFaultAbortPercent: "120",
+	})
+	rout := CreateRoute("ns_alias_dep_80", "/", nil, 100)
+	SetRouteOptions(rout, options)
+	action := rout.GetRoute()
+	if got := action.GetRetryPolicy().GetRetryOn(); got != "5xx,connect-failure" {
+		t.Errorf("expected retry on 5xx,connect-failure, got %s", got)
+	}
+	if got := action.GetRetryPolicy().GetNumRetries().GetValue(); got != 2 {
+		t.Errorf("expected default 2 retries, got %d", got)
+	}
+	if got := action.GetTimeout().GetSeconds(); got != 3 {
+		t.Errorf("expected 3s request timeout, got %d", got)
+	}
+	if _, ok := rout.GetTypedPerFilterConfig()["envoy.filters.http.fault"]; !ok {
+		t.Fatal("expected fault per filter config")
+	}
+	fault := CreateHTTPFault(options)
+	if fault.GetAbort().GetPercentage().GetNumerator() != 100 || fault.GetAbort().GetHttpStatus() != 503 {
+		t.Errorf("unexpected fault abort %v", fault.GetAbort())
+	}
+	vh := CreateRouteVirtualHost("ns_alias_dep_80", []string{"*"}, nil, rout)
+	hcm := CreateHTTPConnectionManager("ns_alias_http_80", "alias_80", nil, nil, vh)
+	if hcm == nil || len(hcm.GetHttpFilters()) != 2 || hcm.GetHttpFilters()[0].GetName() != "envoy.filters.http.fault" {
+		t.Errorf("expected fault filter before router, got %v", hcm.GetHttpFilters())
+	}
+}
+
+func TestRetriableStatusCodes(t *testing.T) {
+	options := envoyv2.GetOptionValues(map[string]interface{}{
+		envoyv2.KeyRetryOn:              "connect-failure,retriable-status-codes",
+		envoyv2.KeyRetriableStatusCodes: "503, 504,abc,700",
+	})
+	policy := CreateRetryPolicy(options)
+	if got := policy.GetRetryOn(); got != "connect-failure,retriable-status-codes" {
+		t.Errorf("expected retry on connect-failure,retriable-status-codes, got %s", got)
+	}
+	if got := policy.GetRetriableStatusCodes(); !reflect.DeepEqual(got, []uint32{503, 504}) {
+		t.Errorf("expected retriable status codes [503 504], got %v", got)
+	}
+	if got := envoyv2.CreateRetryPolicy(options).GetRetriableStatusCodes(); !reflect.DeepEqual(got, []uint32{503, 504}) {
+		t.Errorf("expected retriable status codes [503 504] of v2, got %v", got)
+	}
+	// the condition retries nothing without the status codes
+	options = envoyv2.GetOptionValues(map[string]interface{}{
+		envoyv2.KeyRetryOn: "retriable-status-codes",
+	})
+	if policy := CreateRetryPolicy(options); policy != nil {
+		t.Errorf("expected no retry policy without the status codes, got %v", policy)
+	}
+}
+
+func TestSetRouteOptionsDisabled(t *testing.T) {
+	rout := CreateRoute("ns_alias_dep_80", "/", nil, 100)
+	SetRouteOptions(rout, envoyv2.GetOptionValues(nil))
+	if rout.GetRoute().GetRetryPolicy() != nil || rout.GetRoute().GetTimeout() != nil || len(rout.GetTypedPerFilterConfig()) != 0 {
+		t.Errorf("expected no retry, timeout or fault by default, got %v", rout)
+	}
+}
+
+func TestCreateHTTPListenerWithTracing(t *testing.T) {
+	tracing := envoyv2.GetTracingOptions(map[string]string{
+		util.TracingDriverAnnotation:    envoyv2.TracingDriverZipkin,
+		util.TracingCollectorAnnotation: "zipkin.default:9411",
+		util.TracingSamplingAnnotation:  "10",
+	})
+	if tracing == nil || tracing.Collector.Path != util.DefaultZipkinCollectorPath || tracing.Sampling != 10 {
+		t.Fatalf("unexpected tracing options %+v", tracing)
+	}
+	vh := CreateRouteVirtualHost("ns_alias_dep_80", []string{"*"}, nil, CreateRoute("ns_alias_dep_80", "/", nil, 100))
+	hcm := CreateHTTPConnectionManager("ns_alias_http_80", "alias_80", nil, tracing, vh)
+	if hcm == nil {
+		t.Fatal("create http connection manager failure")
+	}
+	if hcm.GetTracing().GetProvider().GetName() != envoyv2.ZipkinTracerName || hcm.GetTracing().GetRandomSampling().GetValue() != 10 {
+		t.Errorf("unexpected tracing %v", hcm.GetTracing())
+	}
+	c := CreateTracingCollectorCluster(*tracing)
+	if c == nil || c.GetName() != DefaultTracingCollectorClusterName {
+		t.Errorf("unexpected tracing collector cluster %v", c)
+	}
+
+	tracing = envoyv2.GetTracingOptions(map[string]string{
+		util.TracingDriverAnnotation:    envoyv2.TracingDriverOTLP,
+		util.TracingCollectorAnnotation: "otel-collector:4317",
+	})
+	if tracing == nil || tracing.Sampling != 100 {
+		t.Fatalf("unexpected tracing options %+v", tracing)
+	}
+	provider := CreateTracing(*tracing).GetProvider()
+	if provider.GetName() != OpenTelemetryTracerName {
+		t.Error("expected opentelemetry tracer for otlp driver")
+	}
+	config := provider.GetTypedConfig()
+	if config.GetTypeUrl() != "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig" {
+		t.Errorf("unexpected opentelemetry config type %s", config.GetTypeUrl())
+	}
+	num, typ, n := protowire.ConsumeTag(config.GetValue())
+	value, _ := protowire.ConsumeBytes(config.GetValue()[n:])
+	var service core.GrpcService
+	if num != 1 || typ != protowire.BytesType || proto.Unmarshal(value, &service) != nil ||
+		service.GetEnvoyGrpc().GetClusterName() != DefaultTracingCollectorClusterName {
+		t.Errorf("expected the grpc service of the collector cluster, got %v", &service)
+	}
+	c = CreateTracingCollectorCluster(*tracing)
+	if c == nil || c.GetHttp2ProtocolOptions() == nil {
+		t.Errorf("expected the http2 collector cluster for otlp driver, got %v", c)
+	}
+	if envoyv2.CreateTracing(*tracing) != nil {
+		t.Error("expected no tracing of the v2 api for otlp driver")
+	}
+	if envoyv2.GetTracingOptions(map[string]string{util.TracingDriverAnnotation: "jaeger"}) != nil {
+		t.Error("expected tracing disabled for unknown driver")
+	}
+}
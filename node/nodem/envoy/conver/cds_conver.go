@@ -63,6 +63,11 @@ func OneNodeCluster(serviceAlias, namespace string, configs *corev1.ConfigMap, s
 	if len(clusters) == 0 {
 		logrus.Warningf("configmap name: %s; plugin-config: %s; create clusters zero length", configs.Name, configs.Data["plugin-config"])
 	}
+	if tracing := envoyv2.GetTracingOptions(configs.Annotations); tracing != nil {
+		if cl := envoyv2.CreateTracingCollectorCluster(*tracing); cl != nil {
+			clusters = append(clusters, cl)
+		}
+	}
 	return clusters, nil
 }
 
@@ -0,0 +1,168 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package conver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/golang/protobuf/ptypes"
+	api_model "github.com/goodrain/rainbond/api/model"
+	envoyv2 "github.com/goodrain/rainbond/node/core/envoy/v2"
+	envoyv3 "github.com/goodrain/rainbond/node/core/envoy/v3"
+	"github.com/goodrain/rainbond/node/utils"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+)
+
+//OneNodeClusterV3 conver v3 cluster of on envoy node
+func OneNodeClusterV3(serviceAlias, namespace string, configs *corev1.ConfigMap, services []*corev1.Service) ([]types.Resource, error) {
+	resources, _, err := GetPluginConfigs(configs)
+	if err != nil {
+		return nil, err
+	}
+	var clusters []types.Resource
+	if len(resources.BaseServices) > 0 {
+		for _, cl := range upstreamClustersV3(serviceAlias, namespace, resources.BaseServices, services) {
+			if err := cl.Validate(); err != nil {
+				logrus.Errorf("cluster validate failure %s", err.Error())
+			} else {
+				clusters = append(clusters, cl)
+			}
+		}
+	}
+	if len(resources.BasePorts) > 0 {
+		for _, cl := range downstreamClustersV3(serviceAlias, namespace, resources.BasePorts) {
+			if err := cl.Validate(); err != nil {
+				logrus.Errorf("cluster validate failure %s", err.Error())
+			} else {
+				clusters = append(clusters, cl)
+			}
+		}
+	}
+	if len(clusters) == 0 {
+		logrus.Warningf("configmap name: %s; plugin-config: %s; create v3 clusters zero length", configs.Name, configs.Data["plugin-config"])
+	}
+	if tracing := envoyv2.GetTracingOptions(configs.Annotations); tracing != nil {
+		if cl := envoyv3.CreateTracingCollectorCluster(*tracing); cl != nil {
+			clusters = append(clusters, cl)
+		}
+	}
+	return clusters, nil
+}
+
+// upstreamClustersV3 handle upstream app cluster
+// handle kubernetes inner service
+func upstreamClustersV3(serviceAlias, namespace string, dependsServices []*api_model.BaseService, services []*corev1.Service) (cdsClusters []*cluster.Cluster) {
+	var clusterConfig = make(map[string]*api_model.BaseService, len(dependsServices))
+	for i, dService := range dependsServices {
+		depServiceIndex := fmt.Sprintf("%s_%s_%s_%d", namespace, serviceAlias, dService.DependServiceAlias, dService.Port)
+		clusterConfig[depServiceIndex] = dependsServices[i]
+	}
+	for _, service := range services {
+		inner, ok := service.Labels["service_type"]
+		destServiceAlias := GetServiceAliasByService(service)
+		port := service.Spec.Ports[0]
+		if !ok || inner != "inner" {
+			continue
+		}
+		relPort, _ := strconv.Atoi(service.Labels["origin_port"])
+		if relPort == 0 {
+			relPort = int(port.TargetPort.IntVal)
+		}
+		var options envoyv2.RainbondPluginOptions
+		if dService, ok := clusterConfig[fmt.Sprintf("%s_%s_%s_%d", namespace, serviceAlias, destServiceAlias, relPort)]; ok {
+			options = envoyv2.GetOptionValues(dService.Options)
+		} else {
+			options = envoyv2.GetOptionValues(nil)
+		}
+		var clusterOption envoyv3.ClusterOptions
+		clusterOption.Name = fmt.Sprintf("%s_%s_%s_%v", namespace, serviceAlias, destServiceAlias, port.Port)
+		clusterOption.OutlierDetection = envoyv3.CreatOutlierDetection(options)
+		clusterOption.CircuitBreakers = envoyv3.CreateCircuitBreaker(options)
+		clusterOption.ServiceName = clusterOption.Name
+		if domain, ok := service.Annotations["domain"]; ok && domain != "" {
+			logrus.Debugf("domain endpoint[%s], create logical_dns cluster: ", domain)
+			clusterOption.ClusterType = cluster.Cluster_LOGICAL_DNS
+			clusterOption.LoadAssignment = envoyv3.CreateDNSLoadAssignment(serviceAlias, namespace, domain, service)
+			if strings.HasPrefix(domain, "https://") {
+				splitDomain := strings.Split(domain, "https://")
+				if len(splitDomain) == 2 {
+					clusterOption.TransportSocket = transportSocketV3(clusterOption.Name, splitDomain[1])
+				}
+			}
+		} else {
+			clusterOption.ClusterType = cluster.Cluster_EDS
+		}
+		clusterOption.HealthyPanicThreshold = options.HealthyPanicThreshold
+		clusterOption.ConnectionTimeout = envoyv2.ConverTimeDuration(options.ConnectionTimeout)
+		clusterOption.Protocol = service.Labels["port_protocol"]
+		clusterOption.GrpcHealthServiceName = options.GrpcHealthServiceName
+		clusterOption.HealthTimeout = options.HealthCheckTimeout
+		clusterOption.HealthInterval = options.HealthCheckInterval
+		if c := envoyv3.CreateCluster(clusterOption); c != nil {
+			cdsClusters = append(cdsClusters, c)
+		}
+	}
+	return
+}
+
+func transportSocketV3(name, domain string) *core.TransportSocket {
+	tlsContext, err := ptypes.MarshalAny(&tls.UpstreamTlsContext{Sni: domain})
+	if err != nil {
+		logrus.Errorf("error marshaling tls context to transport_socket config for cluster %s, err=%v", name, err)
+		return nil
+	}
+	return &core.TransportSocket{
+		Name: utils.EnvoyTLSSocketName,
+		ConfigType: &core.TransportSocket_TypedConfig{
+			TypedConfig: tlsContext,
+		},
+	}
+}
+
+//downstreamClustersV3 handle app self cluster
+//only local port
+func downstreamClustersV3(serviceAlias, namespace string, ports []*api_model.BasePort) (cdsClusters []*cluster.Cluster) {
+	for i := range ports {
+		port := ports[i]
+		address := envoyv3.CreateSocketAddress(port.Protocol, "127.0.0.1", uint32(port.Port))
+		clusterName := fmt.Sprintf("%s_%s_%v", namespace, serviceAlias, port.Port)
+		option := envoyv2.GetOptionValues(port.Options)
+		c := envoyv3.CreateCluster(envoyv3.ClusterOptions{
+			Name:                     clusterName,
+			ConnectionTimeout:        envoyv2.ConverTimeDuration(option.ConnectionTimeout),
+			ClusterType:              cluster.Cluster_STATIC,
+			CircuitBreakers:          envoyv3.CreateCircuitBreaker(option),
+			OutlierDetection:         envoyv3.CreatOutlierDetection(option),
+			MaxRequestsPerConnection: option.MaxRequestsPerConnection,
+			LoadAssignment:           envoyv3.CreateStaticLoadAssignment(clusterName, address),
+			HealthyPanicThreshold:    option.HealthyPanicThreshold,
+		})
+		if c != nil {
+			cdsClusters = append(cdsClusters, c)
+		}
+	}
+	return
+}
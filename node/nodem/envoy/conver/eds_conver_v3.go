@@ -0,0 +1,81 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package conver
+
+import (
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+)
+
+//OneNodeClusterLoadAssignmentV3 one envoy node v3 endpoints
+//the endpoints are the same as the v2 ones, only the api types differ
+func OneNodeClusterLoadAssignmentV3(serviceAlias, namespace string, endpoints []*corev1.Endpoints, services []*corev1.Service) (clusterLoadAssignment []types.Resource) {
+	for _, res := range OneNodeClusterLoadAssignment(serviceAlias, namespace, endpoints, services) {
+		v2cla, ok := res.(*v2.ClusterLoadAssignment)
+		if !ok {
+			continue
+		}
+		var lendpoints []*endpoint.LocalityLbEndpoints
+		for _, le := range v2cla.GetEndpoints() {
+			var lbe []*endpoint.LbEndpoint
+			for _, e := range le.GetLbEndpoints() {
+				address := e.GetEndpoint().GetAddress().GetSocketAddress()
+				if address == nil {
+					continue
+				}
+				ep := &endpoint.Endpoint{
+					Address: &core.Address{
+						Address: &core.Address_SocketAddress{
+							SocketAddress: &core.SocketAddress{
+								Protocol: core.SocketAddress_Protocol(address.GetProtocol()),
+								Address:  address.GetAddress(),
+								PortSpecifier: &core.SocketAddress_PortValue{
+									PortValue: address.GetPortValue(),
+								},
+							},
+						},
+					},
+				}
+				if hc := e.GetEndpoint().GetHealthCheckConfig(); hc != nil {
+					ep.HealthCheckConfig = &endpoint.Endpoint_HealthCheckConfig{PortValue: hc.GetPortValue()}
+				}
+				lbe = append(lbe, &endpoint.LbEndpoint{
+					HostIdentifier: &endpoint.LbEndpoint_Endpoint{Endpoint: ep},
+				})
+			}
+			if len(lbe) > 0 {
+				lendpoints = append(lendpoints, &endpoint.LocalityLbEndpoints{LbEndpoints: lbe})
+			}
+		}
+		cla := &endpoint.ClusterLoadAssignment{
+			ClusterName: v2cla.GetClusterName(),
+			Endpoints:   lendpoints,
+		}
+		if err := cla.Validate(); err != nil {
+			logrus.Errorf("v3 endpoints discover validate failure %s", err.Error())
+			continue
+		}
+		clusterLoadAssignment = append(clusterLoadAssignment, cla)
+	}
+	return clusterLoadAssignment
+}
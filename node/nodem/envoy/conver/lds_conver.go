@@ -63,8 +63,9 @@ func OneNodeListerner(serviceAlias, namespace string, configs *corev1.ConfigMap,
 		}
 		return false
 	}()
+	tracing := envoyv2.GetTracingOptions(configs.Annotations)
 	if resources.BaseServices != nil && len(resources.BaseServices) > 0 {
-		for _, l := range upstreamListener(serviceAlias, namespace, resources.BaseServices, services, !notCreateCommonHTTPListener) {
+		for _, l := range upstreamListener(serviceAlias, namespace, resources.BaseServices, services, !notCreateCommonHTTPListener, tracing) {
 			if err := l.Validate(); err != nil {
 				logrus.Errorf("listener validate failure %s", err.Error())
 			} else {
@@ -74,7 +75,7 @@ func OneNodeListerner(serviceAlias, namespace string, configs *corev1.ConfigMap,
 		}
 	}
 	if resources.BasePorts != nil && len(resources.BasePorts) > 0 {
-		for _, l := range downstreamListener(serviceAlias, namespace, resources.BasePorts) {
+		for _, l := range downstreamListener(serviceAlias, namespace, resources.BasePorts, tracing) {
 			if err := l.Validate(); err != nil {
 				logrus.Errorf("listener validate failure %s", err.Error())
 			} else {
@@ -91,7 +92,7 @@ func OneNodeListerner(serviceAlias, namespace string, configs *corev1.ConfigMap,
 
 //upstreamListener handle upstream app listener
 // handle kubernetes inner service
-func upstreamListener(serviceAlias, namespace string, dependsServices []*api_model.BaseService, services []*corev1.Service, createHTTPListen bool) (ldsL []*v2.Listener) {
+func upstreamListener(serviceAlias, namespace string, dependsServices []*api_model.BaseService, services []*corev1.Service, createHTTPListen bool, tracing *envoyv2.TracingOptions) (ldsL []*v2.Listener) {
 	var ListennerConfig = make(map[string]*api_model.BaseService, len(dependsServices))
 	for i, dService := range dependsServices {
 		protoccol := "tcp"
@@ -141,6 +142,7 @@ func upstreamListener(serviceAlias, namespace string, dependsServices []*api_mod
 			protocol := service.Labels["port_protocol"]
 			if domain, ok := service.Annotations["domain"]; ok && domain != "" && (protocol == "https" || protocol == "http" || protocol == "grpc") {
 				route := envoyv2.CreateRouteWithHostRewrite(domain, clusterName, "/", nil, 0)
+				envoyv2.SetRouteOptions(route, options)
 				if route != nil {
 					pvh := envoyv2.CreateRouteVirtualHost(
 						fmt.Sprintf("%s_%s_%s_%d", namespace, serviceAlias, GetServiceAliasByService(service), port),
@@ -149,7 +151,7 @@ func upstreamListener(serviceAlias, namespace string, dependsServices []*api_mod
 						route,
 					)
 					if pvh != nil {
-						listener = envoyv2.CreateHTTPListener(fmt.Sprintf("%s_%s_http_%d", namespace, serviceAlias, port), envoyv2.DefaultLocalhostListenerAddress, fmt.Sprintf("%s_%d", serviceAlias, port), uint32(port), nil, pvh)
+						listener = envoyv2.CreateHTTPListener(fmt.Sprintf("%s_%s_http_%d", namespace, serviceAlias, port), envoyv2.DefaultLocalhostListenerAddress, fmt.Sprintf("%s_%d", serviceAlias, port), uint32(port), nil, tracing, pvh)
 					} else {
 						logrus.Warnf("create route virtual host of domain listener %s failure", fmt.Sprintf("%s_%s_http_%d", namespace, serviceAlias, port))
 					}
@@ -201,6 +203,7 @@ func upstreamListener(serviceAlias, namespace string, dependsServices []*api_mod
 					} else {
 						route = envoyv2.CreateRoute(clusterName, options.Prefix, headerMatchers, options.Weight)
 					}
+					envoyv2.SetRouteOptions(route, options)
 
 					if route != nil {
 						if pvh := VHLDomainMap[strings.Join(options.Domains, "")]; pvh != nil {
@@ -248,7 +251,7 @@ func upstreamListener(serviceAlias, namespace string, dependsServices []*api_mod
 		statsPrefix := fmt.Sprintf("%s_%d", serviceAlias, defaultListenPort)
 		plds := envoyv2.CreateHTTPListener(
 			fmt.Sprintf("%s_%s_http_%d", namespace, serviceAlias, defaultListenPort),
-			envoyv2.DefaultLocalhostListenerAddress, statsPrefix, defaultListenPort, nil, newVHL...)
+			envoyv2.DefaultLocalhostListenerAddress, statsPrefix, defaultListenPort, nil, tracing, newVHL...)
 		if plds != nil {
 			ldsL = append(ldsL, plds)
 		} else {
@@ -259,7 +262,7 @@ func upstreamListener(serviceAlias, namespace string, dependsServices []*api_mod
 }
 
 //downstreamListener handle app self port listener
-func downstreamListener(serviceAlias, namespace string, ports []*api_model.BasePort) (ls []*v2.Listener) {
+func downstreamListener(serviceAlias, namespace string, ports []*api_model.BasePort, tracing *envoyv2.TracingOptions) (ls []*v2.Listener) {
 	var portMap = make(map[int32]int, 0)
 	for i := range ports {
 		p := ports[i]
@@ -290,6 +293,7 @@ func downstreamListener(serviceAlias, namespace string, ports []*api_model.BaseP
 					logrus.Warning("create route cirtual route failure")
 					continue
 				}
+				envoyv2.SetRouteOptions(route, options)
 				virtuals := envoyv2.CreateRouteVirtualHost(listenerName, []string{"*"}, limit, route)
 				if virtuals == nil {
 					logrus.Warning("create route cirtual failure")
@@ -300,7 +304,7 @@ func downstreamListener(serviceAlias, namespace string, ports []*api_model.BaseP
 					Domain:                inboundConfig.LimitDomain,
 					RateServerClusterName: envoyv2.DefaultRateLimitServerClusterName,
 					Stage:                 0,
-				}, virtuals)
+				}, tracing, virtuals)
 				if listener != nil {
 					ls = append(ls, listener)
 				}
@@ -34,7 +34,9 @@ import (
 	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/server/v2"
+	serverv3 "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	api_model "github.com/goodrain/rainbond/api/model"
 	"github.com/goodrain/rainbond/cmd/node/option"
 	"github.com/goodrain/rainbond/node/nodem/envoy/conver"
@@ -64,6 +66,10 @@ type DiscoverServerManager struct {
 	endpoints       cacheHandler
 	configmaps      cacheHandler
 	queue           Queue
+	// v3 api server, the snapshots are only set for the nodes speaking v3
+	serverV3        serverv3.Server
+	cacheManagerV3  cachev3.SnapshotCache
+	nodeAPIVersions sync.Map
 }
 
 // Hasher returns node ID as an ID
@@ -88,6 +94,8 @@ type NodeConfig struct {
 	configModel                    *api_model.ResourceSpec
 	dependServices                 sync.Map
 	listeners, clusters, endpoints []types.Resource
+	// v3 api resources
+	listenersV3, clustersV3, endpointsV3 []types.Resource
 }
 
 //GetID get envoy node config id
@@ -235,9 +243,18 @@ func (d *DiscoverServerManager) UpdateNodeConfig(nc *NodeConfig) error {
 	} else {
 		nc.endpoints = clusterLoadAssignment
 	}
+	if d.servedV3(nc.nodeID) {
+		d.updateNodeConfigV3(nc, services, endpoint)
+	}
 	//Fill the configuration information and inject envoy
 	nc.VersionUpdate()
-	return d.setSnapshot(nc)
+	if err := d.setSnapshot(nc); err != nil {
+		return err
+	}
+	if d.servedV3(nc.nodeID) {
+		return d.setSnapshotV3(nc)
+	}
+	return nil
 }
 
 func (d *DiscoverServerManager) setSnapshot(nc *NodeConfig) error {
@@ -257,9 +274,9 @@ func (d *DiscoverServerManager) setSnapshot(nc *NodeConfig) error {
 //CreateDiscoverServerManager create discover server manager
 func CreateDiscoverServerManager(clientset kubernetes.Interface, conf option.Conf) (*DiscoverServerManager, error) {
 	configcache := cache.NewSnapshotCache(false, Hasher{}, logrus.WithField("module", "config-cache"))
+	configcacheV3 := cachev3.NewSnapshotCache(false, HasherV3{}, logrus.WithField("module", "config-cache-v3"))
 	ctx, cancel := context.WithCancel(context.Background())
 	dsm := &DiscoverServerManager{
-		server:       server.NewServer(ctx, configcache, nil),
 		cacheManager: configcache,
 		kubecli:      clientset,
 		conf:         conf,
@@ -273,6 +290,9 @@ func CreateDiscoverServerManager(clientset kubernetes.Interface, conf option.Con
 		cancel: cancel,
 		queue:  NewQueue(1 * time.Second),
 	}
+	dsm.server = server.NewServer(ctx, configcache, callbacksV2{report: dsm.reportNodeAPIVersion})
+	dsm.cacheManagerV3 = configcacheV3
+	dsm.serverV3 = serverv3.NewServer(ctx, configcacheV3, callbacksV3{report: dsm.reportNodeAPIVersion})
 	sharedInformers := informers.NewFilteredSharedInformerFactory(dsm.kubecli, time.Second*10, corev1.NamespaceAll, func(options *meta_v1.ListOptions) {
 		options.LabelSelector = "creator=Rainbond"
 	})
@@ -316,6 +336,7 @@ func (d *DiscoverServerManager) Start(errch chan error) error {
 		v2.RegisterRouteDiscoveryServiceServer(d.grpcServer, d.server)
 		v2.RegisterListenerDiscoveryServiceServer(d.grpcServer, d.server)
 		discovery.RegisterSecretDiscoveryServiceServer(d.grpcServer, d.server)
+		d.registerV3(d.grpcServer)
 		logrus.Infof("envoy grpc management server listening %s", d.conf.GrpcAPIAddr)
 		lis, err := net.Listen("tcp", d.conf.GrpcAPIAddr)
 		if err != nil {
@@ -409,6 +430,8 @@ func (d *DiscoverServerManager) DeleteNodeConfig(nodeID string) {
 	for i, existNC := range d.cacheNodeConfig {
 		if existNC.nodeID == nodeID {
 			d.cacheManager.ClearSnapshot(existNC.nodeID)
+			d.cacheManagerV3.ClearSnapshot(existNC.nodeID)
+			d.nodeAPIVersions.Delete(existNC.nodeID)
 			d.cacheNodeConfig = append(d.cacheNodeConfig[:i], d.cacheNodeConfig[i+1:]...)
 		}
 	}
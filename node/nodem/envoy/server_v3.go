@@ -0,0 +1,187 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package envoy
+
+import (
+	"context"
+
+	apiv2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/service/cluster/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/service/endpoint/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/service/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/service/route/v3"
+	secretv3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/goodrain/rainbond/node/nodem/envoy/conver"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	//XDSAPIVersionMetadataKey the node metadata key a sidecar declares its xds api version with
+	XDSAPIVersionMetadataKey = "XDS_API_VERSION"
+	//XDSAPIVersionV2 envoy.api.v2
+	XDSAPIVersionV2 = "v2"
+	//XDSAPIVersionV3 envoy v3 api
+	XDSAPIVersionV3 = "v3"
+)
+
+// HasherV3 returns node cluster as an ID of v3 node
+type HasherV3 struct {
+}
+
+// ID function
+func (h HasherV3) ID(node *corev3.Node) string {
+	if node == nil {
+		return "unknown"
+	}
+	return node.Cluster
+}
+
+//nodeAPIVersion returns the xds api version declared by the node metadata,
+//the api version of the transport the node connected by otherwise.
+func nodeAPIVersion(metadata *_struct.Struct, transport string) string {
+	if value, ok := metadata.GetFields()[XDSAPIVersionMetadataKey]; ok {
+		switch value.GetStringValue() {
+		case XDSAPIVersionV2, XDSAPIVersionV3:
+			return value.GetStringValue()
+		}
+	}
+	return transport
+}
+
+//callbacksV2 record the api version of the nodes requesting the v2 server
+type callbacksV2 struct {
+	report func(nodeID, version string)
+}
+
+func (c callbacksV2) OnStreamOpen(context.Context, int64, string) error { return nil }
+func (c callbacksV2) OnStreamClosed(int64)                              {}
+func (c callbacksV2) OnStreamRequest(_ int64, req *apiv2.DiscoveryRequest) error {
+	c.onRequest(req)
+	return nil
+}
+func (c callbacksV2) OnStreamResponse(int64, *apiv2.DiscoveryRequest, *apiv2.DiscoveryResponse) {}
+func (c callbacksV2) OnFetchRequest(_ context.Context, req *apiv2.DiscoveryRequest) error {
+	c.onRequest(req)
+	return nil
+}
+func (c callbacksV2) OnFetchResponse(*apiv2.DiscoveryRequest, *apiv2.DiscoveryResponse) {}
+func (c callbacksV2) onRequest(req *apiv2.DiscoveryRequest) {
+	// node is only carried by the first request of a stream
+	if node := req.GetNode(); node != nil {
+		c.report(node.GetCluster(), nodeAPIVersion(node.GetMetadata(), XDSAPIVersionV2))
+	}
+}
+
+//callbacksV3 record the api version of the nodes requesting the v3 server
+type callbacksV3 struct {
+	report func(nodeID, version string)
+}
+
+func (c callbacksV3) OnStreamOpen(context.Context, int64, string) error { return nil }
+func (c callbacksV3) OnStreamClosed(int64)                              {}
+func (c callbacksV3) OnStreamRequest(_ int64, req *discoveryv3.DiscoveryRequest) error {
+	c.onRequest(req)
+	return nil
+}
+func (c callbacksV3) OnStreamResponse(int64, *discoveryv3.DiscoveryRequest, *discoveryv3.DiscoveryResponse) {
+}
+func (c callbacksV3) OnFetchRequest(_ context.Context, req *discoveryv3.DiscoveryRequest) error {
+	c.onRequest(req)
+	return nil
+}
+func (c callbacksV3) OnFetchResponse(*discoveryv3.DiscoveryRequest, *discoveryv3.DiscoveryResponse) {}
+func (c callbacksV3) onRequest(req *discoveryv3.DiscoveryRequest) {
+	if node := req.GetNode(); node != nil {
+		c.report(node.GetCluster(), nodeAPIVersion(node.GetMetadata(), XDSAPIVersionV3))
+	}
+}
+
+//reportNodeAPIVersion record the api version of a node. v2 config is always served during
+//the compatibility period, so only a node newly speaking v3 requires its v3 snapshot to be built.
+func (d *DiscoverServerManager) reportNodeAPIVersion(nodeID, version string) {
+	if version != XDSAPIVersionV3 {
+		return
+	}
+	if _, loaded := d.nodeAPIVersions.LoadOrStore(nodeID, version); !loaded {
+		logrus.Infof("envoy node %s requests config by xds %s api", nodeID, version)
+		d.queue.Push(Task{handler: d.nodeAPIVersionHandle, obj: nodeID, event: EventUpdate})
+	}
+}
+
+//servedV3 whether the v3 config of the node should be served
+func (d *DiscoverServerManager) servedV3(nodeID string) bool {
+	_, ok := d.nodeAPIVersions.Load(nodeID)
+	return ok
+}
+
+func (d *DiscoverServerManager) nodeAPIVersionHandle(obj interface{}, event Event) error {
+	nodeID, _ := obj.(string)
+	for i := range d.cacheNodeConfig {
+		if d.cacheNodeConfig[i].nodeID == nodeID {
+			return d.UpdateNodeConfig(d.cacheNodeConfig[i])
+		}
+	}
+	return nil
+}
+
+//updateNodeConfigV3 conver the v3 resources of the node
+func (d *DiscoverServerManager) updateNodeConfigV3(nc *NodeConfig, services []*corev1.Service, endpoints []*corev1.Endpoints) {
+	listeners, err := conver.OneNodeListernerV3(nc.serviceAlias, nc.namespace, nc.config, services)
+	if err != nil {
+		logrus.Errorf("create envoy v3 listeners failure %s", err.Error())
+	} else {
+		nc.listenersV3 = listeners
+	}
+	clusters, err := conver.OneNodeClusterV3(nc.serviceAlias, nc.namespace, nc.config, services)
+	if err != nil {
+		logrus.Errorf("create envoy v3 clusters failure %s", err.Error())
+	} else {
+		nc.clustersV3 = clusters
+	}
+	nc.endpointsV3 = conver.OneNodeClusterLoadAssignmentV3(nc.serviceAlias, nc.namespace, endpoints, services)
+}
+
+func (d *DiscoverServerManager) setSnapshotV3(nc *NodeConfig) error {
+	if len(nc.clustersV3) < 1 || len(nc.listenersV3) < 1 {
+		logrus.Warningf("node id: %s; node v3 config cluster length is zero or listener length is zero,not set snapshot", nc.GetID())
+		return nil
+	}
+	snapshot := cachev3.NewSnapshot(nc.GetVersion(), nc.endpointsV3, nc.clustersV3, nil, nc.listenersV3, nil)
+	if err := d.cacheManagerV3.SetSnapshot(nc.nodeID, snapshot); err != nil {
+		return err
+	}
+	logrus.Infof("cache envoy node %s v3 config,version: %s", nc.GetID(), nc.GetVersion())
+	return nil
+}
+
+//registerV3 register the v3 discovery services
+func (d *DiscoverServerManager) registerV3(grpcServer *grpc.Server) {
+	discoveryv3.RegisterAggregatedDiscoveryServiceServer(grpcServer, d.serverV3)
+	endpointv3.RegisterEndpointDiscoveryServiceServer(grpcServer, d.serverV3)
+	clusterv3.RegisterClusterDiscoveryServiceServer(grpcServer, d.serverV3)
+	routev3.RegisterRouteDiscoveryServiceServer(grpcServer, d.serverV3)
+	listenerv3.RegisterListenerDiscoveryServiceServer(grpcServer, d.serverV3)
+	secretv3.RegisterSecretDiscoveryServiceServer(grpcServer, d.serverV3)
+}
@@ -0,0 +1,50 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package envoy
+
+import (
+	"testing"
+
+	_struct "github.com/golang/protobuf/ptypes/struct"
+)
+
+func TestNodeAPIVersion(t *testing.T) {
+	metadata := func(version string) *_struct.Struct {
+		return &_struct.Struct{Fields: map[string]*_struct.Value{
+			XDSAPIVersionMetadataKey: {Kind: &_struct.Value_StringValue{StringValue: version}},
+		}}
+	}
+	tests := []struct {
+		name      string
+		metadata  *_struct.Struct
+		transport string
+		want      string
+	}{
+		{name: "no metadata", metadata: nil, transport: XDSAPIVersionV2, want: XDSAPIVersionV2},
+		{name: "v3 transport", metadata: nil, transport: XDSAPIVersionV3, want: XDSAPIVersionV3},
+		{name: "declared v3", metadata: metadata("v3"), transport: XDSAPIVersionV2, want: XDSAPIVersionV3},
+		{name: "declared v2", metadata: metadata("v2"), transport: XDSAPIVersionV3, want: XDSAPIVersionV2},
+		{name: "unknown declaration", metadata: metadata("v4"), transport: XDSAPIVersionV3, want: XDSAPIVersionV3},
+	}
+	for _, tc := range tests {
+		if got := nodeAPIVersion(tc.metadata, tc.transport); got != tc.want {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.want, got)
+		}
+	}
+}
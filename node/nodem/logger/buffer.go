@@ -0,0 +1,320 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	bufferedEntries = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "rainbond",
+		Subsystem: "log_driver",
+		Name:      "buffered_entries",
+		Help:      "The number of log entries waiting in the buffers of the log driver.",
+	}, []string{"driver"})
+	sentEntries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rainbond",
+		Subsystem: "log_driver",
+		Name:      "sent_entries_total",
+		Help:      "The number of log entries sent by the log driver.",
+	}, []string{"driver"})
+	droppedEntries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rainbond",
+		Subsystem: "log_driver",
+		Name:      "dropped_entries_total",
+		Help:      "The number of log entries dropped by the log driver, because the buffer is full or the retries are exhausted.",
+	}, []string{"driver", "reason"})
+	sendRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rainbond",
+		Subsystem: "log_driver",
+		Name:      "send_retries_total",
+		Help:      "The number of retries of the log driver to send a batch.",
+	}, []string{"driver"})
+	blockedSeconds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rainbond",
+		Subsystem: "log_driver",
+		Name:      "blocked_seconds_total",
+		Help:      "The time the log copier is blocked by the full buffer of the log driver in blocking mode.",
+	}, []string{"driver"})
+)
+
+func init() {
+	prometheus.MustRegister(bufferedEntries, sentEntries, droppedEntries, sendRetries, blockedSeconds)
+}
+
+// closeTimeout the max time to send the rest entries when the logger is closed
+var closeTimeout = 5 * time.Second
+
+// BufferOptionKeys the options of the buffer, they are supported by all buffered log drivers.
+var BufferOptionKeys = []string{"mode", "buffer-size", "batch-size", "batch-wait", "max-retries"}
+
+// Entry a log line to be sent, it is copied from the message which is reused after Log returns.
+type Entry struct {
+	Line      []byte
+	Source    string
+	Timestamp time.Time
+}
+
+// BatchWriter writes the batches of log entries to the log backend
+type BatchWriter interface {
+	WriteBatch(ctx context.Context, entries []*Entry) error
+	Close() error
+}
+
+// BufferOptions how the buffered logger buffers and sends the log entries
+type BufferOptions struct {
+	// the max number of entries in the buffer
+	BufferSize int
+	// the max number of entries in a batch
+	BatchSize int
+	// the max time an entry waits to be sent
+	BatchWait time.Duration
+	// the max retries to send a batch, the batch is dropped after it
+	MaxRetries int
+	// Log blocks when the buffer is full in blocking mode, otherwise the entry is dropped
+	Blocking bool
+}
+
+// ParseBufferOptions parses the buffer options of the log driver config
+func ParseBufferOptions(cfg map[string]string) (BufferOptions, error) {
+	opts := BufferOptions{
+		BufferSize: 10000,
+		BatchSize:  500,
+		BatchWait:  time.Second,
+		MaxRetries: 5,
+	}
+	switch containertypes.LogMode(cfg["mode"]) {
+	case containertypes.LogModeBlocking:
+		opts.Blocking = true
+	case containertypes.LogModeNonBlock, containertypes.LogModeUnset:
+	default:
+		return opts, fmt.Errorf("logging mode not supported: %s", cfg["mode"])
+	}
+	for key, value := range map[string]*int{
+		"buffer-size": &opts.BufferSize,
+		"batch-size":  &opts.BatchSize,
+		"max-retries": &opts.MaxRetries,
+	} {
+		if cfg[key] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(cfg[key])
+		if err != nil || n < 0 {
+			return opts, fmt.Errorf("%s must be a non-negative number", key)
+		}
+		*value = n
+	}
+	if opts.BufferSize == 0 || opts.BatchSize == 0 {
+		return opts, fmt.Errorf("buffer-size and batch-size must be greater than 0")
+	}
+	if wait := cfg["batch-wait"]; wait != "" {
+		d, err := time.ParseDuration(wait)
+		if err != nil || d <= 0 {
+			return opts, fmt.Errorf("batch-wait must be a positive duration, such as 1s")
+		}
+		opts.BatchWait = d
+	}
+	return opts, nil
+}
+
+// ValidateOptions returns an error if there is an option not in the keys or the buffer options
+func ValidateOptions(driver string, cfg map[string]string, keys ...string) error {
+	known := make(map[string]bool, len(keys)+len(BufferOptionKeys))
+	for _, key := range append(keys, BufferOptionKeys...) {
+		known[key] = true
+	}
+	for key := range cfg {
+		if !known[key] {
+			return fmt.Errorf("unknown log opt '%s' for %s log driver", key, driver)
+		}
+	}
+	_, err := ParseBufferOptions(cfg)
+	return err
+}
+
+// BufferedLogger buffers the log entries and sends them in batches by the writer, the failed batches are retried
+// with backoff. When the buffer is full, Log blocks in blocking mode to push back on the log copier,
+// or drops the entry in non-blocking mode.
+type BufferedLogger struct {
+	name    string
+	writer  BatchWriter
+	opts    BufferOptions
+	entries chan *Entry
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	once    sync.Once
+}
+
+// NewBufferedLogger creates a buffered logger and starts sending
+func NewBufferedLogger(name string, writer BatchWriter, opts BufferOptions) *BufferedLogger {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &BufferedLogger{
+		name:    name,
+		writer:  writer,
+		opts:    opts,
+		entries: make(chan *Entry, opts.BufferSize),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go l.run()
+	return l
+}
+
+// Log copies the message into the buffer
+func (l *BufferedLogger) Log(msg *Message) error {
+	if len(msg.Line) == 0 {
+		return nil
+	}
+	entry := &Entry{
+		Line:      append([]byte(nil), msg.Line...),
+		Source:    msg.Source,
+		Timestamp: msg.Timestamp,
+	}
+	select {
+	case l.entries <- entry:
+		bufferedEntries.WithLabelValues(l.name).Inc()
+		return nil
+	case <-l.ctx.Done():
+		return fmt.Errorf("%s log driver is closed", l.name)
+	default:
+	}
+	if !l.opts.Blocking {
+		droppedEntries.WithLabelValues(l.name, "buffer_full").Inc()
+		return fmt.Errorf("the buffer of %s log driver is full", l.name)
+	}
+	start := time.Now()
+	defer func() {
+		blockedSeconds.WithLabelValues(l.name).Add(time.Since(start).Seconds())
+	}()
+	select {
+	case l.entries <- entry:
+		bufferedEntries.WithLabelValues(l.name).Inc()
+		return nil
+	case <-l.ctx.Done():
+		return fmt.Errorf("%s log driver is closed", l.name)
+	}
+}
+
+// Name the name of the log driver
+func (l *BufferedLogger) Name() string {
+	return l.name
+}
+
+// Close sends the buffered entries and closes the writer
+func (l *BufferedLogger) Close() error {
+	l.once.Do(func() {
+		l.cancel()
+		<-l.done
+	})
+	return l.writer.Close()
+}
+
+func (l *BufferedLogger) run() {
+	defer close(l.done)
+	ticker := time.NewTicker(l.opts.BatchWait)
+	defer ticker.Stop()
+	batch := make([]*Entry, 0, l.opts.BatchSize)
+	flush := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+		if !l.send(ctx, batch) {
+			// the logger is closing, the batch is sent with the rest entries
+			return
+		}
+		bufferedEntries.WithLabelValues(l.name).Sub(float64(len(batch)))
+		batch = make([]*Entry, 0, l.opts.BatchSize)
+	}
+	for l.ctx.Err() == nil {
+		select {
+		case entry := <-l.entries:
+			batch = append(batch, entry)
+			if len(batch) >= l.opts.BatchSize {
+				flush(l.ctx)
+			}
+		case <-ticker.C:
+			flush(l.ctx)
+		case <-l.ctx.Done():
+		}
+	}
+	// send the rest entries in a limited time, including the batch interrupted by closing
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	flush(ctx)
+	for {
+		select {
+		case entry := <-l.entries:
+			batch = append(batch, entry)
+			if len(batch) >= l.opts.BatchSize {
+				flush(ctx)
+			}
+			continue
+		default:
+		}
+		break
+	}
+	flush(ctx)
+}
+
+// send the batch with retries, the interval of retries doubles from 500ms to 30s.
+// It returns false if the context is canceled before the batch is sent or dropped.
+func (l *BufferedLogger) send(ctx context.Context, batch []*Entry) bool {
+	backoff := 500 * time.Millisecond
+	for i := 0; ; i++ {
+		err := l.writer.WriteBatch(ctx, batch)
+		if err == nil {
+			sentEntries.WithLabelValues(l.name).Add(float64(len(batch)))
+			return true
+		}
+		if ctx.Err() == context.Canceled {
+			return false
+		}
+		if i >= l.opts.MaxRetries {
+			logrus.Warningf("send %d log entries by %s log driver: %v, drop them", len(batch), l.name, err)
+			droppedEntries.WithLabelValues(l.name, "send_failure").Add(float64(len(batch)))
+			return true
+		}
+		logrus.Debugf("send log entries by %s log driver: %v, retry after %s", l.name, err, backoff)
+		sendRetries.WithLabelValues(l.name).Inc()
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			if ctx.Err() == context.Canceled {
+				return false
+			}
+			logrus.Warningf("send %d log entries by %s log driver: %v, drop them", len(batch), l.name, ctx.Err())
+			droppedEntries.WithLabelValues(l.name, "send_failure").Add(float64(len(batch)))
+			return true
+		}
+		if backoff *= 2; backoff > 30*time.Second {
+			backoff = 30 * time.Second
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeBatchWriter struct {
+	lock    sync.Mutex
+	fails   int
+	batches [][]*Entry
+}
+
+func (f *fakeBatchWriter) WriteBatch(ctx context.Context, entries []*Entry) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	// the request is aborted with the context, like the writers of the backends
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if f.fails > 0 {
+		f.fails--
+		return errors.New("unavailable")
+	}
+	f.batches = append(f.batches, entries)
+	return nil
+}
+
+func (f *fakeBatchWriter) Close() error {
+	return nil
+}
+
+func TestParseBufferOptions(t *testing.T) {
+	opts, err := ParseBufferOptions(map[string]string{"mode": "blocking", "batch-size": "10", "batch-wait": "200ms"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !opts.Blocking || opts.BatchSize != 10 || opts.BatchWait != 200*time.Millisecond || opts.BufferSize != 10000 {
+		t.Errorf("unexpected options %+v", opts)
+	}
+	for _, cfg := range []map[string]string{
+		{"mode": "foo"},
+		{"buffer-size": "0"},
+		{"batch-size": "-1"},
+		{"batch-wait": "1"},
+	} {
+		if _, err := ParseBufferOptions(cfg); err == nil {
+			t.Errorf("expected an error for %v", cfg)
+		}
+	}
+	if err := ValidateOptions("test", map[string]string{"url": "x", "foo": "bar"}, "url"); err == nil {
+		t.Errorf("expected an error for unknown option")
+	}
+}
+
+func TestBufferedLogger(t *testing.T) {
+	w := &fakeBatchWriter{fails: 1}
+	l := NewBufferedLogger("test", w, BufferOptions{BufferSize: 100, BatchSize: 2, BatchWait: time.Hour, MaxRetries: 3})
+	msg := &Message{Line: []byte("hello"), Source: "stdout", Timestamp: time.Now()}
+	for i := 0; i < 3; i++ {
+		if err := l.Log(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the message is reused by the copier
+	msg.Line[0] = 'j'
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, batch := range w.batches {
+		for _, entry := range batch {
+			lines = append(lines, string(entry.Line))
+		}
+	}
+	if len(w.batches) != 2 || len(lines) != 3 || lines[0] != "hello" {
+		t.Errorf("expected 3 lines in 2 batches, but got %d batches: %v", len(w.batches), lines)
+	}
+}
+
+func TestBufferedLoggerCloseWhileRetrying(t *testing.T) {
+	w := &fakeBatchWriter{fails: 1}
+	l := NewBufferedLogger("test", w, BufferOptions{BufferSize: 100, BatchSize: 1, BatchWait: time.Hour, MaxRetries: 3})
+	if err := l.Log(&Message{Line: []byte("hello"), Timestamp: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	// close in the backoff of the first retry
+	time.Sleep(100 * time.Millisecond)
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(w.batches) != 1 || string(w.batches[0][0].Line) != "hello" {
+		t.Errorf("expected the batch to be sent when the logger is closed, but got %d batches", len(w.batches))
+	}
+}
+
+func TestBufferedLoggerNonBlocking(t *testing.T) {
+	w := &fakeBatchWriter{}
+	l := &BufferedLogger{name: "test", writer: w, opts: BufferOptions{BufferSize: 1}, entries: make(chan *Entry, 1)}
+	l.ctx, l.cancel = context.WithCancel(context.Background())
+	msg := &Message{Line: []byte("hello")}
+	if err := l.Log(msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Log(msg); err == nil {
+		t.Errorf("expected the entry to be dropped when the buffer is full")
+	}
+}
@@ -0,0 +1,238 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/goodrain/rainbond/node/nodem/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const name = "elasticsearch"
+
+func init() {
+	if err := logger.RegisterLogDriver(name, New); err != nil {
+		logrus.Fatal(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, ValidateLogOpt); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// ValidateLogOpt looks for elasticsearch specific log options
+func ValidateLogOpt(cfg map[string]string) error {
+	if err := logger.ValidateOptions(name, cfg, "es-url", "es-index", "es-username", "es-password", "es-timeout", "labels", "env"); err != nil {
+		return err
+	}
+	if cfg["es-url"] == "" {
+		return fmt.Errorf("es-url is required")
+	}
+	for _, address := range strings.Split(cfg["es-url"], ",") {
+		if u, err := url.Parse(strings.TrimSpace(address)); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("es-url must be comma separated urls, such as http://es-0:9200,http://es-1:9200")
+		}
+	}
+	if timeout := cfg["es-timeout"]; timeout != "" {
+		if _, err := time.ParseDuration(timeout); err != nil {
+			return fmt.Errorf("parse es-timeout: %v", err)
+		}
+	}
+	return nil
+}
+
+// document the document of a log line
+type document struct {
+	Timestamp     string            `json:"@timestamp"`
+	Message       string            `json:"message"`
+	Source        string            `json:"source,omitempty"`
+	TenantID      string            `json:"tenant_id"`
+	ServiceID     string            `json:"service_id"`
+	ContainerID   string            `json:"container_id"`
+	ContainerName string            `json:"container_name"`
+	Host          string            `json:"host,omitempty"`
+	Attrs         map[string]string `json:"attrs,omitempty"`
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error,omitempty"`
+	} `json:"items"`
+}
+
+// writer writes the log entries by the bulk api of elasticsearch
+type writer struct {
+	client    *http.Client
+	addresses []string
+	next      uint32
+	index     string
+	username  string
+	password  string
+	template  document
+}
+
+// New creates an elasticsearch logger
+func New(info logger.Info) (logger.Logger, error) {
+	if err := ValidateLogOpt(info.Config); err != nil {
+		return nil, err
+	}
+	opts, err := logger.ParseBufferOptions(info.Config)
+	if err != nil {
+		return nil, err
+	}
+	timeout := 10 * time.Second
+	if info.Config["es-timeout"] != "" {
+		timeout, _ = time.ParseDuration(info.Config["es-timeout"])
+	}
+	w := &writer{
+		client:   &http.Client{Timeout: timeout},
+		index:    info.Config["es-index"],
+		username: info.Config["es-username"],
+		password: info.Config["es-password"],
+		template: document{
+			TenantID:      info.Env("TENANT_ID", "default"),
+			ServiceID:     info.Env("SERVICE_ID", "default"),
+			ContainerID:   info.ContainerID,
+			ContainerName: info.Name(),
+			Attrs:         info.ExtraAttributes(nil),
+		},
+	}
+	if w.index == "" {
+		w.index = "rainbond-logs"
+	}
+	w.template.Host, _ = info.Hostname()
+	for _, address := range strings.Split(info.Config["es-url"], ",") {
+		w.addresses = append(w.addresses, strings.TrimSuffix(strings.TrimSpace(address), "/")+"/_bulk")
+	}
+	return logger.NewBufferedLogger(name, w, opts), nil
+}
+
+// indexName the daily index of the entry, such as rainbond-logs-2022.03.04
+func (w *writer) indexName(t time.Time) string {
+	return w.index + "-" + t.UTC().Format("2006.01.02")
+}
+
+// buildBody builds the ndjson body of the bulk request. The id of a document is the hash
+// of its content, so the documents written before a retry are not duplicated.
+func (w *writer) buildBody(entries []*logger.Entry) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, entry := range entries {
+		doc := w.template
+		doc.Timestamp = entry.Timestamp.UTC().Format(time.RFC3339Nano)
+		doc.Message = strings.TrimSuffix(string(entry.Line), "\n")
+		doc.Source = entry.Source
+		sum := sha1.Sum([]byte(doc.ContainerID + doc.Timestamp + doc.Source + doc.Message))
+		action := map[string]map[string]string{
+			"create": {"_index": w.indexName(entry.Timestamp), "_id": hex.EncodeToString(sum[:])},
+		}
+		if err := enc.Encode(action); err != nil {
+			return nil, err
+		}
+		if err := enc.Encode(&doc); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteBatch writes the entries by a bulk request, the elasticsearch nodes are used in turn
+func (w *writer) WriteBatch(ctx context.Context, entries []*logger.Entry) error {
+	body, err := w.buildBody(entries)
+	if err != nil {
+		return err
+	}
+	address := w.addresses[int(atomic.AddUint32(&w.next, 1))%len(w.addresses)]
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if w.username != "" {
+		req.SetBasicAuth(w.username, w.password)
+	}
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("elasticsearch returns status %d: %s", res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	var bulkRes bulkResponse
+	if err := json.NewDecoder(res.Body).Decode(&bulkRes); err != nil {
+		return fmt.Errorf("decode bulk response: %v", err)
+	}
+	return checkBulkResponse(&bulkRes)
+}
+
+// checkBulkResponse returns an error if some documents should be retried. The conflict means
+// the document has been written, and the other client errors can not be fixed by a retry.
+func checkBulkResponse(res *bulkResponse) error {
+	if !res.Errors {
+		return nil
+	}
+	var retry, failed int
+	var reason string
+	for _, item := range res.Items {
+		for _, result := range item {
+			switch {
+			case result.Status < 300 || result.Status == http.StatusConflict:
+			case result.Status == http.StatusTooManyRequests || result.Status >= 500:
+				retry++
+			default:
+				failed++
+				if result.Error != nil && reason == "" {
+					reason = result.Error.Type + ": " + result.Error.Reason
+				}
+			}
+		}
+	}
+	if failed > 0 {
+		logrus.Warningf("elasticsearch rejects %d log documents: %s", failed, reason)
+	}
+	if retry > 0 {
+		return fmt.Errorf("elasticsearch can not write %d log documents now", retry)
+	}
+	return nil
+}
+
+// Close closes the idle connections
+func (w *writer) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
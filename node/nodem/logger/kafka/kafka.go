@@ -0,0 +1,186 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/goodrain/rainbond/node/nodem/logger"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+)
+
+const name = "kafka"
+
+func init() {
+	if err := logger.RegisterLogDriver(name, New); err != nil {
+		logrus.Fatal(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, ValidateLogOpt); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// ValidateLogOpt looks for kafka specific log options
+func ValidateLogOpt(cfg map[string]string) error {
+	if err := logger.ValidateOptions(name, cfg, "kafka-brokers", "kafka-topic", "kafka-required-acks",
+		"kafka-timeout", "kafka-client-id", "labels", "env"); err != nil {
+		return err
+	}
+	if cfg["kafka-brokers"] == "" || cfg["kafka-topic"] == "" {
+		return fmt.Errorf("kafka-brokers and kafka-topic are required")
+	}
+	for _, address := range strings.Split(cfg["kafka-brokers"], ",") {
+		if _, _, err := net.SplitHostPort(strings.TrimSpace(address)); err != nil {
+			return fmt.Errorf("kafka-brokers must be comma separated addresses, such as kafka-0:9092,kafka-1:9092")
+		}
+	}
+	if _, err := parseAcks(cfg["kafka-required-acks"]); err != nil {
+		return err
+	}
+	if timeout := cfg["kafka-timeout"]; timeout != "" {
+		if _, err := time.ParseDuration(timeout); err != nil {
+			return fmt.Errorf("parse kafka-timeout: %v", err)
+		}
+	}
+	return nil
+}
+
+// parseAcks parses the required acks, 0 for no ack, 1 for the leader and all(-1) for all the in-sync replicas
+func parseAcks(acks string) (kafkago.RequiredAcks, error) {
+	switch acks {
+	case "", "1":
+		return kafkago.RequireOne, nil
+	case "0":
+		return kafkago.RequireNone, nil
+	case "all", "-1":
+		return kafkago.RequireAll, nil
+	}
+	return kafkago.RequireNone, fmt.Errorf("kafka-required-acks must be one of 0, 1 and all")
+}
+
+// record the value of a log message
+type record struct {
+	Timestamp     string            `json:"@timestamp"`
+	Message       string            `json:"message"`
+	Source        string            `json:"source,omitempty"`
+	TenantID      string            `json:"tenant_id"`
+	ServiceID     string            `json:"service_id"`
+	ContainerID   string            `json:"container_id"`
+	ContainerName string            `json:"container_name"`
+	Host          string            `json:"host,omitempty"`
+	Attrs         map[string]string `json:"attrs,omitempty"`
+}
+
+// writer produces the log entries to the topic. The entries are keyed by the container id,
+// so the logs of a container are produced to the same partition and keep in order.
+type writer struct {
+	template record
+	producer *kafkago.Writer
+}
+
+// New creates a kafka logger
+func New(info logger.Info) (logger.Logger, error) {
+	if err := ValidateLogOpt(info.Config); err != nil {
+		return nil, err
+	}
+	opts, err := logger.ParseBufferOptions(info.Config)
+	if err != nil {
+		return nil, err
+	}
+	var brokers []string
+	for _, address := range strings.Split(info.Config["kafka-brokers"], ",") {
+		brokers = append(brokers, strings.TrimSpace(address))
+	}
+	acks, _ := parseAcks(info.Config["kafka-required-acks"])
+	timeout := 10 * time.Second
+	if info.Config["kafka-timeout"] != "" {
+		timeout, _ = time.ParseDuration(info.Config["kafka-timeout"])
+	}
+	clientID := info.Config["kafka-client-id"]
+	if clientID == "" {
+		clientID = "rainbond-node"
+	}
+	w := &writer{
+		template: record{
+			TenantID:      info.Env("TENANT_ID", "default"),
+			ServiceID:     info.Env("SERVICE_ID", "default"),
+			ContainerID:   info.ContainerID,
+			ContainerName: info.Name(),
+			Attrs:         info.ExtraAttributes(nil),
+		},
+		producer: &kafkago.Writer{
+			Addr:         kafkago.TCP(brokers...),
+			Topic:        info.Config["kafka-topic"],
+			Balancer:     &kafkago.Hash{},
+			RequiredAcks: acks,
+			ReadTimeout:  timeout,
+			WriteTimeout: timeout,
+			// the buffered logger batches and retries the entries
+			MaxAttempts:  1,
+			BatchSize:    opts.BatchSize,
+			BatchTimeout: 10 * time.Millisecond,
+			Transport: &kafkago.Transport{
+				ClientID:    clientID,
+				DialTimeout: timeout,
+			},
+		},
+	}
+	w.template.Host, _ = info.Hostname()
+	return logger.NewBufferedLogger(name, w, opts), nil
+}
+
+func (w *writer) messages(entries []*logger.Entry) ([]kafkago.Message, error) {
+	messages := make([]kafkago.Message, 0, len(entries))
+	for _, entry := range entries {
+		r := w.template
+		r.Timestamp = entry.Timestamp.UTC().Format(time.RFC3339Nano)
+		r.Message = strings.TrimSuffix(string(entry.Line), "\n")
+		r.Source = entry.Source
+		value, err := json.Marshal(&r)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, kafkago.Message{
+			Key:   []byte(w.template.ContainerID),
+			Value: value,
+			Time:  entry.Timestamp,
+		})
+	}
+	return messages, nil
+}
+
+// WriteBatch produces the entries and waits for the acks
+func (w *writer) WriteBatch(ctx context.Context, entries []*logger.Entry) error {
+	messages, err := w.messages(entries)
+	if err != nil {
+		return err
+	}
+	return w.producer.WriteMessages(ctx, messages...)
+}
+
+// Close closes the producer
+func (w *writer) Close() error {
+	return w.producer.Close()
+}
@@ -0,0 +1,94 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package kafka
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/goodrain/rainbond/node/nodem/logger"
+)
+
+func TestValidateLogOpt(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     map[string]string
+		wantErr bool
+	}{
+		{name: "valid", cfg: map[string]string{"kafka-brokers": "kafka-0:9092, kafka-1:9092", "kafka-topic": "logs", "kafka-required-acks": "all", "kafka-timeout": "5s"}},
+		{name: "no topic", cfg: map[string]string{"kafka-brokers": "kafka-0:9092"}, wantErr: true},
+		{name: "no port", cfg: map[string]string{"kafka-brokers": "kafka-0", "kafka-topic": "logs"}, wantErr: true},
+		{name: "invalid acks", cfg: map[string]string{"kafka-brokers": "kafka-0:9092", "kafka-topic": "logs", "kafka-required-acks": "2"}, wantErr: true},
+		{name: "invalid timeout", cfg: map[string]string{"kafka-brokers": "kafka-0:9092", "kafka-topic": "logs", "kafka-timeout": "5"}, wantErr: true},
+		{name: "unknown option", cfg: map[string]string{"kafka-brokers": "kafka-0:9092", "kafka-topic": "logs", "kafka-sasl": "plain"}, wantErr: true},
+	}
+	for _, tc := range tests {
+		if err := ValidateLogOpt(tc.cfg); (err != nil) != tc.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", tc.name, tc.wantErr, err)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	l, err := New(logger.Info{
+		Config:      map[string]string{"kafka-brokers": "kafka-0:9092,kafka-1:9092", "kafka-topic": "logs", "kafka-required-acks": "0"},
+		ContainerID: "c1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	w := l.(*logger.BufferedLogger)
+	if w.Name() != name {
+		t.Errorf("expected logger %s, but got %s", name, w.Name())
+	}
+}
+
+func TestMessages(t *testing.T) {
+	now := time.Date(2022, 3, 4, 10, 30, 15, 0, time.UTC)
+	w := &writer{
+		template: record{TenantID: "t1", ServiceID: "s1", ContainerID: "c1", ContainerName: "app"},
+	}
+	messages, err := w.messages([]*logger.Entry{
+		{Line: []byte("hello\n"), Source: "stdout", Timestamp: now},
+		{Line: []byte("world"), Source: "stderr", Timestamp: now.Add(time.Second)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, but got %d", len(messages))
+	}
+	for _, m := range messages {
+		if string(m.Key) != "c1" {
+			t.Errorf("expected the messages keyed by the container id, but got %s", m.Key)
+		}
+	}
+	var r record
+	if err := json.Unmarshal(messages[0].Value, &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Message != "hello" || r.Source != "stdout" || r.ServiceID != "s1" || r.Timestamp != "2022-03-04T10:30:15Z" {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if !messages[1].Time.Equal(now.Add(time.Second)) {
+		t.Errorf("expected the message time %s, but got %s", now.Add(time.Second), messages[1].Time)
+	}
+}
@@ -127,3 +127,16 @@ func (info *Info) ImageFullID() string {
 func (info *Info) ImageName() string {
 	return info.ContainerImageName
 }
+
+// Env returns the value of the container environment variable, or def if it is not set.
+func (info *Info) Env(key, def string) string {
+	prefix := key + "="
+	for _, e := range info.ContainerEnv {
+		if strings.HasPrefix(e, prefix) {
+			if v := e[len(prefix):]; v != "" {
+				return v
+			}
+		}
+	}
+	return def
+}
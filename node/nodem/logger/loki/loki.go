@@ -0,0 +1,174 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package loki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goodrain/rainbond/node/nodem/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const name = "loki"
+
+func init() {
+	if err := logger.RegisterLogDriver(name, New); err != nil {
+		logrus.Fatal(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, ValidateLogOpt); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// ValidateLogOpt looks for loki specific log options
+func ValidateLogOpt(cfg map[string]string) error {
+	if err := logger.ValidateOptions(name, cfg, "loki-url", "loki-tenant-id", "loki-timeout", "labels", "env"); err != nil {
+		return err
+	}
+	if u, err := url.Parse(cfg["loki-url"]); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("loki-url must be a valid url, such as http://loki:3100")
+	}
+	if timeout := cfg["loki-timeout"]; timeout != "" {
+		if _, err := time.ParseDuration(timeout); err != nil {
+			return fmt.Errorf("parse loki-timeout: %v", err)
+		}
+	}
+	return nil
+}
+
+type stream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type pushRequest struct {
+	Streams []*stream `json:"streams"`
+}
+
+// writer pushes the log entries to the push api of loki
+type writer struct {
+	client   *http.Client
+	url      string
+	tenantID string
+	labels   map[string]string
+}
+
+// New creates a loki logger
+func New(info logger.Info) (logger.Logger, error) {
+	if err := ValidateLogOpt(info.Config); err != nil {
+		return nil, err
+	}
+	opts, err := logger.ParseBufferOptions(info.Config)
+	if err != nil {
+		return nil, err
+	}
+	timeout := 10 * time.Second
+	if info.Config["loki-timeout"] != "" {
+		timeout, _ = time.ParseDuration(info.Config["loki-timeout"])
+	}
+	w := &writer{
+		client:   &http.Client{Timeout: timeout},
+		url:      strings.TrimSuffix(info.Config["loki-url"], "/") + "/loki/api/v1/push",
+		tenantID: info.Config["loki-tenant-id"],
+		labels:   streamLabels(info),
+	}
+	return logger.NewBufferedLogger(name, w, opts), nil
+}
+
+// streamLabels the labels of the log streams of the container. Loki indexes the labels,
+// so they should be low cardinality.
+func streamLabels(info logger.Info) map[string]string {
+	labels := info.ExtraAttributes(func(key string) string {
+		return strings.NewReplacer(".", "_", "-", "_", "/", "_").Replace(key)
+	})
+	labels["tenant_id"] = info.Env("TENANT_ID", "default")
+	labels["service_id"] = info.Env("SERVICE_ID", "default")
+	labels["container_name"] = info.Name()
+	if hostname, err := info.Hostname(); err == nil {
+		labels["host"] = hostname
+	}
+	return labels
+}
+
+// WriteBatch pushes the entries, the entries of stdout and stderr are in different streams
+func (w *writer) WriteBatch(ctx context.Context, entries []*logger.Entry) error {
+	body, err := json.Marshal(w.buildRequest(entries))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.tenantID != "" {
+		req.Header.Set("X-Scope-OrgID", w.tenantID)
+	}
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("loki returns status %d: %s", res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (w *writer) buildRequest(entries []*logger.Entry) *pushRequest {
+	var req pushRequest
+	streams := make(map[string]*stream)
+	for _, entry := range entries {
+		s, ok := streams[entry.Source]
+		if !ok {
+			labels := make(map[string]string, len(w.labels)+1)
+			for k, v := range w.labels {
+				labels[k] = v
+			}
+			if entry.Source != "" {
+				labels["source"] = entry.Source
+			}
+			s = &stream{Stream: labels}
+			streams[entry.Source] = s
+			req.Streams = append(req.Streams, s)
+		}
+		s.Values = append(s.Values, [2]string{
+			strconv.FormatInt(entry.Timestamp.UnixNano(), 10),
+			strings.TrimSuffix(string(entry.Line), "\n"),
+		})
+	}
+	return &req
+}
+
+// Close closes the idle connections
+func (w *writer) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,287 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package syslog
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goodrain/rainbond/node/nodem/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const name = "syslog"
+
+// the max length of a message over udp, the longer message is truncated.
+// RFC 5424 recommends the receivers to support 2048 bytes and allows them to support up to 8192 bytes,
+// and a udp datagram can not carry more than 65507 bytes anyway.
+const maxUDPMessageSize = 8 * 1024
+
+var facilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// the severities of the log sources
+const (
+	severityError = 3
+	severityInfo  = 6
+)
+
+func init() {
+	if err := logger.RegisterLogDriver(name, New); err != nil {
+		logrus.Fatal(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, ValidateLogOpt); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// ValidateLogOpt looks for syslog specific log options
+func ValidateLogOpt(cfg map[string]string) error {
+	if err := logger.ValidateOptions(name, cfg, "syslog-address", "syslog-facility", "syslog-tag",
+		"syslog-tls-ca-cert", "syslog-tls-cert", "syslog-tls-key", "syslog-tls-skip-verify", "labels", "env"); err != nil {
+		return err
+	}
+	if _, _, err := parseAddress(cfg["syslog-address"]); err != nil {
+		return err
+	}
+	if facility := cfg["syslog-facility"]; facility != "" {
+		if _, ok := facilities[facility]; !ok {
+			return fmt.Errorf("unknown syslog-facility %s", facility)
+		}
+	}
+	if (cfg["syslog-tls-cert"] == "") != (cfg["syslog-tls-key"] == "") {
+		return fmt.Errorf("syslog-tls-cert and syslog-tls-key must be set together")
+	}
+	if skip := cfg["syslog-tls-skip-verify"]; skip != "" {
+		if _, err := strconv.ParseBool(skip); err != nil {
+			return fmt.Errorf("syslog-tls-skip-verify must be a bool")
+		}
+	}
+	return nil
+}
+
+// parseAddress parses the address, such as udp://syslog:514, tcp://syslog:601 and tcp+tls://syslog:6514
+func parseAddress(address string) (string, string, error) {
+	u, err := url.Parse(address)
+	if err != nil || u.Host == "" {
+		return "", "", fmt.Errorf("syslog-address must be an address such as udp://host:514, tcp://host:601 or tcp+tls://host:6514")
+	}
+	switch u.Scheme {
+	case "udp", "tcp", "tcp+tls":
+	default:
+		return "", "", fmt.Errorf("syslog-address: unsupported protocol %s", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		port := "514"
+		if u.Scheme == "tcp+tls" {
+			port = "6514"
+		}
+		host = net.JoinHostPort(host, port)
+	}
+	return u.Scheme, host, nil
+}
+
+// writer writes the log entries to the syslog server in RFC5424 format.
+// The messages over tcp are framed by octet counting(RFC6587).
+type writer struct {
+	protocol  string
+	address   string
+	tlsConfig *tls.Config
+	facility  int
+	hostname  string
+	tag       string
+	procID    string
+	conn      net.Conn
+}
+
+// New creates a syslog logger
+func New(info logger.Info) (logger.Logger, error) {
+	if err := ValidateLogOpt(info.Config); err != nil {
+		return nil, err
+	}
+	opts, err := logger.ParseBufferOptions(info.Config)
+	if err != nil {
+		return nil, err
+	}
+	protocol, address, _ := parseAddress(info.Config["syslog-address"])
+	w := &writer{
+		protocol: protocol,
+		address:  address,
+		facility: facilities["daemon"],
+		tag:      info.Config["syslog-tag"],
+		procID:   info.ID(),
+	}
+	if facility := info.Config["syslog-facility"]; facility != "" {
+		w.facility = facilities[facility]
+	}
+	if w.tag == "" {
+		w.tag = info.Env("SERVICE_NAME", info.Name())
+	}
+	if w.hostname, err = info.Hostname(); err != nil {
+		w.hostname = "-"
+	}
+	if protocol == "tcp+tls" {
+		if w.tlsConfig, err = tlsConfig(info.Config); err != nil {
+			return nil, err
+		}
+	}
+	return logger.NewBufferedLogger(name, w, opts), nil
+}
+
+func tlsConfig(cfg map[string]string) (*tls.Config, error) {
+	config := &tls.Config{}
+	config.InsecureSkipVerify, _ = strconv.ParseBool(cfg["syslog-tls-skip-verify"])
+	if ca := cfg["syslog-tls-ca-cert"]; ca != "" {
+		pem, err := ioutil.ReadFile(ca)
+		if err != nil {
+			return nil, fmt.Errorf("read syslog-tls-ca-cert: %v", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate in syslog-tls-ca-cert %s", ca)
+		}
+	}
+	if cert := cfg["syslog-tls-cert"]; cert != "" {
+		pair, err := tls.LoadX509KeyPair(cert, cfg["syslog-tls-key"])
+		if err != nil {
+			return nil, fmt.Errorf("load syslog-tls-cert: %v", err)
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+	return config, nil
+}
+
+// format formats the entry as a RFC5424 message:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG, the app name is the tag
+// which is the workload name of the component by default, and the msg id is the source of the log.
+func (w *writer) format(entry *logger.Entry) []byte {
+	severity := severityInfo
+	if entry.Source == "stderr" {
+		severity = severityError
+	}
+	msg := strings.TrimRight(string(entry.Line), "\r\n")
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		w.facility*8+severity,
+		entry.Timestamp.UTC().Format("2006-01-02T15:04:05.000000Z"),
+		headerField(w.hostname, 255),
+		headerField(w.tag, 48),
+		headerField(w.procID, 128),
+		headerField(entry.Source, 32),
+		msg,
+	))
+}
+
+// headerField the printable header field with the max length, "-" is used for the empty field
+func headerField(value string, max int) string {
+	field := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+	if field == "" {
+		return "-"
+	}
+	if len(field) > max {
+		field = field[:max]
+	}
+	return field
+}
+
+func (w *writer) connect(ctx context.Context) (net.Conn, error) {
+	if w.conn != nil {
+		return w.conn, nil
+	}
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	var conn net.Conn
+	var err error
+	switch w.protocol {
+	case "tcp+tls":
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: w.tlsConfig}).DialContext(ctx, "tcp", w.address)
+	default:
+		conn, err = dialer.DialContext(ctx, w.protocol, w.address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	w.conn = conn
+	return conn, nil
+}
+
+// WriteBatch writes the entries, the connection is closed after a failure and
+// a new one is created on the next retry.
+func (w *writer) WriteBatch(ctx context.Context, entries []*logger.Entry) error {
+	conn, err := w.connect(ctx)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetWriteDeadline(deadline)
+	} else {
+		conn.SetWriteDeadline(time.Now().Add(30 * time.Second))
+	}
+	if w.protocol == "udp" {
+		for _, entry := range entries {
+			msg := w.format(entry)
+			if len(msg) > maxUDPMessageSize {
+				msg = msg[:maxUDPMessageSize]
+			}
+			if _, err := conn.Write(msg); err != nil {
+				w.Close()
+				return err
+			}
+		}
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		msg := w.format(entry)
+		buf.WriteString(strconv.Itoa(len(msg)))
+		buf.WriteByte(' ')
+		buf.Write(msg)
+	}
+	if _, err := conn.Write(buf.Bytes()); err != nil {
+		w.Close()
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection
+func (w *writer) Close() error {
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
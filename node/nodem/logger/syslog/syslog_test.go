@@ -0,0 +1,84 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package syslog
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/goodrain/rainbond/node/nodem/logger"
+)
+
+func TestFormat(t *testing.T) {
+	w := &writer{facility: facilities["local0"], hostname: "node-1", tag: "gr123456", procID: "abcdef012345"}
+	entry := &logger.Entry{
+		Line:      []byte("connection refused\n"),
+		Source:    "stderr",
+		Timestamp: time.Date(2022, 3, 4, 10, 30, 15, 123456000, time.UTC),
+	}
+	want := "<131>1 2022-03-04T10:30:15.123456Z node-1 gr123456 abcdef012345 stderr - connection refused"
+	if got := string(w.format(entry)); got != want {
+		t.Errorf("expected %q, but got %q", want, got)
+	}
+}
+
+func TestValidateLogOpt(t *testing.T) {
+	tests := []struct {
+		cfg     map[string]string
+		wantErr bool
+	}{
+		{cfg: map[string]string{"syslog-address": "udp://10.0.0.1"}},
+		{cfg: map[string]string{"syslog-address": "tcp+tls://syslog:6514", "syslog-facility": "local7"}},
+		{cfg: map[string]string{"syslog-address": "http://syslog:514"}, wantErr: true},
+		{cfg: map[string]string{"syslog-address": "tcp://syslog:601", "syslog-facility": "foo"}, wantErr: true},
+		{cfg: map[string]string{"syslog-address": "tcp://syslog:601", "syslog-tls-cert": "/cert.pem"}, wantErr: true},
+		{cfg: map[string]string{"syslog-address": "tcp://syslog:601", "foo": "bar"}, wantErr: true},
+	}
+	for _, tc := range tests {
+		if err := ValidateLogOpt(tc.cfg); (err != nil) != tc.wantErr {
+			t.Errorf("ValidateLogOpt(%v): expected error %v, but got %v", tc.cfg, tc.wantErr, err)
+		}
+	}
+}
+
+func TestWriteBatchUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	w := &writer{protocol: "udp", address: conn.LocalAddr().String(), facility: facilities["local0"], hostname: "node-1", tag: "gr123456", procID: "abcdef012345"}
+	defer w.Close()
+	entry := &logger.Entry{Line: bytes.Repeat([]byte("a"), 100*1024), Source: "stdout", Timestamp: time.Now()}
+	if err := w.WriteBatch(context.Background(), []*logger.Entry{entry}); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 128*1024)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != maxUDPMessageSize {
+		t.Errorf("expected the message truncated to %d bytes, but got %d", maxUDPMessageSize, n)
+	}
+}
@@ -0,0 +1,77 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr bool
+	}{
+		{spec: "* * * * *"},
+		{spec: "0 8 * * 1-5"},
+		{spec: "*/15 0-6,22,23 1,15 jan-jun mon"},
+		{spec: "@daily"},
+		{spec: "5/10 * * * *"},
+		{spec: "* * * *", wantErr: true},
+		{spec: "60 * * * *", wantErr: true},
+		{spec: "* 5-1 * * *", wantErr: true},
+		{spec: "*/0 * * * *", wantErr: true},
+		{spec: "* * 0 * *", wantErr: true},
+		{spec: "* * * foo *", wantErr: true},
+	}
+	for _, tc := range tests {
+		_, err := Parse(tc.spec)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("Parse(%q): expected error %v, but got %v", tc.spec, tc.wantErr, err)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 2022-03-04 is a friday
+	now := time.Date(2022, 3, 4, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "* * * * *", want: time.Date(2022, 3, 4, 10, 31, 0, 0, time.UTC)},
+		{spec: "30 10 * * *", want: time.Date(2022, 3, 5, 10, 30, 0, 0, time.UTC)},
+		{spec: "0 8 * * 1-5", want: time.Date(2022, 3, 7, 8, 0, 0, 0, time.UTC)},
+		{spec: "*/20 * * * *", want: time.Date(2022, 3, 4, 10, 40, 0, 0, time.UTC)},
+		{spec: "0 0 1 * *", want: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 * * 7", want: time.Date(2022, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 10 * 6", want: time.Date(2022, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 30 2 *", want: time.Time{}},
+	}
+	for _, tc := range tests {
+		s, err := Parse(tc.spec)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tc.spec, err)
+		}
+		if got := s.Next(now); !got.Equal(tc.want) {
+			t.Errorf("Next(%q): expected %v, but got %v", tc.spec, tc.want, got)
+		}
+	}
+}
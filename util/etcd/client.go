@@ -45,10 +45,11 @@ var (
 )
 
 // deleteRevKey deletes a key by revision, returning false if key is missing
-func deleteRevKey(ctx context.Context, kv v3.KV, key string, rev int64) (bool, error) {
+// the ops are committed in the same transaction
+func deleteRevKey(ctx context.Context, kv v3.KV, key string, rev int64, ops ...v3.Op) (bool, error) {
 	cmp := v3.Compare(v3.ModRevision(key), "=", rev)
-	req := v3.OpDelete(key)
-	txnresp, err := kv.Txn(ctx).If(cmp).Then(req).Commit()
+	req := append([]v3.Op{v3.OpDelete(key)}, ops...)
+	txnresp, err := kv.Txn(ctx).If(cmp).Then(req...).Commit()
 	if err != nil {
 		return false, err
 	} else if !txnresp.Succeeded {
@@ -57,10 +58,17 @@ func deleteRevKey(ctx context.Context, kv v3.KV, key string, rev int64) (bool, e
 	return true, nil
 }
 
+func claimOps(ops func(val string) []v3.Op, kv *spb.KeyValue) []v3.Op {
+	if ops == nil {
+		return nil
+	}
+	return ops(string(kv.Value))
+}
+
 //claimFirstKey 获取队列第一个key,并从队列删除
-func claimFirstKey(ctx context.Context, kv v3.KV, kvs []*spb.KeyValue) (*spb.KeyValue, error) {
+func claimFirstKey(ctx context.Context, kv v3.KV, kvs []*spb.KeyValue, ops func(val string) []v3.Op) (*spb.KeyValue, error) {
 	for _, k := range kvs {
-		ok, err := deleteRevKey(ctx, kv, string(k.Key), k.ModRevision)
+		ok, err := deleteRevKey(ctx, kv, string(k.Key), k.ModRevision, claimOps(ops, k)...)
 		if err != nil {
 			return nil, err
 		} else if ok {
@@ -48,6 +48,13 @@ func (q *Queue) Enqueue(val string) error {
 // Dequeue returns Enqueue()'d elements in FIFO order. If the
 // queue is empty, Dequeue blocks until elements are available.
 func (q *Queue) Dequeue() (string, error) {
+	return q.DequeueWithOps(nil)
+}
+
+// DequeueWithOps works like Dequeue. The ops built from the element are
+// committed in the same transaction that removes it from the queue, so the
+// element can be moved elsewhere without being lost.
+func (q *Queue) DequeueWithOps(ops func(val string) []v3.Op) (string, error) {
 	for {
 		// TODO: fewer round trips by fetching more than one key
 		resp, err := q.client.Get(q.ctx, q.keyPrefix, v3.WithFirstRev()...)
@@ -55,18 +62,19 @@ func (q *Queue) Dequeue() (string, error) {
 			return "", err
 		}
 
-		kv, err := claimFirstKey(q.ctx, q.client, resp.Kvs)
+		kv, err := claimFirstKey(q.ctx, q.client, resp.Kvs, ops)
 		if err != nil {
 			return "", err
 		} else if kv != nil {
 			return string(kv.Value), nil
 		} else if resp.More {
 			// missed some items, retry to read in more
-			return q.Dequeue()
+			return q.DequeueWithOps(ops)
 		}
 
 		// nothing yet; wait on elements
 		ev, err := WaitPrefixEvents(
+			q.ctx,
 			q.client,
 			q.keyPrefix,
 			resp.Header.Revision,
@@ -83,12 +91,19 @@ func (q *Queue) Dequeue() (string, error) {
 		if ev.Kv == nil {
 			return "", fmt.Errorf("event key value is nil")
 		}
-		ok, err := deleteRevKey(q.ctx, q.client, string(ev.Kv.Key), ev.Kv.ModRevision)
+		ok, err := deleteRevKey(q.ctx, q.client, string(ev.Kv.Key), ev.Kv.ModRevision, claimOps(ops, ev.Kv)...)
 		if err != nil {
 			return "", err
 		} else if !ok {
-			return q.Dequeue()
+			return q.DequeueWithOps(ops)
 		}
 		return string(ev.Kv.Value), err
 	}
 }
+
+// Claim removes the element kv from the queue. The ops built from its value
+// are committed in the same transaction. It returns false if the element
+// has been claimed by others.
+func (q *Queue) Claim(kv *mvccpb.KeyValue, ops func(val string) []v3.Op) (bool, error) {
+	return deleteRevKey(q.ctx, q.client, string(kv.Key), kv.ModRevision, claimOps(ops, kv)...)
+}
@@ -31,25 +31,28 @@ import (
 //ErrNoUpdateForLongTime no update for long time , can reobservation of synchronous data
 var ErrNoUpdateForLongTime = fmt.Errorf("not updated for a long time")
 
-//WaitPrefixEvents WaitPrefixEvents
-func WaitPrefixEvents(c *clientv3.Client, prefix string, rev int64, evs []mvccpb.Event_EventType) (*clientv3.Event, error) {
-	ctx, cancel := context.WithCancel(context.Background())
+//WaitPrefixEvents waits for the events under prefix, it returns the error of ctx once ctx is done
+func WaitPrefixEvents(ctx context.Context, c *clientv3.Client, prefix string, rev int64, evs []mvccpb.Event_EventType) (*clientv3.Event, error) {
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	logrus.Debug("start watch message from etcd queue")
 	wc := clientv3.NewWatcher(c).Watch(ctx, prefix, clientv3.WithPrefix(), clientv3.WithRev(rev))
 	if wc == nil {
 		return nil, ErrNoWatcher
 	}
-	event := waitEvents(wc, evs)
+	event := waitEvents(ctx, wc, evs)
 	if event != nil {
 		return event, nil
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	logrus.Debug("queue watcher sync, because of not updated for a long time")
 	return nil, ErrNoUpdateForLongTime
 }
 
 //waitEvents this will return nil
-func waitEvents(wc clientv3.WatchChan, evs []mvccpb.Event_EventType) *clientv3.Event {
+func waitEvents(ctx context.Context, wc clientv3.WatchChan, evs []mvccpb.Event_EventType) *clientv3.Event {
 	i := 0
 	timer := time.NewTimer(time.Second * 30)
 	defer timer.Stop()
@@ -73,6 +76,8 @@ func waitEvents(wc clientv3.WatchChan, evs []mvccpb.Event_EventType) *clientv3.E
 			}
 		case <-timer.C:
 			return nil
+		case <-ctx.Done():
+			return nil
 		}
 	}
 }
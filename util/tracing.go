@@ -0,0 +1,86 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+//DefaultZipkinCollectorPath the span endpoint of zipkin and the zipkin receiver of otel collector
+const DefaultZipkinCollectorPath = "/api/v2/spans"
+
+//the annotations of the mesh plugin config, which carry the tracing of the app to the xds server
+const (
+	TracingDriverAnnotation    = "tracing_driver"
+	TracingCollectorAnnotation = "tracing_collector"
+	TracingSamplingAnnotation  = "tracing_sampling"
+)
+
+//TracingCollector the address of a trace collector
+type TracingCollector struct {
+	//http or https
+	Scheme string
+	Host   string
+	Port   uint32
+	Path   string
+}
+
+//Address returns host:port of the collector
+func (t *TracingCollector) Address() string {
+	return net.JoinHostPort(t.Host, strconv.Itoa(int(t.Port)))
+}
+
+//URL returns the http url of the collector
+func (t *TracingCollector) URL() string {
+	return fmt.Sprintf("%s://%s%s", t.Scheme, t.Address(), t.Path)
+}
+
+//ParseTracingCollector parse the collector endpoint, supports host:port and http(s)://host:port/path
+func ParseTracingCollector(collector string) (*TracingCollector, error) {
+	collector = strings.TrimSpace(collector)
+	if collector == "" {
+		return nil, fmt.Errorf("collector endpoint can not be empty")
+	}
+	if !strings.Contains(collector, "://") {
+		collector = "http://" + collector
+	}
+	u, err := url.Parse(collector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid collector endpoint %s: %v", collector, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported collector scheme %s", u.Scheme)
+	}
+	host, portStr := u.Hostname(), u.Port()
+	if host == "" {
+		return nil, fmt.Errorf("collector endpoint %s has no host", collector)
+	}
+	if portStr == "" {
+		return nil, fmt.Errorf("collector endpoint %s has no port", collector)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid collector port %s", portStr)
+	}
+	return &TracingCollector{Scheme: u.Scheme, Host: host, Port: uint32(port), Path: u.Path}, nil
+}
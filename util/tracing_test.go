@@ -0,0 +1,57 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTracingCollector(t *testing.T) {
+	tests := []struct {
+		collector string
+		host      string
+		port      uint32
+		path      string
+		wantErr   bool
+	}{
+		{collector: "otel-collector:55678", host: "otel-collector", port: 55678},
+		{collector: "http://zipkin.default:9411/api/v2/spans", host: "zipkin.default", port: 9411, path: "/api/v2/spans"},
+		{collector: "https://[::1]:9411", host: "::1", port: 9411},
+		{collector: "", wantErr: true},
+		{collector: "zipkin", wantErr: true},
+		{collector: "tcp://zipkin:9411", wantErr: true},
+		{collector: "zipkin:70000", wantErr: true},
+	}
+	for _, tc := range tests {
+		c, err := ParseTracingCollector(tc.collector)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("collector %q: want error %v, got %v", tc.collector, tc.wantErr, err)
+		}
+		if tc.wantErr {
+			continue
+		}
+		if c.Host != tc.host || c.Port != tc.port || c.Path != tc.path {
+			t.Errorf("collector %q: got %+v", tc.collector, c)
+		}
+		if !strings.HasPrefix(tc.collector, "http") && c.URL() != "http://"+tc.collector {
+			t.Errorf("collector %q: got url %s", tc.collector, c.URL())
+		}
+	}
+}
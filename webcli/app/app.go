@@ -29,6 +29,7 @@ import (
 	"net/http"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +37,7 @@ import (
 
 	"github.com/barnettZQG/gotty/server"
 	"github.com/barnettZQG/gotty/webtty"
+	"github.com/goodrain/rainbond/util"
 	httputil "github.com/goodrain/rainbond/util/http"
 	k8sutil "github.com/goodrain/rainbond/util/k8s"
 	"github.com/gorilla/websocket"
@@ -85,6 +87,12 @@ type Options struct {
 	RawPreferences  map[string]interface{} `hcl:"preferences"`
 	SessionKey      string                 `hcl:"session_key"`
 	K8SConfPath     string
+	//the sessions are recorded in it, and they are not recorded if it is nil
+	RecordingStore RecordingStore
+	//the terminals in the namespaces of these tenants are read-only, * for all tenants
+	ReadOnlyTenants []string
+	//the token to list and replay the sessions, the session api is disabled if it is empty
+	SessionAPIToken string
 }
 
 //Version -
@@ -111,6 +119,8 @@ type InitMessage struct {
 	ContainerName string `json:"containerName"`
 	Md5           string `json:"Md5"`
 	Namespace     string `json:"namespace"`
+	//User the user of the console opening the terminal, it is signed in Md5 if not empty
+	User string `json:"user"`
 }
 
 func checkSameOrigin(r *http.Request) bool {
@@ -160,6 +170,11 @@ func (app *App) Run() error {
 	wsMux.Handle("/docker_console", wsHandler)
 	wsMux.Handle("/health", health)
 	wsMux.Handle("/metrics", promhttp.Handler())
+	if app.options.RecordingStore != nil && app.options.SessionAPIToken != "" {
+		sessions := &sessionAPI{store: app.options.RecordingStore, token: app.options.SessionAPIToken}
+		wsMux.Handle("/sessions", sessions)
+		wsMux.Handle("/sessions/", sessions)
+	}
 
 	siteHandler = (http.Handler(wsMux))
 
@@ -225,9 +240,7 @@ func (app *App) handleWS(w http.ResponseWriter, r *http.Request) {
 		conn.Close()
 		return
 	}
-	key := init.TenantID + "_" + init.ServiceID + "_" + init.PodName
-	md5 := md5Func(key)
-	if md5 != init.Md5 {
+	if !checkMd5(init) {
 		logrus.Print("Auth is not allowed !")
 		conn.WriteMessage(websocket.TextMessage, []byte("Auth is not allowed!"))
 		conn.Close()
@@ -244,6 +257,19 @@ func (app *App) handleWS(w http.ResponseWriter, r *http.Request) {
 		ExecuteCommandFailed++
 		return
 	}
+	readOnly := app.isReadOnly(init.Namespace)
+	// the session is refused if it can not be recorded
+	var rec *recorder
+	if app.options.RecordingStore != nil {
+		rec, err = app.startRecording(init, containerName, readOnly, r)
+		if err != nil {
+			logrus.Errorf("start recording failure %s", err.Error())
+			conn.WriteMessage(websocket.TextMessage, []byte("record session failure!"))
+			ExecuteCommandFailed++
+			return
+		}
+		defer app.finishRecording(rec)
+	}
 	request := app.NewRequest(init.PodName, init.Namespace, containerName, args)
 	var slave server.Slave
 	slave, err = NewExecContext(request, app.config)
@@ -254,10 +280,15 @@ func (app *App) handleWS(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer slave.Close()
+	if rec != nil {
+		slave = &recordingSlave{Slave: slave, recorder: rec}
+	}
 	opts := []webtty.Option{
 		webtty.WithWindowTitle([]byte(ip)),
 		webtty.WithReconnect(10),
-		webtty.WithPermitWrite(),
+	}
+	if !readOnly {
+		opts = append(opts, webtty.WithPermitWrite())
 	}
 	// create web tty and run
 	tty, err := webtty.New(&WsWrapper{conn}, slave, opts...)
@@ -282,6 +313,65 @@ func (app *App) handleWS(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+//checkMd5 checks the md5 of the init message signed by the console.
+//The user is signed along with the pod, so that the recorded user can not be forged.
+func checkMd5(init InitMessage) bool {
+	key := init.TenantID + "_" + init.ServiceID + "_" + init.PodName
+	if init.User != "" {
+		key += "_" + init.User
+	}
+	return md5Func(key) == init.Md5
+}
+
+//isReadOnly checks the namespace the terminal is opened in
+func (app *App) isReadOnly(namespace string) bool {
+	for _, tenant := range app.options.ReadOnlyTenants {
+		if tenant == "*" || tenant == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func (app *App) startRecording(init InitMessage, containerName string, readOnly bool, r *http.Request) (*recorder, error) {
+	session := &Session{
+		ID:            util.NewUUID(),
+		User:          init.User,
+		TenantID:      init.TenantID,
+		ServiceID:     init.ServiceID,
+		Namespace:     init.Namespace,
+		PodName:       init.PodName,
+		ContainerName: containerName,
+		ClientIP:      r.RemoteAddr,
+		ReadOnly:      readOnly,
+		StartTime:     time.Now(),
+	}
+	if session.User == "" {
+		session.User = "unknown"
+	}
+	w, err := app.options.RecordingStore.Create(session)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := newRecorder(session, w)
+	if err != nil {
+		w.Close()
+		return nil, err
+	}
+	logrus.Infof("user %s opens session %s of %s/%s/%s, read only: %t", session.User, session.ID, session.Namespace, session.PodName, containerName, readOnly)
+	return rec, nil
+}
+
+func (app *App) finishRecording(rec *recorder) {
+	if err := rec.close(); err != nil {
+		logrus.Errorf("close the recording of session %s failure %s", rec.session.ID, err.Error())
+	}
+	if err := app.options.RecordingStore.Finish(rec.session); err != nil {
+		logrus.Errorf("save session %s failure %s", rec.session.ID, err.Error())
+	}
+	logrus.Infof("session %s is closed, %d bytes in, %d bytes out", rec.session.ID, rec.session.BytesIn, rec.session.BytesOut)
+}
+
 //Exit -
 func (app *App) Exit() (firstCall bool) {
 	return true
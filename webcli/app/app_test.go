@@ -25,3 +25,29 @@ import (
 func TestSendCommand(t *testing.T) {
 
 }
+
+func TestCheckMd5(t *testing.T) {
+	init := InitMessage{TenantID: "tenant", ServiceID: "service", PodName: "pod"}
+	init.Md5 = md5Func("tenant_service_pod")
+	if !checkMd5(init) {
+		t.Error("want the message without user authorized")
+	}
+	init.User = "admin"
+	if checkMd5(init) {
+		t.Error("want the forged user refused")
+	}
+	init.Md5 = md5Func("tenant_service_pod_admin")
+	if !checkMd5(init) {
+		t.Error("want the signed user authorized")
+	}
+}
+
+func TestIsReadOnly(t *testing.T) {
+	app := &App{options: &Options{ReadOnlyTenants: []string{"readonly"}}}
+	if !app.isReadOnly("readonly") {
+		t.Error("want the terminals in namespace readonly read-only")
+	}
+	if app.isReadOnly("writable") {
+		t.Error("want the terminals in namespace writable writable")
+	}
+}
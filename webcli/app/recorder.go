@@ -0,0 +1,157 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2014-2020 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/barnettZQG/gotty/server"
+	"github.com/sirupsen/logrus"
+)
+
+//Session the terminal session of a container
+type Session struct {
+	ID            string     `json:"id"`
+	User          string     `json:"user"`
+	TenantID      string     `json:"tenant_id"`
+	ServiceID     string     `json:"service_id"`
+	Namespace     string     `json:"namespace"`
+	PodName       string     `json:"pod_name"`
+	ContainerName string     `json:"container_name"`
+	ClientIP      string     `json:"client_ip"`
+	ReadOnly      bool       `json:"read_only"`
+	StartTime     time.Time  `json:"start_time"`
+	EndTime       *time.Time `json:"end_time,omitempty"`
+	//BytesIn the bytes typed by the user
+	BytesIn int64 `json:"bytes_in"`
+	//BytesOut the bytes output by the container
+	BytesOut int64 `json:"bytes_out"`
+}
+
+//the default size of the terminal before the client resizes it
+const (
+	defaultColumns = 80
+	defaultRows    = 24
+)
+
+//recorder records a session in asciicast v2 format, see https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md
+type recorder struct {
+	lock    sync.Mutex
+	session *Session
+	w       io.WriteCloser
+	//the incomplete utf-8 characters of the input and output
+	pending map[string][]byte
+	err     error
+	closed  bool
+}
+
+func newRecorder(session *Session, w io.WriteCloser) (*recorder, error) {
+	header, err := json.Marshal(map[string]interface{}{
+		"version":   2,
+		"width":     defaultColumns,
+		"height":    defaultRows,
+		"timestamp": session.StartTime.Unix(),
+		"title":     fmt.Sprintf("%s/%s/%s", session.Namespace, session.PodName, session.ContainerName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append(header, '\n')); err != nil {
+		return nil, err
+	}
+	return &recorder{session: session, w: w, pending: make(map[string][]byte)}, nil
+}
+
+//event records the data of the type, o for output, i for input and r for resize
+func (r *recorder) event(typ string, data []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	// the terminal may be read after the session is finished
+	if r.closed {
+		return
+	}
+	switch typ {
+	case "i":
+		r.session.BytesIn += int64(len(data))
+	case "o":
+		r.session.BytesOut += int64(len(data))
+	}
+	data, r.pending[typ] = splitUTF8(append(r.pending[typ], data...))
+	if len(data) == 0 || r.err != nil {
+		return
+	}
+	event, _ := json.Marshal([]interface{}{time.Since(r.session.StartTime).Seconds(), typ, string(data)})
+	if _, r.err = r.w.Write(append(event, '\n')); r.err != nil {
+		logrus.Errorf("record session %s failure %s", r.session.ID, r.err.Error())
+	}
+}
+
+func (r *recorder) close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := time.Now()
+	r.session.EndTime = &now
+	r.closed = true
+	return r.w.Close()
+}
+
+//splitUTF8 splits the incomplete utf-8 character at the end of p, it is recorded with the next data
+func splitUTF8(p []byte) ([]byte, []byte) {
+	for i := 1; i < utf8.UTFMax && i <= len(p); i++ {
+		if utf8.RuneStart(p[len(p)-i]) {
+			if !utf8.FullRune(p[len(p)-i:]) {
+				return p[:len(p)-i], p[len(p)-i:]
+			}
+			break
+		}
+	}
+	return p, nil
+}
+
+//recordingSlave records the input and output of a slave
+type recordingSlave struct {
+	server.Slave
+	recorder *recorder
+}
+
+func (s *recordingSlave) Read(p []byte) (int, error) {
+	n, err := s.Slave.Read(p)
+	if n > 0 {
+		s.recorder.event("o", p[:n])
+	}
+	return n, err
+}
+
+func (s *recordingSlave) Write(p []byte) (int, error) {
+	n, err := s.Slave.Write(p)
+	if n > 0 {
+		s.recorder.event("i", p[:n])
+	}
+	return n, err
+}
+
+func (s *recordingSlave) ResizeTerminal(columns int, rows int) error {
+	s.recorder.event("r", []byte(fmt.Sprintf("%dx%d", columns, rows)))
+	return s.Slave.ResizeTerminal(columns, rows)
+}
@@ -0,0 +1,115 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2014-2020 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestSplitUTF8(t *testing.T) {
+	hello := []byte("你好")
+	tests := []struct {
+		data, complete, rest []byte
+	}{
+		{data: []byte("ls -l\n"), complete: []byte("ls -l\n")},
+		{data: hello, complete: hello},
+		{data: hello[:4], complete: hello[:3], rest: hello[3:4]},
+		{data: hello[:5], complete: hello[:3], rest: hello[3:5]},
+		{data: hello[3:4], complete: nil, rest: hello[3:4]},
+	}
+	for _, tc := range tests {
+		complete, rest := splitUTF8(tc.data)
+		if string(complete) != string(tc.complete) || string(rest) != string(tc.rest) {
+			t.Errorf("split %v: want %v %v, but got %v %v", tc.data, tc.complete, tc.rest, complete, rest)
+		}
+	}
+}
+
+func TestRecordSession(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webcli-recordings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewLocalRecordingStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := &Session{ID: "s1", User: "admin", TenantID: "t1", PodName: "pod", StartTime: time.Now()}
+	w, err := store.Create(session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := newRecorder(session, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hello := []byte("你好\n")
+	rec.event("i", []byte("echo\n"))
+	rec.event("o", hello[:4])
+	rec.event("o", hello[4:])
+	rec.event("r", []byte("120x40"))
+	if err := rec.close(); err != nil {
+		t.Fatal(err)
+	}
+	rec.event("o", []byte("after closed"))
+	if err := store.Finish(session); err != nil {
+		t.Fatal(err)
+	}
+
+	sessions, err := store.List("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].BytesIn != 5 || sessions[0].BytesOut != int64(len(hello)) || sessions[0].EndTime == nil {
+		t.Fatalf("unexpected sessions %+v", sessions)
+	}
+	if _, err := store.Get("t1", "../t1/s1"); err != ErrSessionNotFound {
+		t.Errorf("want session not found, but got %v", err)
+	}
+
+	recording, err := store.Open("t1", "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recording.Close()
+	scanner := bufio.NewScanner(recording)
+	var header map[string]interface{}
+	if !scanner.Scan() || json.Unmarshal(scanner.Bytes(), &header) != nil || header["version"] != float64(2) {
+		t.Fatalf("unexpected header %s", scanner.Text())
+	}
+	want := [][2]string{{"i", "echo\n"}, {"o", "你"}, {"o", "好\n"}, {"r", "120x40"}}
+	for _, w := range want {
+		var event []interface{}
+		if !scanner.Scan() || json.Unmarshal(scanner.Bytes(), &event) != nil || len(event) != 3 {
+			t.Fatalf("unexpected event %s", scanner.Text())
+		}
+		if event[1] != w[0] || event[2] != w[1] {
+			t.Errorf("want event %v, but got %v", w, event)
+		}
+	}
+	if scanner.Scan() {
+		t.Errorf("unexpected event %s", scanner.Text())
+	}
+}
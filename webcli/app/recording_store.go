@@ -0,0 +1,281 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2014-2020 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/goodrain/rainbond/builder/cloudos"
+	"github.com/sirupsen/logrus"
+)
+
+//ErrSessionNotFound the session or its recording is not found
+var ErrSessionNotFound = fmt.Errorf("session not found")
+
+var idRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+//RecordingStore stores the sessions and their recordings, the sessions are grouped by tenant
+type RecordingStore interface {
+	//Create saves a new session and returns the writer of its recording
+	Create(session *Session) (io.WriteCloser, error)
+	//Finish saves the session after its recording is closed
+	Finish(session *Session) error
+	//List returns the sessions of the tenant, or of all tenants if the tenant id is empty, the latest first
+	List(tenantID string) ([]*Session, error)
+	Get(tenantID, sessionID string) (*Session, error)
+	//Open returns the recording of the session
+	Open(tenantID, sessionID string) (io.ReadCloser, error)
+}
+
+func checkSessionID(tenantID, sessionID string) error {
+	if !idRegexp.MatchString(tenantID) || !idRegexp.MatchString(sessionID) {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+//NewLocalRecordingStore stores the recordings in the directory
+func NewLocalRecordingStore(dir string) (RecordingStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &localStore{dir: dir}, nil
+}
+
+type localStore struct {
+	dir string
+}
+
+func (l *localStore) castPath(tenantID, sessionID string) string {
+	return filepath.Join(l.dir, tenantID, sessionID+".cast")
+}
+
+func (l *localStore) sessionPath(tenantID, sessionID string) string {
+	return filepath.Join(l.dir, tenantID, sessionID+".json")
+}
+
+func (l *localStore) Create(session *Session) (io.WriteCloser, error) {
+	if err := checkSessionID(session.TenantID, session.ID); err != nil {
+		return nil, fmt.Errorf("invalid tenant id %q or session id %q", session.TenantID, session.ID)
+	}
+	if err := os.MkdirAll(filepath.Join(l.dir, session.TenantID), 0700); err != nil {
+		return nil, err
+	}
+	if err := l.Finish(session); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(l.castPath(session.TenantID, session.ID), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+}
+
+func (l *localStore) Finish(session *Session) error {
+	body, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(l.sessionPath(session.TenantID, session.ID), body, 0600)
+}
+
+func (l *localStore) List(tenantID string) ([]*Session, error) {
+	pattern := filepath.Join(l.dir, "*", "*.json")
+	if tenantID != "" {
+		if !idRegexp.MatchString(tenantID) {
+			return nil, nil
+		}
+		pattern = filepath.Join(l.dir, tenantID, "*.json")
+	}
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var sessions []*Session
+	for _, file := range files {
+		session, err := readSession(file)
+		if err != nil {
+			logrus.Warningf("read session %s failure %s", file, err.Error())
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	sortSessions(sessions)
+	return sessions, nil
+}
+
+func (l *localStore) Get(tenantID, sessionID string) (*Session, error) {
+	if err := checkSessionID(tenantID, sessionID); err != nil {
+		return nil, err
+	}
+	session, err := readSession(l.sessionPath(tenantID, sessionID))
+	if os.IsNotExist(err) {
+		return nil, ErrSessionNotFound
+	}
+	return session, err
+}
+
+func (l *localStore) Open(tenantID, sessionID string) (io.ReadCloser, error) {
+	if err := checkSessionID(tenantID, sessionID); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(l.castPath(tenantID, sessionID))
+	if os.IsNotExist(err) {
+		return nil, ErrSessionNotFound
+	}
+	return file, err
+}
+
+func readSession(file string) (*Session, error) {
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return parseSession(body)
+}
+
+func parseSession(body []byte) (*Session, error) {
+	var session Session
+	if err := json.Unmarshal(body, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func sortSessions(sessions []*Session) {
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.After(sessions[j].StartTime)
+	})
+}
+
+//NewObjectRecordingStore records the sessions in the local directory, and uploads them to the object storage when they are finished
+func NewObjectRecordingStore(dir, prefix string, oss cloudos.CloudOSer) (RecordingStore, error) {
+	local, err := NewLocalRecordingStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &objectStore{localStore: local.(*localStore), prefix: prefix, oss: oss}, nil
+}
+
+type objectStore struct {
+	*localStore
+	prefix string
+	oss    cloudos.CloudOSer
+}
+
+func (o *objectStore) key(tenantID, name string) string {
+	return path.Join(o.prefix, tenantID, name)
+}
+
+func (o *objectStore) Finish(session *Session) error {
+	if err := o.localStore.Finish(session); err != nil {
+		return err
+	}
+	castPath := o.castPath(session.TenantID, session.ID)
+	sessionPath := o.sessionPath(session.TenantID, session.ID)
+	// the session is uploaded last, so the listed sessions always have their recordings
+	if err := o.oss.PutObject(o.key(session.TenantID, session.ID+".cast"), castPath); err != nil {
+		return fmt.Errorf("upload the recording of session %s: %v", session.ID, err)
+	}
+	if err := o.oss.PutObject(o.key(session.TenantID, session.ID+".json"), sessionPath); err != nil {
+		return fmt.Errorf("upload session %s: %v", session.ID, err)
+	}
+	os.Remove(castPath)
+	os.Remove(sessionPath)
+	return nil
+}
+
+//List returns the active sessions and the ones failed to upload in the local directory, and the ones in the object storage
+func (o *objectStore) List(tenantID string) ([]*Session, error) {
+	if tenantID != "" && !idRegexp.MatchString(tenantID) {
+		return nil, nil
+	}
+	sessions, err := o.localStore.List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.TrimSuffix(o.key(tenantID, ""), "/") + "/"
+	objects, err := o.oss.ListObjects(prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, object := range objects {
+		if !strings.HasSuffix(object.Key, ".json") {
+			continue
+		}
+		body, err := o.download(object.Key)
+		if err != nil {
+			logrus.Warningf("download session %s failure %s", object.Key, err.Error())
+			continue
+		}
+		session, err := parseSession(body)
+		if err != nil {
+			logrus.Warningf("read session %s failure %s", object.Key, err.Error())
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	sortSessions(sessions)
+	return sessions, nil
+}
+
+func (o *objectStore) Get(tenantID, sessionID string) (*Session, error) {
+	session, err := o.localStore.Get(tenantID, sessionID)
+	if err != ErrSessionNotFound {
+		return session, err
+	}
+	body, err := o.download(o.key(tenantID, sessionID+".json"))
+	if err != nil {
+		return nil, err
+	}
+	return parseSession(body)
+}
+
+func (o *objectStore) Open(tenantID, sessionID string) (io.ReadCloser, error) {
+	recording, err := o.localStore.Open(tenantID, sessionID)
+	if err != ErrSessionNotFound {
+		return recording, err
+	}
+	body, err := o.download(o.key(tenantID, sessionID+".cast"))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(body)), nil
+}
+
+//download downloads the object to a temporary file and reads it
+func (o *objectStore) download(key string) ([]byte, error) {
+	tmp, err := ioutil.TempFile("", "webcli-session-")
+	if err != nil {
+		return nil, err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+	if err := o.oss.GetObject(key, tmp.Name()); err != nil {
+		logrus.Debugf("download %s failure %s", key, err.Error())
+		return nil, ErrSessionNotFound
+	}
+	return ioutil.ReadFile(tmp.Name())
+}
@@ -0,0 +1,121 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2014-2020 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	httputil "github.com/goodrain/rainbond/util/http"
+	"github.com/sirupsen/logrus"
+)
+
+//sessionAPI lists the sessions and replays their recordings
+//GET /sessions?tenant_id=&user=&pod_name=&page=&size= lists the sessions, the latest first
+//GET /sessions/{tenant_id}/{session_id} returns the session
+//GET /sessions/{tenant_id}/{session_id}/recording returns the recording in asciicast v2 format
+type sessionAPI struct {
+	store RecordingStore
+	token string
+}
+
+func (s *sessionAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", 405)
+		return
+	}
+	if !s.authorized(r) {
+		httputil.ReturnError(r, w, 401, "unauthorized")
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/sessions"), "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "":
+		s.list(w, r)
+	case len(parts) == 2:
+		session, err := s.store.Get(parts[0], parts[1])
+		if err != nil {
+			s.returnError(w, r, err)
+			return
+		}
+		httputil.ReturnSuccess(r, w, session)
+	case len(parts) == 3 && parts[2] == "recording":
+		recording, err := s.store.Open(parts[0], parts[1])
+		if err != nil {
+			s.returnError(w, r, err)
+			return
+		}
+		defer recording.Close()
+		w.Header().Set("Content-Type", "application/x-asciicast")
+		if _, err := io.Copy(w, recording); err != nil {
+			logrus.Warningf("replay session %s failure %s", parts[1], err.Error())
+		}
+	default:
+		httputil.ReturnError(r, w, 404, "not found")
+	}
+}
+
+func (s *sessionAPI) authorized(r *http.Request) bool {
+	tt := strings.Split(r.Header.Get("Authorization"), " ")
+	return len(tt) == 2 && subtle.ConstantTimeCompare([]byte(tt[1]), []byte(s.token)) == 1
+}
+
+func (s *sessionAPI) list(w http.ResponseWriter, r *http.Request) {
+	sessions, err := s.store.List(r.FormValue("tenant_id"))
+	if err != nil {
+		s.returnError(w, r, err)
+		return
+	}
+	user, podName := r.FormValue("user"), r.FormValue("pod_name")
+	var matched []*Session
+	for _, session := range sessions {
+		if (user == "" || session.User == user) && (podName == "" || session.PodName == podName) {
+			matched = append(matched, session)
+		}
+	}
+	page, err := strconv.Atoi(r.FormValue("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+	size, err := strconv.Atoi(r.FormValue("size"))
+	if err != nil || size <= 0 {
+		size = 10
+	}
+	list := []*Session{}
+	if start := (page - 1) * size; start < len(matched) {
+		end := start + size
+		if end > len(matched) {
+			end = len(matched)
+		}
+		list = matched[start:end]
+	}
+	httputil.ReturnList(r, w, len(matched), page, list)
+}
+
+func (s *sessionAPI) returnError(w http.ResponseWriter, r *http.Request, err error) {
+	if err == ErrSessionNotFound {
+		httputil.ReturnError(r, w, 404, err.Error())
+		return
+	}
+	logrus.Errorf("read sessions failure %s", err.Error())
+	httputil.ReturnError(r, w, 500, "read sessions failure")
+}
@@ -0,0 +1,575 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2014-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/util"
+	"github.com/goodrain/rainbond/worker/appm/f"
+	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	betav1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CanaryLabel marks the kubernetes resources of a canary version.
+// Canary resources do not carry the creater_id label, so the app store never mixes them up with the stable ones.
+const CanaryLabel = "rainbond.io/canary"
+
+// CanaryActionKey the key of AppService.CustomParams which holds the canary action
+const CanaryActionKey = "canary_action"
+
+const (
+	// CanaryActionStart starts the canary version next to the stable version
+	CanaryActionStart = "start"
+	// CanaryActionStep moves the traffic to the next step
+	CanaryActionStep = "step"
+	// CanaryActionPromote replaces the stable version with the canary version
+	CanaryActionPromote = "promote"
+	// CanaryActionAbort removes the canary version
+	CanaryActionAbort = "abort"
+)
+
+type canaryController struct {
+	stopChan     chan struct{}
+	controllerID string
+	appService   v1.AppService
+	manager      *Manager
+	ctx          context.Context
+}
+
+func (s *canaryController) Begin() {
+	defer s.manager.callback(s.controllerID, nil)
+	app := s.appService
+	action := app.CustomParams[CanaryActionKey]
+	release, err := db.GetManager().TenantServiceCanaryReleaseDao().GetByServiceID(app.ServiceID)
+	if err != nil {
+		logrus.Errorf("get canary release of component %s failure %s", app.ServiceID, err.Error())
+		app.Logger.Error(util.Translation("get canary release failure"), event.GetCallbackLoggerOption())
+		return
+	}
+	switch action {
+	case CanaryActionStart:
+		err = s.start(app, release)
+	case CanaryActionStep:
+		err = s.step(app, release)
+	case CanaryActionPromote:
+		err = s.promote(app, release)
+	case CanaryActionAbort:
+		err = s.abort(app, release)
+	default:
+		err = fmt.Errorf("unknown canary action %s", action)
+	}
+	if err != nil {
+		logrus.Errorf("canary %s of component %s failure %s", action, app.ServiceAlias, err.Error())
+		if err == ErrWaitTimeOut {
+			app.Logger.Error(util.Translation("canary release timeout"), event.GetTimeoutLoggerOption())
+			return
+		}
+		app.Logger.Error(fmt.Sprintf("canary %s failure %s", action, err.Error()), event.GetCallbackLoggerOption())
+		return
+	}
+	app.Logger.Info(fmt.Sprintf("canary %s of component %s success", action, app.ServiceAlias), event.GetLastLoggerOption())
+}
+
+func (s *canaryController) Stop() error {
+	close(s.stopChan)
+	return nil
+}
+
+// start creates the canary workload of app and routes the first step of traffic to it.
+func (s *canaryController) start(app v1.AppService, release *dbmodel.TenantServiceCanaryRelease) error {
+	stableApp := s.manager.store.GetAppService(app.ServiceID)
+	if stableApp == nil || stableApp.GetDeployment() == nil {
+		return fmt.Errorf("stable version of component %s is not running", app.ServiceAlias)
+	}
+	deployment := app.GetDeployment()
+	if deployment == nil {
+		return fmt.Errorf("canary release only supports deployment")
+	}
+	app.Logger.Info(fmt.Sprintf("start canary version %s next to stable version %s", release.CanaryVersion, release.StableVersion), event.GetLoggerOption("starting"))
+	canary := newCanaryDeployment(deployment, release.Replicas)
+	if err := s.ensureDeployment(canary); err != nil {
+		return err
+	}
+	for _, svc := range canaryBackends(stableApp) {
+		if err := s.ensureService(newCanaryService(svc)); err != nil {
+			return err
+		}
+	}
+	if err := s.waitCanaryReady(app, canary); err != nil {
+		return err
+	}
+	release.CurrentStep = 0
+	return s.applyStep(stableApp, release)
+}
+
+// step routes the next step of traffic to the canary version.
+func (s *canaryController) step(app v1.AppService, release *dbmodel.TenantServiceCanaryRelease) error {
+	stableApp := s.manager.store.GetAppService(app.ServiceID)
+	if stableApp == nil {
+		return fmt.Errorf("stable version of component %s is not running", app.ServiceAlias)
+	}
+	release.CurrentStep++
+	return s.applyStep(stableApp, release)
+}
+
+func (s *canaryController) applyStep(stableApp *v1.AppService, release *dbmodel.TenantServiceCanaryRelease) error {
+	weights, err := release.StepWeights()
+	if err != nil {
+		return err
+	}
+	if release.CurrentStep >= len(weights) {
+		release.CurrentStep = len(weights) - 1
+	}
+	weight := weights[release.CurrentStep]
+	if err := s.routeTraffic(stableApp, release, weight); err != nil {
+		return err
+	}
+	stableApp.Logger.Info(fmt.Sprintf("canary version %s receives %d%% of the traffic", release.CanaryVersion, weight), event.GetLoggerOption("running"))
+	release.Weight = weight
+	release.Status = dbmodel.CanaryReleaseStatusProgressing.String()
+	// the leader worker moves the release to the next step when it is due
+	release.ScheduleNextStep(time.Now())
+	return db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release)
+}
+
+// routeTraffic splits the traffic of every http rule between the stable and the canary version.
+// The gateway weights the endpoints of a backend pool, so the weights are scaled by the replicas
+// of the other version to keep the split independent of the number of pods.
+func (s *canaryController) routeTraffic(stableApp *v1.AppService, release *dbmodel.TenantServiceCanaryRelease, weight int) error {
+	stableReplicas := stableApp.Replicas
+	if d := stableApp.GetDeployment(); d != nil && d.Spec.Replicas != nil {
+		stableReplicas = int(*d.Spec.Replicas)
+	}
+	stableWeight, canaryWeight := canaryWeights(weight, stableReplicas, release.Replicas)
+	ingresses, betaIngresses := stableApp.GetIngress(true)
+	for _, ing := range ingresses {
+		if isL4Ingress(ing.Annotations) {
+			continue
+		}
+		stable := ing.DeepCopy()
+		if weight >= 100 {
+			// blue/green: the stable rules point to the canary version directly
+			switchIngressBackend(stable, canaryName)
+			delete(stable.Annotations, parser.GetAnnotationWithPrefix("weight"))
+		} else {
+			setIngressWeight(&stable.ObjectMeta, stableWeight)
+		}
+		if _, err := s.manager.client.NetworkingV1().Ingresses(stable.Namespace).Update(s.ctx, stable, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("update ingress %s failure %s", stable.Name, err.Error())
+		}
+		canary := newCanaryIngress(ing, canaryWeight)
+		if weight >= 100 {
+			err := s.manager.client.NetworkingV1().Ingresses(canary.Namespace).Delete(s.ctx, canary.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("delete canary ingress %s failure %s", canary.Name, err.Error())
+			}
+			continue
+		}
+		if err := s.ensureIngress(canary); err != nil {
+			return err
+		}
+	}
+	for _, ing := range betaIngresses {
+		if isL4Ingress(ing.Annotations) {
+			continue
+		}
+		stable := ing.DeepCopy()
+		if weight >= 100 {
+			switchBetaIngressBackend(stable, canaryName)
+			delete(stable.Annotations, parser.GetAnnotationWithPrefix("weight"))
+		} else {
+			setIngressWeight(&stable.ObjectMeta, stableWeight)
+		}
+		if _, err := s.manager.client.NetworkingV1beta1().Ingresses(stable.Namespace).Update(s.ctx, stable, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("update ingress %s failure %s", stable.Name, err.Error())
+		}
+		canary := newCanaryBetaIngress(ing, canaryWeight)
+		if weight >= 100 {
+			err := s.manager.client.NetworkingV1beta1().Ingresses(canary.Namespace).Delete(s.ctx, canary.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("delete canary ingress %s failure %s", canary.Name, err.Error())
+			}
+			continue
+		}
+		if err := s.ensureBetaIngress(canary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// promote upgrades the stable workload to the canary version and removes the canary resources.
+// app must be the component converted from the canary version with the upgrade patch set.
+func (s *canaryController) promote(app v1.AppService, release *dbmodel.TenantServiceCanaryRelease) error {
+	app.Logger.Info(fmt.Sprintf("promote canary version %s", release.CanaryVersion), event.GetLoggerOption("starting"))
+	upgrade := &upgradeController{
+		controllerID: s.controllerID,
+		manager:      s.manager,
+		stopChan:     s.stopChan,
+		ctx:          s.ctx,
+	}
+	if err := upgrade.upgradeOne(app); err != nil {
+		return err
+	}
+	if err := s.deleteCanaryResources(app.GetNamespace(), app.ServiceID); err != nil {
+		return err
+	}
+	release.StableVersion = release.CanaryVersion
+	release.Weight = 100
+	release.Status = dbmodel.CanaryReleaseStatusPromoted.String()
+	release.NextStepTime = nil
+	return db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release)
+}
+
+// abort restores the http rules of the stable version and removes the canary resources.
+// app must be the component converted from the stable version.
+func (s *canaryController) abort(app v1.AppService, release *dbmodel.TenantServiceCanaryRelease) error {
+	app.Logger.Info(fmt.Sprintf("abort canary version %s", release.CanaryVersion), event.GetLoggerOption("starting"))
+	if stableApp := s.manager.store.GetAppService(app.ServiceID); stableApp != nil {
+		oldIngresses, oldBetaIngresses := stableApp.GetIngress(true)
+		newIngresses, newBetaIngresses := app.GetIngress(true)
+		handleErr := func(msg string, err error) error {
+			logrus.Warning(msg)
+			return nil
+		}
+		_ = f.UpgradeIngress(s.manager.client, stableApp, oldIngresses, newIngresses, oldBetaIngresses, newBetaIngresses, handleErr)
+	}
+	if err := s.deleteCanaryResources(app.GetNamespace(), app.ServiceID); err != nil {
+		return err
+	}
+	release.Weight = 0
+	release.Status = dbmodel.CanaryReleaseStatusAborted.String()
+	release.NextStepTime = nil
+	return db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release)
+}
+
+func (s *canaryController) waitCanaryReady(app v1.AppService, deployment *appsv1.Deployment) error {
+	var initTime int32
+	for _, c := range deployment.Spec.Template.Spec.Containers {
+		if c.ReadinessProbe != nil {
+			initTime = c.ReadinessProbe.InitialDelaySeconds
+			break
+		}
+	}
+	timeout := time.Second * time.Duration(40+initTime) * time.Duration(*deployment.Spec.Replicas*2)
+	app.Logger.Info(fmt.Sprintf("waiting canary version ready timeout %ds", int(timeout.Seconds())), map[string]string{"step": "appruntime", "status": "running"})
+	ticker := time.NewTicker(time.Second * 3)
+	defer ticker.Stop()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		current, err := s.manager.client.AppsV1().Deployments(deployment.Namespace).Get(s.ctx, deployment.Name, metav1.GetOptions{})
+		if err == nil && current.Status.ObservedGeneration >= current.Generation &&
+			current.Status.UpdatedReplicas >= *deployment.Spec.Replicas && current.Status.ReadyReplicas >= *deployment.Spec.Replicas {
+			return nil
+		}
+		select {
+		case <-s.stopChan:
+			return ErrWaitCancel
+		case <-timer.C:
+			return ErrWaitTimeOut
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *canaryController) ensureDeployment(deployment *appsv1.Deployment) error {
+	old, err := s.manager.client.AppsV1().Deployments(deployment.Namespace).Get(s.ctx, deployment.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = s.manager.client.AppsV1().Deployments(deployment.Namespace).Create(s.ctx, deployment, metav1.CreateOptions{})
+		return err
+	}
+	deployment.ResourceVersion = old.ResourceVersion
+	_, err = s.manager.client.AppsV1().Deployments(deployment.Namespace).Update(s.ctx, deployment, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *canaryController) ensureService(service *corev1.Service) error {
+	old, err := s.manager.client.CoreV1().Services(service.Namespace).Get(s.ctx, service.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = s.manager.client.CoreV1().Services(service.Namespace).Create(s.ctx, service, metav1.CreateOptions{})
+		return err
+	}
+	old.Spec.Ports = service.Spec.Ports
+	old.Spec.Selector = service.Spec.Selector
+	old.Labels = service.Labels
+	_, err = s.manager.client.CoreV1().Services(service.Namespace).Update(s.ctx, old, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *canaryController) ensureIngress(ingress *networkingv1.Ingress) error {
+	old, err := s.manager.client.NetworkingV1().Ingresses(ingress.Namespace).Get(s.ctx, ingress.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = s.manager.client.NetworkingV1().Ingresses(ingress.Namespace).Create(s.ctx, ingress, metav1.CreateOptions{})
+		return err
+	}
+	ingress.ResourceVersion = old.ResourceVersion
+	_, err = s.manager.client.NetworkingV1().Ingresses(ingress.Namespace).Update(s.ctx, ingress, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *canaryController) ensureBetaIngress(ingress *betav1.Ingress) error {
+	old, err := s.manager.client.NetworkingV1beta1().Ingresses(ingress.Namespace).Get(s.ctx, ingress.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = s.manager.client.NetworkingV1beta1().Ingresses(ingress.Namespace).Create(s.ctx, ingress, metav1.CreateOptions{})
+		return err
+	}
+	ingress.ResourceVersion = old.ResourceVersion
+	_, err = s.manager.client.NetworkingV1beta1().Ingresses(ingress.Namespace).Update(s.ctx, ingress, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *canaryController) deleteCanaryResources(namespace, serviceID string) error {
+	return s.manager.DeleteCanaryResources(s.ctx, namespace, serviceID)
+}
+
+// DeleteCanaryResources removes the canary deployment, services and ingresses of the component.
+func (m *Manager) DeleteCanaryResources(ctx context.Context, namespace, serviceID string) error {
+	selector := fmt.Sprintf("service_id=%s,%s=true", serviceID, CanaryLabel)
+	listOpts := metav1.ListOptions{LabelSelector: selector}
+	if err := m.client.NetworkingV1().Ingresses(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, listOpts); err != nil && !errors.IsNotFound(err) {
+		logrus.Warningf("delete canary ingresses of component %s failure %s", serviceID, err.Error())
+	}
+	if err := m.client.NetworkingV1beta1().Ingresses(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, listOpts); err != nil && !errors.IsNotFound(err) {
+		logrus.Debugf("delete canary beta ingresses of component %s failure %s", serviceID, err.Error())
+	}
+	services, err := m.client.CoreV1().Services(namespace).List(ctx, listOpts)
+	if err != nil {
+		return fmt.Errorf("list canary services failure %s", err.Error())
+	}
+	for _, svc := range services.Items {
+		if err := m.client.CoreV1().Services(namespace).Delete(ctx, svc.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("delete canary service %s failure %s", svc.Name, err.Error())
+		}
+	}
+	if err := m.client.AppsV1().Deployments(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, listOpts); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("delete canary deployment failure %s", err.Error())
+	}
+	return nil
+}
+
+func canaryName(name string) string {
+	return name + "-canary"
+}
+
+// canaryWeights returns the endpoint weights of the stable and the canary version,
+// so that the canary version receives weight percent of the traffic in total.
+func canaryWeights(weight, stableReplicas, canaryReplicas int) (int, int) {
+	if stableReplicas <= 0 {
+		stableReplicas = 1
+	}
+	if canaryReplicas <= 0 {
+		canaryReplicas = 1
+	}
+	if weight <= 0 {
+		return 1, 0
+	}
+	stable, canary := (100-weight)*canaryReplicas, weight*stableReplicas
+	if d := gcd(stable, canary); d > 1 {
+		stable, canary = stable/d, canary/d
+	}
+	return stable, canary
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func isL4Ingress(annotations map[string]string) bool {
+	return annotations[parser.GetAnnotationWithPrefix("l4-enable")] == "true"
+}
+
+func setIngressWeight(meta *metav1.ObjectMeta, weight int) {
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[parser.GetAnnotationWithPrefix("weight")] = strconv.Itoa(weight)
+}
+
+// canaryLabels removes the creater_id label, so the app store ignores the canary resources.
+func canaryLabels(labels map[string]string) map[string]string {
+	newLabels := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		newLabels[k] = v
+	}
+	delete(newLabels, "creater_id")
+	newLabels[CanaryLabel] = "true"
+	return newLabels
+}
+
+func newCanaryDeployment(deployment *appsv1.Deployment, replicas int) *appsv1.Deployment {
+	if replicas <= 0 {
+		replicas = 1
+	}
+	canary := deployment.DeepCopy()
+	canary.Name = canaryName(deployment.Name)
+	canary.ResourceVersion = ""
+	canary.UID = ""
+	canary.Labels = canaryLabels(deployment.Labels)
+	canary.Spec.Replicas = util.Int32(int32(replicas))
+	selector := make(map[string]string, len(deployment.Spec.Selector.MatchLabels))
+	for k, v := range deployment.Spec.Selector.MatchLabels {
+		selector[k] = v
+	}
+	selector["name"] = canaryName(selector["name"])
+	canary.Spec.Selector = &metav1.LabelSelector{MatchLabels: selector}
+	canary.Spec.Template.Labels = canaryLabels(deployment.Spec.Template.Labels)
+	canary.Spec.Template.Labels["name"] = selector["name"]
+	return canary
+}
+
+// canaryBackends returns the services of the stable version which are used by http rules.
+func canaryBackends(stableApp *v1.AppService) []*corev1.Service {
+	backends := make(map[string]struct{})
+	ingresses, betaIngresses := stableApp.GetIngress(true)
+	for _, ing := range ingresses {
+		if isL4Ingress(ing.Annotations) {
+			continue
+		}
+		for _, rule := range ing.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.Service != nil {
+					backends[path.Backend.Service.Name] = struct{}{}
+				}
+			}
+		}
+	}
+	for _, ing := range betaIngresses {
+		if isL4Ingress(ing.Annotations) {
+			continue
+		}
+		for _, rule := range ing.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				backends[path.Backend.ServiceName] = struct{}{}
+			}
+		}
+	}
+	var services []*corev1.Service
+	for _, svc := range stableApp.GetServices(true) {
+		if _, ok := backends[svc.Name]; ok {
+			services = append(services, svc)
+		}
+	}
+	return services
+}
+
+func newCanaryService(service *corev1.Service) *corev1.Service {
+	canary := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        canaryName(service.Name),
+			Namespace:   service.Namespace,
+			Labels:      canaryLabels(service.Labels),
+			Annotations: service.Annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: service.Spec.Ports,
+			Type:  corev1.ServiceTypeClusterIP,
+		},
+	}
+	if service.Spec.Selector != nil {
+		canary.Spec.Selector = make(map[string]string, len(service.Spec.Selector))
+		for k, v := range service.Spec.Selector {
+			canary.Spec.Selector[k] = v
+		}
+		canary.Spec.Selector["name"] = canaryName(canary.Spec.Selector["name"])
+	}
+	return canary
+}
+
+func switchIngressBackend(ing *networkingv1.Ingress, rename func(string) string) {
+	for i := range ing.Spec.Rules {
+		if ing.Spec.Rules[i].HTTP == nil {
+			continue
+		}
+		for j := range ing.Spec.Rules[i].HTTP.Paths {
+			if backend := ing.Spec.Rules[i].HTTP.Paths[j].Backend.Service; backend != nil {
+				backend.Name = rename(backend.Name)
+			}
+		}
+	}
+}
+
+func switchBetaIngressBackend(ing *betav1.Ingress, rename func(string) string) {
+	for i := range ing.Spec.Rules {
+		if ing.Spec.Rules[i].HTTP == nil {
+			continue
+		}
+		for j := range ing.Spec.Rules[i].HTTP.Paths {
+			backend := &ing.Spec.Rules[i].HTTP.Paths[j].Backend
+			backend.ServiceName = rename(backend.ServiceName)
+		}
+	}
+}
+
+func newCanaryIngress(ing *networkingv1.Ingress, weight int) *networkingv1.Ingress {
+	canary := ing.DeepCopy()
+	canary.Name = canaryName(ing.Name)
+	canary.ResourceVersion = ""
+	canary.UID = ""
+	canary.Labels = canaryLabels(ing.Labels)
+	switchIngressBackend(canary, canaryName)
+	setIngressWeight(&canary.ObjectMeta, weight)
+	return canary
+}
+
+func newCanaryBetaIngress(ing *betav1.Ingress, weight int) *betav1.Ingress {
+	canary := ing.DeepCopy()
+	canary.Name = canaryName(ing.Name)
+	canary.ResourceVersion = ""
+	canary.UID = ""
+	canary.Labels = canaryLabels(ing.Labels)
+	switchBetaIngressBackend(canary, canaryName)
+	setIngressWeight(&canary.ObjectMeta, weight)
+	return canary
+}
@@ -0,0 +1,24 @@
+package controller
+
+import "testing"
+
+func TestCanaryWeights(t *testing.T) {
+	tests := []struct {
+		name                           string
+		weight, stableReplicas, canary int
+		wantStable, wantCanary         int
+	}{
+		{name: "no traffic", weight: 0, stableReplicas: 2, canary: 1, wantStable: 1, wantCanary: 0},
+		{name: "same replicas", weight: 10, stableReplicas: 1, canary: 1, wantStable: 9, wantCanary: 1},
+		{name: "more stable replicas", weight: 50, stableReplicas: 3, canary: 1, wantStable: 1, wantCanary: 3},
+		{name: "more canary replicas", weight: 20, stableReplicas: 1, canary: 2, wantStable: 8, wantCanary: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stable, canary := canaryWeights(tt.weight, tt.stableReplicas, tt.canary)
+			if stable != tt.wantStable || canary != tt.wantCanary {
+				t.Errorf("canaryWeights() = %d, %d, want %d, %d", stable, canary, tt.wantStable, tt.wantCanary)
+			}
+		})
+	}
+}
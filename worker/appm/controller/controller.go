@@ -64,6 +64,9 @@ var TypeApplyConfigController TypeController = "apply_config"
 // TypeControllerRefreshHPA -
 var TypeControllerRefreshHPA TypeController = "refreshhpa"
 
+// TypeCanaryController start, promote or abort the canary version of a component
+var TypeCanaryController TypeController = "canary"
+
 //Manager controller manager
 type Manager struct {
 	ctx           context.Context
@@ -171,6 +174,14 @@ func (m *Manager) StartController(controllerType TypeController, apps ...v1.AppS
 			stopChan:     make(chan struct{}),
 			ctx:          context.Background(),
 		}
+	case TypeCanaryController:
+		controller = &canaryController{
+			controllerID: controllerID,
+			appService:   apps[0],
+			manager:      m,
+			stopChan:     make(chan struct{}),
+			ctx:          context.Background(),
+		}
 	default:
 		return fmt.Errorf("No support controller")
 	}
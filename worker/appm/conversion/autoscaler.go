@@ -113,6 +113,79 @@ func createResourceMetrics(metric *model.TenantServiceAutoscalerRuleMetrics) aut
 	return ms
 }
 
+func createMetricTarget(metric *model.TenantServiceAutoscalerRuleMetrics) autoscalingv2.MetricTarget {
+	value := resource.NewQuantity(int64(metric.MetricTargetValue), resource.DecimalSI)
+	if metric.MetricTargetType == model.MetricTargetTypeValue {
+		return autoscalingv2.MetricTarget{
+			Type:  autoscalingv2.ValueMetricType,
+			Value: value,
+		}
+	}
+	return autoscalingv2.MetricTarget{
+		Type:         autoscalingv2.AverageValueMetricType,
+		AverageValue: value,
+	}
+}
+
+func createMetricIdentifier(metric *model.TenantServiceAutoscalerRuleMetrics) (autoscalingv2.MetricIdentifier, error) {
+	identifier := autoscalingv2.MetricIdentifier{
+		Name: metric.MetricsName,
+	}
+	if metric.MetricSelector != "" {
+		selector, err := metav1.ParseToLabelSelector(metric.MetricSelector)
+		if err != nil {
+			return identifier, fmt.Errorf("parse metric selector %s: %v", metric.MetricSelector, err)
+		}
+		identifier.Selector = selector
+	}
+	return identifier, nil
+}
+
+// createCustomMetrics creates the metric spec of pods, object and external metrics
+func createCustomMetrics(metric *model.TenantServiceAutoscalerRuleMetrics) (*autoscalingv2.MetricSpec, error) {
+	if err := metric.Validate(); err != nil {
+		return nil, err
+	}
+	identifier, err := createMetricIdentifier(metric)
+	if err != nil {
+		return nil, err
+	}
+	target := createMetricTarget(metric)
+	switch metric.MetricsType {
+	case model.MetricsTypePods:
+		return &autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: identifier,
+				Target: target,
+			},
+		}, nil
+	case model.MetricsTypeObject:
+		apiVersion, kind, name, _ := metric.ParseDescribedObject()
+		return &autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ObjectMetricSourceType,
+			Object: &autoscalingv2.ObjectMetricSource{
+				DescribedObject: autoscalingv2.CrossVersionObjectReference{
+					APIVersion: apiVersion,
+					Kind:       kind,
+					Name:       name,
+				},
+				Metric: identifier,
+				Target: target,
+			},
+		}, nil
+	case model.MetricsTypeExternal:
+		return &autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: identifier,
+				Target: target,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported metric type: %s", metric.MetricsType)
+}
+
 func newHPA(namespace, kind, name string, labels map[string]string, rule *model.TenantServiceAutoscalerRules, metrics []*model.TenantServiceAutoscalerRuleMetrics) *autoscalingv2.HorizontalPodAutoscaler {
 	hpa := &autoscalingv2.HorizontalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
@@ -133,13 +206,17 @@ func newHPA(namespace, kind, name string, labels map[string]string, rule *model.
 	}
 
 	for _, metric := range metrics {
-		if metric.MetricsType != "resource_metrics" {
-			logrus.Warningf("rule id:  %s; unsupported metric type: %s", rule.RuleID, metric.MetricsType)
+		if metric.MetricsType != model.MetricsTypeResource {
+			ms, err := createCustomMetrics(metric)
+			if err != nil {
+				logrus.Warningf("rule id:  %s; invalid metric %s: %v", rule.RuleID, metric.MetricsName, err)
+				continue
+			}
+			spec.Metrics = append(spec.Metrics, *ms)
 			continue
 		}
 		if metric.MetricTargetValue <= 0 {
 			// TODO: If the target value of cpu and memory is 0, it will not take effect.
-			// TODO: The target value of the custom indicator can be 0.
 			continue
 		}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/goodrain/rainbond/db/model"
 	k8sutil "github.com/goodrain/rainbond/util/k8s"
+	autoscalingv2 "k8s.io/api/autoscaling/v2beta2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -21,6 +22,77 @@ func TestCreateMetricSpec(t *testing.T) {
 	t.Logf("%#v", metricSpec)
 }
 
+func TestCreateCustomMetrics(t *testing.T) {
+	tests := []struct {
+		name    string
+		metric  *model.TenantServiceAutoscalerRuleMetrics
+		want    autoscalingv2.MetricSourceType
+		wantErr bool
+	}{
+		{
+			name: "pods metric",
+			metric: &model.TenantServiceAutoscalerRuleMetrics{
+				MetricsType:       model.MetricsTypePods,
+				MetricsName:       "requests_per_second",
+				MetricTargetType:  model.MetricTargetTypeAverageValue,
+				MetricTargetValue: 100,
+			},
+			want: autoscalingv2.PodsMetricSourceType,
+		},
+		{
+			name: "object metric",
+			metric: &model.TenantServiceAutoscalerRuleMetrics{
+				MetricsType:       model.MetricsTypeObject,
+				MetricsName:       "requests_per_second",
+				MetricTargetType:  model.MetricTargetTypeValue,
+				MetricTargetValue: 1000,
+				DescribedObject:   "networking.k8s.io/v1/Ingress/web",
+			},
+			want: autoscalingv2.ObjectMetricSourceType,
+		},
+		{
+			name: "external metric",
+			metric: &model.TenantServiceAutoscalerRuleMetrics{
+				MetricsType:       model.MetricsTypeExternal,
+				MetricsName:       "queue_depth",
+				MetricTargetType:  model.MetricTargetTypeAverageValue,
+				MetricTargetValue: 30,
+				MetricSelector:    "topic=builder",
+			},
+			want: autoscalingv2.ExternalMetricSourceType,
+		},
+		{
+			name: "object metric without object",
+			metric: &model.TenantServiceAutoscalerRuleMetrics{
+				MetricsType:      model.MetricsTypeObject,
+				MetricsName:      "requests_per_second",
+				MetricTargetType: model.MetricTargetTypeValue,
+			},
+			wantErr: true,
+		},
+		{
+			name: "pods metric with value target",
+			metric: &model.TenantServiceAutoscalerRuleMetrics{
+				MetricsType:      model.MetricsTypePods,
+				MetricsName:      "requests_per_second",
+				MetricTargetType: model.MetricTargetTypeValue,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, err := createCustomMetrics(tt.metric)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("createCustomMetrics() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && ms.Type != tt.want {
+				t.Errorf("createCustomMetrics() type = %s, want %s", ms.Type, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewHPA(t *testing.T) {
 	rule := &model.TenantServiceAutoscalerRules{
 		RuleID:      "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx",
@@ -85,6 +85,11 @@ func InitAppService(dbmanager db.Manager, serviceID string, configs map[string]s
 	if app != nil {
 		appService.AppServiceBase.GovernanceMode = app.GovernanceMode
 		appService.AppServiceBase.K8sApp = app.K8sApp
+		if app.TracingEnabled() {
+			appService.AppServiceBase.TracingDriver = app.TracingDriver
+			appService.AppServiceBase.TracingCollector = app.TracingCollector
+			appService.AppServiceBase.TracingSampling = app.TracingSampling
+		}
 	}
 	if err := TenantServiceBase(appService, dbmanager); err != nil {
 		logrus.Errorf("init component base config failure %s", err.Error())
@@ -133,6 +138,11 @@ func InitCacheAppService(dbm db.Manager, serviceID, creatorID string) (*v1.AppSe
 	if app != nil {
 		appService.AppServiceBase.GovernanceMode = app.GovernanceMode
 		appService.AppServiceBase.K8sApp = app.K8sApp
+		if app.TracingEnabled() {
+			appService.AppServiceBase.TracingDriver = app.TracingDriver
+			appService.AppServiceBase.TracingCollector = app.TracingCollector
+			appService.AppServiceBase.TracingSampling = app.TracingSampling
+		}
 	}
 
 	if err := TenantServiceBase(appService, dbm); err != nil {
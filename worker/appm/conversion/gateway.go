@@ -26,9 +26,13 @@ import (
 
 	"github.com/goodrain/rainbond/db"
 	"github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/gateway/annotations/auth"
+	"github.com/goodrain/rainbond/gateway/annotations/authtls"
 	"github.com/goodrain/rainbond/gateway/annotations/parser"
+	"github.com/goodrain/rainbond/gateway/annotations/proxyssl"
 	"github.com/goodrain/rainbond/util/k8s"
 	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
+	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -278,7 +282,7 @@ func (a *AppServiceBuild) applyHTTPRule(rule *model.HTTPRule, containerPort, plu
 
 	if k8s.IsHighVersion() {
 		ntwIngress := createNtwIngress(domain, path, name, namespace, serviceName, labels, pluginContainerPort)
-		if sec != nil {
+		if sec != nil && sec.Data[corev1.TLSCertKey] != nil {
 			ntwIngress.Spec.TLS = []networkingv1.IngressTLS{
 				{
 					Hosts:      []string{domain},
@@ -292,7 +296,7 @@ func (a *AppServiceBuild) applyHTTPRule(rule *model.HTTPRule, containerPort, plu
 	}
 
 	beatIngress := createBetaIngress(domain, path, name, namespace, serviceName, labels, pluginContainerPort)
-	if sec != nil {
+	if sec != nil && sec.Data[corev1.TLSCertKey] != nil {
 		beatIngress.Spec.TLS = []betav1.IngressTLS{
 			{
 				Hosts:      []string{domain},
@@ -556,6 +560,8 @@ func (a *AppServiceBuild) parseAnnotations(rule *model.HTTPRule) (map[string]str
 	if err != nil {
 		return nil, err
 	}
+	var clientCA bool
+	clientVerify := authtls.VerifyOn
 	for _, extension := range ruleExtensions {
 		switch extension.Key {
 		case string(model.HTTPToHTTPS):
@@ -575,11 +581,40 @@ func (a *AppServiceBuild) parseAnnotations(rule *model.HTTPRule) (map[string]str
 				break
 			}
 			annos[parser.GetAnnotationWithPrefix("lb-type")] = extension.Value
+		case string(model.RateLimitRPS), string(model.RateLimitBurst), string(model.RateLimitKey),
+			string(model.AllowCIDRs), string(model.DenyCIDRs),
+			string(model.AuthType), string(model.AuthRealm), string(model.AuthJWKSURL), string(model.AuthJWTIssuer),
+			string(model.AuthJWTAudience), string(model.AuthJWTClaimHeaders), string(model.AuthURL), string(model.AuthResponseHeaders),
+			string(model.ClientVerifyDepth), string(model.UpstreamProtocol), string(model.UpstreamTLSVerify), string(model.UpstreamTLSServerName):
+			// validated by the annotation parsers of the gateway
+			annos[parser.GetAnnotationWithPrefix(extension.Key)] = extension.Value
+		case string(model.AutoTLS):
+			// the certificate is issued by the acme controller, see worker/master/controller/acme,
+			// and the gateway serves the http-01 challenges of the rule
+			if extension.Value == "true" {
+				annos[parser.GetAnnotationWithPrefix(extension.Key)] = extension.Value
+			}
+		case string(model.AutoTLSChallenge):
+		case string(model.AuthBasicUsers), string(model.AuthJWTKeys):
+			// the users and keys are stored in the secret of the rule, see createSecret
+			annos[parser.GetAnnotationWithPrefix("auth-secret")] = rule.UUID
+		case string(model.ClientCA), string(model.UpstreamTLSCA):
+			// the CA bundles are stored in the secret of the rule, see createSecret
+			annos[parser.GetAnnotationWithPrefix("ca-secret")] = rule.UUID
+			if extension.Key == string(model.ClientCA) {
+				clientCA = true
+			}
+		case string(model.ClientVerify):
+			clientVerify = extension.Value
 
 		default:
 			logrus.Warnf("Unexpected RuleExtension Key: %s", extension.Key)
 		}
 	}
+	// the client certificates are verified only if there is a client CA
+	if clientCA {
+		annos[parser.GetAnnotationWithPrefix("client-verify")] = clientVerify
+	}
 
 	configs, err := db.GetManager().GwRuleConfigDao().ListByRuleID(rule.UUID)
 	if err != nil {
@@ -590,27 +625,56 @@ func (a *AppServiceBuild) parseAnnotations(rule *model.HTTPRule) (map[string]str
 			annos[parser.GetAnnotationWithPrefix(cfg.Key)] = cfg.Value
 		}
 	}
+
+	// the gateway holds the requests while the idle component is waking up
+	idlePolicy, err := a.dbmanager.TenantServiceIdlePolicyDao().GetByServiceID(a.serviceID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if idlePolicy != nil && idlePolicy.Enable && idlePolicy.WakeTimeout > 0 {
+		annos[parser.GetAnnotationWithPrefix("wake-timeout")] = strconv.Itoa(idlePolicy.WakeTimeout)
+	}
 	return annos, nil
 }
 
+// createSecret creates the secret of the certificate, the authentication users and keys and the CA bundles of the rule,
+// returns nil if the rule has none of them.
 func (a *AppServiceBuild) createSecret(rule *model.HTTPRule, name, namespace string, labels map[string]string) (*corev1.Secret, error) {
-	if rule.CertificateID == "" {
-		return nil, nil
+	data := make(map[string][]byte)
+	if rule.CertificateID != "" {
+		cert, err := a.dbmanager.CertificateDao().GetCertificateByID(rule.CertificateID)
+		if err != nil {
+			return nil, fmt.Errorf("cant not get certificate by id(%s): %v", rule.CertificateID, err)
+		}
+		if cert == nil || strings.TrimSpace(cert.Certificate) == "" || strings.TrimSpace(cert.PrivateKey) == "" {
+			return nil, fmt.Errorf("rule id: %s; certificate not found", rule.UUID)
+		}
+		data[corev1.TLSCertKey] = []byte(cert.Certificate)
+		data[corev1.TLSPrivateKeyKey] = []byte(cert.PrivateKey)
 	}
-	cert, err := a.dbmanager.CertificateDao().GetCertificateByID(rule.CertificateID)
+	ruleExtensions, err := a.dbmanager.RuleExtensionDao().GetRuleExtensionByRuleID(rule.UUID)
 	if err != nil {
-		return nil, fmt.Errorf("cant not get certificate by id(%s): %v", rule.CertificateID, err)
+		return nil, err
 	}
-	if cert == nil || strings.TrimSpace(cert.Certificate) == "" || strings.TrimSpace(cert.PrivateKey) == "" {
-		return nil, fmt.Errorf("rule id: %s; certificate not found", rule.UUID)
+	for _, extension := range ruleExtensions {
+		switch extension.Key {
+		case string(model.AuthBasicUsers):
+			data[auth.SecretUsersKey] = []byte(extension.Value)
+		case string(model.AuthJWTKeys):
+			data[auth.SecretJWTKeysKey] = []byte(extension.Value)
+		case string(model.ClientCA):
+			data[authtls.SecretCAKey] = []byte(extension.Value)
+		case string(model.UpstreamTLSCA):
+			data[proxyssl.SecretUpstreamCAKey] = []byte(extension.Value)
+		}
+	}
+	if len(data) == 0 {
+		return nil, nil
 	}
 	return &corev1.Secret{
 		ObjectMeta: createIngressMeta(name, namespace, labels),
-		Data: map[string][]byte{
-			"tls.crt": []byte(cert.Certificate),
-			"tls.key": []byte(cert.PrivateKey),
-		},
-		Type: corev1.SecretTypeOpaque,
+		Data:       data,
+		Type:       corev1.SecretTypeOpaque,
 	}, nil
 }
 
@@ -32,6 +32,7 @@ import (
 	"github.com/goodrain/rainbond/gateway/annotations/parser"
 	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
@@ -196,10 +197,14 @@ func TestApplyTcpRule(t *testing.T) {
 		t.Errorf("Unexpected occurred while creating AppServiceBuild: %v", err)
 	}
 
-	ing, err := build.applyTCPRule(tcpRule, service, testCase["namespace"])
+	obj, err := build.applyTCPRule(tcpRule, service, testCase["namespace"])
 	if err != nil {
 		t.Errorf("Unexpected error occurred while applying stream rule: %v", err)
 	}
+	ing, ok := obj.(*networkingv1.Ingress)
+	if !ok {
+		t.Fatalf("Expected *networkingv1.Ingress but returned %T", obj)
+	}
 
 	if ing.Namespace != testCase["namespace"] {
 		t.Errorf("Expected %s for namespace but returned %s", testCase["namespace"], ing.Namespace)
@@ -225,13 +230,13 @@ func TestApplyTcpRule(t *testing.T) {
 			parser.GetAnnotationWithPrefix("l4-port"),
 			ing.Annotations[parser.GetAnnotationWithPrefix("l4-port")])
 	}
-	if ing.Spec.Backend.ServiceName != testCase["serviceName"] {
+	if ing.Spec.DefaultBackend.Service.Name != testCase["serviceName"] {
 		t.Errorf("Expected %s for ServiceName but returned %s", testCase["serviceName"],
-			ing.Spec.Backend.ServiceName)
+			ing.Spec.DefaultBackend.Service.Name)
 	}
-	if ing.Spec.Backend.ServicePort.IntVal != int32(containerPort) {
+	if ing.Spec.DefaultBackend.Service.Port.Number != int32(containerPort) {
 		t.Errorf("Expected %v for ServicePort but returned %v", containerPort,
-			ing.Spec.Backend.ServicePort)
+			ing.Spec.DefaultBackend.Service.Port.Number)
 	}
 
 	// create k8s resources
@@ -250,7 +255,7 @@ func TestApplyTcpRule(t *testing.T) {
 	}, metav1.CreateOptions{}); err != nil {
 		t.Errorf("Can't create Namespace(%s): %v", testCase["namespace"], err)
 	}
-	if _, err := clientSet.ExtensionsV1beta1().Ingresses(ing.Namespace).Create(context.Background(), ing, metav1.CreateOptions{}); err != nil {
+	if _, err := clientSet.NetworkingV1().Ingresses(ing.Namespace).Create(context.Background(), ing, metav1.CreateOptions{}); err != nil {
 		t.Errorf("Can't create Ingress(%s): %v", ing.Name, err)
 	}
 	if err := clientSet.CoreV1().Namespaces().Delete(context.Background(), testCase["namespace"], metav1.DeleteOptions{}); err != nil {
@@ -347,10 +352,14 @@ func TestAppServiceBuild_ApplyHttpRule(t *testing.T) {
 		},
 	}
 
-	ing, sec, err := build.applyHTTPRule(httpRule, containerPort, 0, service)
+	obj, sec, err := build.applyHTTPRule(httpRule, containerPort, 0, service)
 	if err != nil {
 		t.Errorf("Unexpected error occurred whiling applying http rule: %v", err)
 	}
+	ing, ok := obj.(*networkingv1.Ingress)
+	if !ok {
+		t.Fatalf("Expected *networkingv1.Ingress but returned %T", obj)
+	}
 	if sec != nil {
 		t.Errorf("Expected nil for sec, but returned %v", sec)
 	}
@@ -363,13 +372,13 @@ func TestAppServiceBuild_ApplyHttpRule(t *testing.T) {
 	if ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Path != testCase["path"] {
 		t.Errorf("Expected %s for path, but returned %s", testCase["path"], ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Path)
 	}
-	if ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServiceName != testCase["serviceName"] {
+	if ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Name != testCase["serviceName"] {
 		t.Errorf("Expected %s for serviceName, but returned %s", testCase["serviceName"],
-			ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServiceName)
+			ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Name)
 	}
-	if fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServicePort.IntVal) != testCase["servicePort"] {
+	if fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Port.Number) != testCase["servicePort"] {
 		t.Errorf("Expected %s for servicePort, but returned %s", testCase["servicePort"],
-			fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServicePort.IntVal))
+			fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Port.Number))
 	}
 
 	// create k8s resources
@@ -388,7 +397,7 @@ func TestAppServiceBuild_ApplyHttpRule(t *testing.T) {
 	}, metav1.CreateOptions{}); err != nil {
 		t.Errorf("Can't create Namespace(%s): %v", testCase["namespace"], err)
 	}
-	if _, err := clientSet.ExtensionsV1beta1().Ingresses(ing.Namespace).Create(context.Background(), ing, metav1.CreateOptions{}); err != nil {
+	if _, err := clientSet.NetworkingV1().Ingresses(ing.Namespace).Create(context.Background(), ing, metav1.CreateOptions{}); err != nil {
 		t.Errorf("Can't create Ingress(%s): %v", ing.Name, err)
 	}
 	if err := clientSet.CoreV1().Namespaces().Delete(context.Background(), testCase["namespace"], metav1.DeleteOptions{}); err != nil {
@@ -498,10 +507,14 @@ func TestAppServiceBuild_ApplyHttpRuleWithCertificate(t *testing.T) {
 		},
 	}
 
-	ing, sec, err := build.applyHTTPRule(httpRule, containerPort, 0, service)
+	obj, sec, err := build.applyHTTPRule(httpRule, containerPort, 0, service)
 	if err != nil {
 		t.Errorf("Unexpected error occurred whiling applying http rule: %v", err)
 	}
+	ing, ok := obj.(*networkingv1.Ingress)
+	if !ok {
+		t.Fatalf("Expected *networkingv1.Ingress but returned %T", obj)
+	}
 
 	// create k8s resources
 	c, err := clientcmd.BuildConfigFromFlags("", "/Users/abe/go/src/github.com/goodrain/rainbond/test/admin.kubeconfig")
@@ -522,7 +535,7 @@ func TestAppServiceBuild_ApplyHttpRuleWithCertificate(t *testing.T) {
 	if _, err := clientSet.CoreV1().Secrets(sec.Namespace).Create(context.Background(), sec, metav1.CreateOptions{}); err != nil {
 		t.Errorf("Can't create Serect(%s): %v", sec.Name, err)
 	}
-	if _, err := clientSet.ExtensionsV1beta1().Ingresses(ing.Namespace).Create(context.Background(), ing, metav1.CreateOptions{}); err != nil {
+	if _, err := clientSet.NetworkingV1().Ingresses(ing.Namespace).Create(context.Background(), ing, metav1.CreateOptions{}); err != nil {
 		t.Errorf("Can't create Ingress(%s): %v", ing.Name, err)
 	}
 	if err := clientSet.CoreV1().Namespaces().Delete(context.Background(), testCase["namespace"], metav1.DeleteOptions{}); err != nil {
@@ -538,13 +551,13 @@ func TestAppServiceBuild_ApplyHttpRuleWithCertificate(t *testing.T) {
 	if ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Path != testCase["path"] {
 		t.Errorf("Expected %s for path, but returned %s", testCase["path"], ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Path)
 	}
-	if ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServiceName != testCase["serviceName"] {
+	if ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Name != testCase["serviceName"] {
 		t.Errorf("Expected %s for serviceName, but returned %s", testCase["serviceName"],
-			ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServiceName)
+			ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Name)
 	}
-	if fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServicePort.IntVal) != testCase["servicePort"] {
+	if fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Port.Number) != testCase["servicePort"] {
 		t.Errorf("Expected %s for servicePort, but returned %s", testCase["servicePort"],
-			fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.ServicePort.IntVal))
+			fmt.Sprintf("%v", ing.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Port.Number))
 	}
 	if sec.Namespace != testCase["namespace"] {
 		t.Errorf("Expected %s for namespace, but returned %s", testCase["namespace"], sec.Namespace)
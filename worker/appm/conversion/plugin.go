@@ -328,7 +328,8 @@ func ApplyPluginConfig(as *typesv1.AppService, servicePluginRelation *model.Tena
 		}
 		cm := &v1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: fmt.Sprintf("config-%s-%s", config.ServiceID, config.PluginID),
+				Name:        fmt.Sprintf("config-%s-%s", config.ServiceID, config.PluginID),
+				Annotations: tracingAnnotations(as),
 				Labels: as.GetCommonLabels(map[string]string{
 					"plugin_id":     servicePluginRelation.PluginID,
 					"service_alias": as.ServiceAlias,
@@ -383,7 +384,8 @@ func applyDefaultMeshPluginConfig(as *typesv1.AppService, dbmanager db.Manager)
 	pluginID := "def-mesh" + as.ServiceID
 	cm := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: fmt.Sprintf("config-%s-%s", as.ServiceID, pluginID),
+			Name:        fmt.Sprintf("config-%s-%s", as.ServiceID, pluginID),
+			Annotations: tracingAnnotations(as),
 			Labels: as.GetCommonLabels(map[string]string{
 				"plugin_id":     pluginID,
 				"service_alias": as.ServiceAlias,
@@ -398,6 +400,18 @@ func applyDefaultMeshPluginConfig(as *typesv1.AppService, dbmanager db.Manager)
 	return pluginID, res, nil
 }
 
+//tracingAnnotations the tracing of the app, the xds server reads it from the plugin config
+func tracingAnnotations(as *typesv1.AppService) map[string]string {
+	if as.TracingDriver == "" {
+		return nil
+	}
+	return map[string]string{
+		util.TracingDriverAnnotation:    as.TracingDriver,
+		util.TracingCollectorAnnotation: as.TracingCollector,
+		util.TracingSamplingAnnotation:  strconv.FormatFloat(as.TracingSampling, 'f', -1, 64),
+	}
+}
+
 func getPluginModel(pluginID, tenantID string, dbmanager db.Manager) (string, error) {
 	plugin, err := dbmanager.TenantPluginDao().GetPluginByID(pluginID, tenantID)
 	if err != nil {
@@ -208,6 +208,40 @@ func createArgs(version *dbmodel.VersionInfo, envs []corev1.EnvVar) (args []stri
 	return args
 }
 
+//createTracingEnvs the trace context envs of the app tracing, they follow the OpenTelemetry sdk conventions
+func createTracingEnvs(as *v1.AppService) []corev1.EnvVar {
+	if as.TracingDriver == "" {
+		return nil
+	}
+	envs := []corev1.EnvVar{
+		{Name: "TRACING_DRIVER", Value: as.TracingDriver},
+		{Name: "TRACING_COLLECTOR", Value: as.TracingCollector},
+		{Name: "TRACING_SAMPLING", Value: strconv.FormatFloat(as.TracingSampling, 'f', -1, 64)},
+		{Name: "OTEL_SERVICE_NAME", Value: as.GetK8sWorkloadName()},
+		{Name: "OTEL_TRACES_SAMPLER", Value: "parentbased_traceidratio"},
+		{Name: "OTEL_TRACES_SAMPLER_ARG", Value: strconv.FormatFloat(as.TracingSampling/100, 'f', -1, 64)},
+	}
+	switch as.TracingDriver {
+	case dbmodel.TracingDriverZipkin:
+		envs = append(envs, corev1.EnvVar{Name: "OTEL_PROPAGATORS", Value: "b3multi"})
+		if collector, err := util.ParseTracingCollector(as.TracingCollector); err == nil {
+			if collector.Path == "" {
+				collector.Path = util.DefaultZipkinCollectorPath
+			}
+			envs = append(envs, corev1.EnvVar{Name: "OTEL_TRACES_EXPORTER", Value: "zipkin"})
+			envs = append(envs, corev1.EnvVar{Name: "OTEL_EXPORTER_ZIPKIN_ENDPOINT", Value: collector.URL()})
+		}
+	case dbmodel.TracingDriverOTLP:
+		// the opentelemetry tracer of the sidecar propagates the w3c trace context
+		envs = append(envs, corev1.EnvVar{Name: "OTEL_PROPAGATORS", Value: "tracecontext,baggage"})
+		if collector, err := util.ParseTracingCollector(as.TracingCollector); err == nil {
+			envs = append(envs, corev1.EnvVar{Name: "OTEL_TRACES_EXPORTER", Value: "otlp"})
+			envs = append(envs, corev1.EnvVar{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: collector.URL()})
+		}
+	}
+	return envs
+}
+
 //createEnv create service container env
 func createEnv(as *v1.AppService, dbmanager db.Manager, envVarSecrets []*corev1.Secret) ([]corev1.EnvVar, error) {
 	var envs []corev1.EnvVar
@@ -327,6 +361,8 @@ func createEnv(as *v1.AppService, dbmanager db.Manager, envVarSecrets []*corev1.
 	if len(es) > 0 {
 		envsAll = append(envsAll, es...)
 	}
+	//set tracing env before custom envs, so that the custom envs can override it
+	envs = append(envs, createTracingEnvs(as)...)
 	for _, e := range envsAll {
 		envs = append(envs, corev1.EnvVar{Name: strings.TrimSpace(e.AttrName), Value: e.AttrValue})
 	}
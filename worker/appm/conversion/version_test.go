@@ -97,3 +97,32 @@ func TestFoobar(t *testing.T) {
 	cpuRequest, cpuLimit := int64(memory)/128*30, int64(memory)/128*80
 	t.Errorf("request: %d; limit: %d", cpuRequest, cpuLimit)
 }
+
+func TestCreateTracingEnvs(t *testing.T) {
+	as := &v1.AppService{AppServiceBase: v1.AppServiceBase{
+		K8sApp:           "app",
+		K8sComponentName: "web",
+		TracingDriver:    model.TracingDriverOTLP,
+		TracingCollector: "otel-collector.default:4317",
+		TracingSampling:  50,
+	}}
+	envs := make(map[string]string)
+	for _, env := range createTracingEnvs(as) {
+		envs[env.Name] = env.Value
+	}
+	for name, want := range map[string]string{
+		"OTEL_SERVICE_NAME":           "app-web",
+		"OTEL_TRACES_SAMPLER_ARG":     "0.5",
+		"OTEL_PROPAGATORS":            "tracecontext,baggage",
+		"OTEL_TRACES_EXPORTER":        "otlp",
+		"OTEL_EXPORTER_OTLP_ENDPOINT": "http://otel-collector.default:4317",
+	} {
+		if envs[name] != want {
+			t.Errorf("expected %s=%s, but got %s", name, want, envs[name])
+		}
+	}
+	as.TracingDriver = ""
+	if envs := createTracingEnvs(as); len(envs) != 0 {
+		t.Errorf("expected no tracing env, but got %v", envs)
+	}
+}
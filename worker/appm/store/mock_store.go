@@ -5,78 +5,74 @@
 package store
 
 import (
+	reflect "reflect"
+
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/goodrain/rainbond/db/model"
+	v1alpha1 "github.com/goodrain/rainbond/pkg/apis/rainbond/v1alpha1"
 	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
-	v10 "k8s.io/api/core/v1"
-	v11 "k8s.io/client-go/listers/core/v1"
-	reflect "reflect"
+	pb "github.com/goodrain/rainbond/worker/server/pb"
+	versioned "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	v10 "k8s.io/api/apps/v1"
+	v11 "k8s.io/api/core/v1"
+	v12 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
 )
 
-// MockStorer is a mock of Storer interface
+// MockStorer is a mock of Storer interface.
 type MockStorer struct {
 	ctrl     *gomock.Controller
 	recorder *MockStorerMockRecorder
 }
 
-// MockStorerMockRecorder is the mock recorder for MockStorer
+// MockStorerMockRecorder is the mock recorder for MockStorer.
 type MockStorerMockRecorder struct {
 	mock *MockStorer
 }
 
-// NewMockStorer creates a new mock instance
+// NewMockStorer creates a new mock instance.
 func NewMockStorer(ctrl *gomock.Controller) *MockStorer {
 	mock := &MockStorer{ctrl: ctrl}
 	mock.recorder = &MockStorerMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockStorer) EXPECT() *MockStorerMockRecorder {
 	return m.recorder
 }
 
-// Start mocks base method
-func (m *MockStorer) Start() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Start")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Start indicates an expected call of Start
-func (mr *MockStorerMockRecorder) Start() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockStorer)(nil).Start))
-}
-
-// Ready mocks base method
-func (m *MockStorer) Ready() bool {
+// GetAllAppServices mocks base method.
+func (m *MockStorer) GetAllAppServices() []*v1.AppService {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ready")
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetAllAppServices")
+	ret0, _ := ret[0].([]*v1.AppService)
 	return ret0
 }
 
-// Ready indicates an expected call of Ready
-func (mr *MockStorerMockRecorder) Ready() *gomock.Call {
+// GetAllAppServices indicates an expected call of GetAllAppServices.
+func (mr *MockStorerMockRecorder) GetAllAppServices() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockStorer)(nil).Ready))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAppServices", reflect.TypeOf((*MockStorer)(nil).GetAllAppServices))
 }
 
-// RegistAppService mocks base method
-func (m *MockStorer) RegistAppService(arg0 *v1.AppService) {
+// GetAppResources mocks base method.
+func (m *MockStorer) GetAppResources(appID string) (int64, int64, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RegistAppService", arg0)
+	ret := m.ctrl.Call(m, "GetAppResources", appID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// RegistAppService indicates an expected call of RegistAppService
-func (mr *MockStorerMockRecorder) RegistAppService(arg0 interface{}) *gomock.Call {
+// GetAppResources indicates an expected call of GetAppResources.
+func (mr *MockStorerMockRecorder) GetAppResources(appID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistAppService", reflect.TypeOf((*MockStorer)(nil).RegistAppService), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResources", reflect.TypeOf((*MockStorer)(nil).GetAppResources), appID)
 }
 
-// GetAppService mocks base method
+// GetAppService mocks base method.
 func (m *MockStorer) GetAppService(serviceID string) *v1.AppService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAppService", serviceID)
@@ -84,83 +80,187 @@ func (m *MockStorer) GetAppService(serviceID string) *v1.AppService {
 	return ret0
 }
 
-// GetAppService indicates an expected call of GetAppService
+// GetAppService indicates an expected call of GetAppService.
 func (mr *MockStorerMockRecorder) GetAppService(serviceID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppService", reflect.TypeOf((*MockStorer)(nil).GetAppService), serviceID)
 }
 
-// UpdateGetAppService mocks base method
-func (m *MockStorer) UpdateGetAppService(serviceID string) *v1.AppService {
+// GetAppServiceStatus mocks base method.
+func (m *MockStorer) GetAppServiceStatus(serviceID string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGetAppService", serviceID)
-	ret0, _ := ret[0].(*v1.AppService)
+	ret := m.ctrl.Call(m, "GetAppServiceStatus", serviceID)
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// UpdateGetAppService indicates an expected call of UpdateGetAppService
-func (mr *MockStorerMockRecorder) UpdateGetAppService(serviceID interface{}) *gomock.Call {
+// GetAppServiceStatus indicates an expected call of GetAppServiceStatus.
+func (mr *MockStorerMockRecorder) GetAppServiceStatus(serviceID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGetAppService", reflect.TypeOf((*MockStorer)(nil).UpdateGetAppService), serviceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppServiceStatus", reflect.TypeOf((*MockStorer)(nil).GetAppServiceStatus), serviceID)
 }
 
-// GetAllAppServices mocks base method
-func (m *MockStorer) GetAllAppServices() []*v1.AppService {
+// GetAppServiceStatuses mocks base method.
+func (m *MockStorer) GetAppServiceStatuses(serviceIDs []string) map[string]string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllAppServices")
-	ret0, _ := ret[0].([]*v1.AppService)
+	ret := m.ctrl.Call(m, "GetAppServiceStatuses", serviceIDs)
+	ret0, _ := ret[0].(map[string]string)
 	return ret0
 }
 
-// GetAllAppServices indicates an expected call of GetAllAppServices
-func (mr *MockStorerMockRecorder) GetAllAppServices() *gomock.Call {
+// GetAppServiceStatuses indicates an expected call of GetAppServiceStatuses.
+func (mr *MockStorerMockRecorder) GetAppServiceStatuses(serviceIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAppServices", reflect.TypeOf((*MockStorer)(nil).GetAllAppServices))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppServiceStatuses", reflect.TypeOf((*MockStorer)(nil).GetAppServiceStatuses), serviceIDs)
 }
 
-// GetAppServiceStatus mocks base method
-func (m *MockStorer) GetAppServiceStatus(serviceID string) string {
+// GetAppServicesStatus mocks base method.
+func (m *MockStorer) GetAppServicesStatus(serviceIDs []string) map[string]string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppServiceStatus", serviceID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetAppServicesStatus", serviceIDs)
+	ret0, _ := ret[0].(map[string]string)
 	return ret0
 }
 
-// GetAppServiceStatus indicates an expected call of GetAppServiceStatus
-func (mr *MockStorerMockRecorder) GetAppServiceStatus(serviceID interface{}) *gomock.Call {
+// GetAppServicesStatus indicates an expected call of GetAppServicesStatus.
+func (mr *MockStorerMockRecorder) GetAppServicesStatus(serviceIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppServiceStatus", reflect.TypeOf((*MockStorer)(nil).GetAppServiceStatus), serviceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppServicesStatus", reflect.TypeOf((*MockStorer)(nil).GetAppServicesStatus), serviceIDs)
 }
 
-// GetAppServicesStatus mocks base method
-func (m *MockStorer) GetAppServicesStatus(serviceIDs []string) map[string]string {
+// GetAppStatus mocks base method.
+func (m *MockStorer) GetAppStatus(appID string) (pb.AppStatus_Status, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppServicesStatus", serviceIDs)
+	ret := m.ctrl.Call(m, "GetAppStatus", appID)
+	ret0, _ := ret[0].(pb.AppStatus_Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppStatus indicates an expected call of GetAppStatus.
+func (mr *MockStorerMockRecorder) GetAppStatus(appID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppStatus", reflect.TypeOf((*MockStorer)(nil).GetAppStatus), appID)
+}
+
+// GetCrd mocks base method.
+func (m *MockStorer) GetCrd(name string) (*v12.CustomResourceDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCrd", name)
+	ret0, _ := ret[0].(*v12.CustomResourceDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCrd indicates an expected call of GetCrd.
+func (mr *MockStorerMockRecorder) GetCrd(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrd", reflect.TypeOf((*MockStorer)(nil).GetCrd), name)
+}
+
+// GetCrds mocks base method.
+func (m *MockStorer) GetCrds() ([]*v12.CustomResourceDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCrds")
+	ret0, _ := ret[0].([]*v12.CustomResourceDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCrds indicates an expected call of GetCrds.
+func (mr *MockStorerMockRecorder) GetCrds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrds", reflect.TypeOf((*MockStorer)(nil).GetCrds))
+}
+
+// GetHelmApp mocks base method.
+func (m *MockStorer) GetHelmApp(namespace, name string) (*v1alpha1.HelmApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmApp", namespace, name)
+	ret0, _ := ret[0].(*v1alpha1.HelmApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmApp indicates an expected call of GetHelmApp.
+func (mr *MockStorerMockRecorder) GetHelmApp(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmApp", reflect.TypeOf((*MockStorer)(nil).GetHelmApp), namespace, name)
+}
+
+// GetNeedBillingStatus mocks base method.
+func (m *MockStorer) GetNeedBillingStatus(serviceIDs []string) map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNeedBillingStatus", serviceIDs)
 	ret0, _ := ret[0].(map[string]string)
 	return ret0
 }
 
-// GetAppServicesStatus indicates an expected call of GetAppServicesStatus
-func (mr *MockStorerMockRecorder) GetAppServicesStatus(serviceIDs interface{}) *gomock.Call {
+// GetNeedBillingStatus indicates an expected call of GetNeedBillingStatus.
+func (mr *MockStorerMockRecorder) GetNeedBillingStatus(serviceIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppServicesStatus", reflect.TypeOf((*MockStorer)(nil).GetAppServicesStatus), serviceIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNeedBillingStatus", reflect.TypeOf((*MockStorer)(nil).GetNeedBillingStatus), serviceIDs)
 }
 
-// GetTenantResource mocks base method
-func (m *MockStorer) GetTenantResource(tenantID string) *v1.TenantResource {
+// GetPod mocks base method.
+func (m *MockStorer) GetPod(namespace, name string) (*v11.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPod", namespace, name)
+	ret0, _ := ret[0].(*v11.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPod indicates an expected call of GetPod.
+func (mr *MockStorerMockRecorder) GetPod(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPod", reflect.TypeOf((*MockStorer)(nil).GetPod), namespace, name)
+}
+
+// GetServiceMonitorClient mocks base method.
+func (m *MockStorer) GetServiceMonitorClient() (*versioned.Clientset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceMonitorClient")
+	ret0, _ := ret[0].(*versioned.Clientset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceMonitorClient indicates an expected call of GetServiceMonitorClient.
+func (mr *MockStorerMockRecorder) GetServiceMonitorClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceMonitorClient", reflect.TypeOf((*MockStorer)(nil).GetServiceMonitorClient))
+}
+
+// GetTenantResource mocks base method.
+func (m *MockStorer) GetTenantResource(tenantID string) TenantResource {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTenantResource", tenantID)
-	ret0, _ := ret[0].(*v1.TenantResource)
+	ret0, _ := ret[0].(TenantResource)
 	return ret0
 }
 
-// GetTenantResource indicates an expected call of GetTenantResource
+// GetTenantResource indicates an expected call of GetTenantResource.
 func (mr *MockStorerMockRecorder) GetTenantResource(tenantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantResource", reflect.TypeOf((*MockStorer)(nil).GetTenantResource), tenantID)
 }
 
-// GetTenantRunningApp mocks base method
+// GetTenantResourceList mocks base method.
+func (m *MockStorer) GetTenantResourceList() []TenantResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantResourceList")
+	ret0, _ := ret[0].([]TenantResource)
+	return ret0
+}
+
+// GetTenantResourceList indicates an expected call of GetTenantResourceList.
+func (mr *MockStorerMockRecorder) GetTenantResourceList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantResourceList", reflect.TypeOf((*MockStorer)(nil).GetTenantResourceList))
+}
+
+// GetTenantRunningApp mocks base method.
 func (m *MockStorer) GetTenantRunningApp(tenantID string) []*v1.AppService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTenantRunningApp", tenantID)
@@ -168,27 +268,86 @@ func (m *MockStorer) GetTenantRunningApp(tenantID string) []*v1.AppService {
 	return ret0
 }
 
-// GetTenantRunningApp indicates an expected call of GetTenantRunningApp
+// GetTenantRunningApp indicates an expected call of GetTenantRunningApp.
 func (mr *MockStorerMockRecorder) GetTenantRunningApp(tenantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantRunningApp", reflect.TypeOf((*MockStorer)(nil).GetTenantRunningApp), tenantID)
 }
 
-// GetNeedBillingStatus mocks base method
-func (m *MockStorer) GetNeedBillingStatus(serviceIDs []string) map[string]string {
+// Informer mocks base method.
+func (m *MockStorer) Informer() *Informer {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNeedBillingStatus", serviceIDs)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "Informer")
+	ret0, _ := ret[0].(*Informer)
 	return ret0
 }
 
-// GetNeedBillingStatus indicates an expected call of GetNeedBillingStatus
-func (mr *MockStorerMockRecorder) GetNeedBillingStatus(serviceIDs interface{}) *gomock.Call {
+// Informer indicates an expected call of Informer.
+func (mr *MockStorerMockRecorder) Informer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNeedBillingStatus", reflect.TypeOf((*MockStorer)(nil).GetNeedBillingStatus), serviceIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Informer", reflect.TypeOf((*MockStorer)(nil).Informer))
+}
+
+// ListPods mocks base method.
+func (m *MockStorer) ListPods(namespace string, selector labels.Selector) ([]*v11.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPods", namespace, selector)
+	ret0, _ := ret[0].([]*v11.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPods indicates an expected call of ListPods.
+func (mr *MockStorerMockRecorder) ListPods(namespace, selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPods", reflect.TypeOf((*MockStorer)(nil).ListPods), namespace, selector)
+}
+
+// ListReplicaSets mocks base method.
+func (m *MockStorer) ListReplicaSets(namespace string, selector labels.Selector) ([]*v10.ReplicaSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReplicaSets", namespace, selector)
+	ret0, _ := ret[0].([]*v10.ReplicaSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReplicaSets indicates an expected call of ListReplicaSets.
+func (mr *MockStorerMockRecorder) ListReplicaSets(namespace, selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReplicaSets", reflect.TypeOf((*MockStorer)(nil).ListReplicaSets), namespace, selector)
+}
+
+// ListServices mocks base method.
+func (m *MockStorer) ListServices(namespace string, selector labels.Selector) ([]*v11.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", namespace, selector)
+	ret0, _ := ret[0].([]*v11.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// OnDeletes mocks base method
+// ListServices indicates an expected call of ListServices.
+func (mr *MockStorerMockRecorder) ListServices(namespace, selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockStorer)(nil).ListServices), namespace, selector)
+}
+
+// Lister mocks base method.
+func (m *MockStorer) Lister() *Lister {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lister")
+	ret0, _ := ret[0].(*Lister)
+	return ret0
+}
+
+// Lister indicates an expected call of Lister.
+func (mr *MockStorerMockRecorder) Lister() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lister", reflect.TypeOf((*MockStorer)(nil).Lister))
+}
+
+// OnDeletes mocks base method.
 func (m *MockStorer) OnDeletes(obj ...interface{}) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
@@ -198,84 +357,110 @@ func (m *MockStorer) OnDeletes(obj ...interface{}) {
 	m.ctrl.Call(m, "OnDeletes", varargs...)
 }
 
-// OnDeletes indicates an expected call of OnDeletes
+// OnDeletes indicates an expected call of OnDeletes.
 func (mr *MockStorerMockRecorder) OnDeletes(obj ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnDeletes", reflect.TypeOf((*MockStorer)(nil).OnDeletes), obj...)
 }
 
-// GetPodLister mocks base method
-func (m *MockStorer) GetPodLister() v11.PodLister {
+// Ready mocks base method.
+func (m *MockStorer) Ready() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodLister")
-	ret0, _ := ret[0].(v11.PodLister)
+	ret := m.ctrl.Call(m, "Ready")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// GetPodLister indicates an expected call of GetPodLister
-func (mr *MockStorerMockRecorder) GetPodLister() *gomock.Call {
+// Ready indicates an expected call of Ready.
+func (mr *MockStorerMockRecorder) Ready() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLister", reflect.TypeOf((*MockStorer)(nil).GetPodLister))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockStorer)(nil).Ready))
 }
 
-// RegistPodUpdateListener mocks base method
-func (m *MockStorer) RegistPodUpdateListener(arg0 string, arg1 chan<- *v10.Pod) {
+// RegistAppService mocks base method.
+func (m *MockStorer) RegistAppService(arg0 *v1.AppService) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RegistPodUpdateListener", arg0, arg1)
+	m.ctrl.Call(m, "RegistAppService", arg0)
 }
 
-// RegistPodUpdateListener indicates an expected call of RegistPodUpdateListener
-func (mr *MockStorerMockRecorder) RegistPodUpdateListener(arg0, arg1 interface{}) *gomock.Call {
+// RegistAppService indicates an expected call of RegistAppService.
+func (mr *MockStorerMockRecorder) RegistAppService(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistPodUpdateListener", reflect.TypeOf((*MockStorer)(nil).RegistPodUpdateListener), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistAppService", reflect.TypeOf((*MockStorer)(nil).RegistAppService), arg0)
 }
 
-// UnRegistPodUpdateListener mocks base method
-func (m *MockStorer) UnRegistPodUpdateListener(arg0 string) {
+// RegistPodUpdateListener mocks base method.
+func (m *MockStorer) RegistPodUpdateListener(arg0 string, arg1 chan<- *v11.Pod) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "UnRegistPodUpdateListener", arg0)
+	m.ctrl.Call(m, "RegistPodUpdateListener", arg0, arg1)
 }
 
-// UnRegistPodUpdateListener indicates an expected call of UnRegistPodUpdateListener
-func (mr *MockStorerMockRecorder) UnRegistPodUpdateListener(arg0 interface{}) *gomock.Call {
+// RegistPodUpdateListener indicates an expected call of RegistPodUpdateListener.
+func (mr *MockStorerMockRecorder) RegistPodUpdateListener(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnRegistPodUpdateListener", reflect.TypeOf((*MockStorer)(nil).UnRegistPodUpdateListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistPodUpdateListener", reflect.TypeOf((*MockStorer)(nil).RegistPodUpdateListener), arg0, arg1)
 }
 
-// RegisterVolumeTypeListener mocks base method
+// RegisterVolumeTypeListener mocks base method.
 func (m *MockStorer) RegisterVolumeTypeListener(arg0 string, arg1 chan<- *model.TenantServiceVolumeType) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "RegisterVolumeTypeListener", arg0, arg1)
 }
 
-// RegisterVolumeTypeListener indicates an expected call of RegisterVolumeTypeListener
+// RegisterVolumeTypeListener indicates an expected call of RegisterVolumeTypeListener.
 func (mr *MockStorerMockRecorder) RegisterVolumeTypeListener(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterVolumeTypeListener", reflect.TypeOf((*MockStorer)(nil).RegisterVolumeTypeListener), arg0, arg1)
 }
 
-// UnRegisterVolumeTypeListener mocks base method
+// Start mocks base method.
+func (m *MockStorer) Start() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockStorerMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockStorer)(nil).Start))
+}
+
+// UnRegistPodUpdateListener mocks base method.
+func (m *MockStorer) UnRegistPodUpdateListener(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnRegistPodUpdateListener", arg0)
+}
+
+// UnRegistPodUpdateListener indicates an expected call of UnRegistPodUpdateListener.
+func (mr *MockStorerMockRecorder) UnRegistPodUpdateListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnRegistPodUpdateListener", reflect.TypeOf((*MockStorer)(nil).UnRegistPodUpdateListener), arg0)
+}
+
+// UnRegisterVolumeTypeListener mocks base method.
 func (m *MockStorer) UnRegisterVolumeTypeListener(arg0 string) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "UnRegisterVolumeTypeListener", arg0)
 }
 
-// UnRegisterVolumeTypeListener indicates an expected call of UnRegisterVolumeTypeListener
+// UnRegisterVolumeTypeListener indicates an expected call of UnRegisterVolumeTypeListener.
 func (mr *MockStorerMockRecorder) UnRegisterVolumeTypeListener(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnRegisterVolumeTypeListener", reflect.TypeOf((*MockStorer)(nil).UnRegisterVolumeTypeListener), arg0)
 }
 
-// InitOneThirdPartService mocks base method
-func (m *MockStorer) InitOneThirdPartService(service *model.TenantServices) error {
+// UpdateGetAppService mocks base method.
+func (m *MockStorer) UpdateGetAppService(serviceID string) *v1.AppService {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitOneThirdPartService", service)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "UpdateGetAppService", serviceID)
+	ret0, _ := ret[0].(*v1.AppService)
 	return ret0
 }
 
-// InitOneThirdPartService indicates an expected call of InitOneThirdPartService
-func (mr *MockStorerMockRecorder) InitOneThirdPartService(service interface{}) *gomock.Call {
+// UpdateGetAppService indicates an expected call of UpdateGetAppService.
+func (mr *MockStorerMockRecorder) UpdateGetAppService(serviceID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitOneThirdPartService", reflect.TypeOf((*MockStorer)(nil).InitOneThirdPartService), service)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGetAppService", reflect.TypeOf((*MockStorer)(nil).UpdateGetAppService), serviceID)
 }
@@ -93,6 +93,12 @@ var Rolling TypeUpgradeMethod = "Rolling"
 //OnDelete Stop the old version before starting the new version the upgrade
 var OnDelete TypeUpgradeMethod = "OnDelete"
 
+//Canary Start the new version next to the old version and shift traffic to it step by step
+var Canary TypeUpgradeMethod = "Canary"
+
+//BlueGreen Start the new version next to the old version and switch all traffic to it at once
+var BlueGreen TypeUpgradeMethod = "BlueGreen"
+
 //AppServiceBase app service base info
 type AppServiceBase struct {
 	TenantID         string
@@ -118,6 +124,10 @@ type AppServiceBase struct {
 	GovernanceMode   string
 	K8sApp           string
 	K8sComponentName string
+	//tracing of the build-in service mesh, inherited from the app
+	TracingDriver    string
+	TracingCollector string
+	TracingSampling  float64
 }
 
 //GetComponentDefinitionName get component definition name by component kind
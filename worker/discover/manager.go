@@ -24,6 +24,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/goodrain/rainbond/cmd/worker/option"
 	"github.com/goodrain/rainbond/mq/api/grpc/pb"
 	"github.com/goodrain/rainbond/mq/client"
@@ -52,6 +53,7 @@ type TaskManager struct {
 	config        option.Config
 	handleManager *handle.Manager
 	client        client.MQClient
+	leases        *client.LeaseKeeper
 }
 
 //NewTaskManager return *TaskManager
@@ -80,14 +82,15 @@ func (t *TaskManager) Start() error {
 		CertFile:  t.config.EtcdCertFile,
 		KeyFile:   t.config.EtcdKeyFile,
 	}
-	client, err := client.NewMqClient(etcdClientArgs, t.config.MQAPI)
+	mqClient, err := client.NewMqClient(etcdClientArgs, t.config.MQAPI)
 	if err != nil {
 		logrus.Errorf("new Mq client error, %v", err)
 		healthStatus["status"] = "unusual"
 		healthStatus["info"] = fmt.Sprintf("new Mq client error, %v", err)
 		return err
 	}
-	t.client = client
+	t.client = mqClient
+	t.leases = client.NewLeaseKeeper(t.ctx, mqClient, client.WorkerTopic)
 	go t.Do()
 	logrus.Info("start discover success.")
 	return nil
@@ -102,7 +105,7 @@ func (t *TaskManager) Do() {
 		case <-t.ctx.Done():
 			return
 		default:
-			data, err := t.client.Dequeue(t.ctx, &pb.DequeueRequest{Topic: client.WorkerTopic, ClientHost: hostname + "-worker"})
+			data, err := t.leases.Lease(t.ctx, hostname+"-worker")
 			if err != nil {
 				if grpc1.ErrorDesc(err) == context.DeadlineExceeded.Error() {
 					continue
@@ -124,34 +127,56 @@ func (t *TaskManager) Do() {
 			transData, err := model.TransTask(data)
 			if err != nil {
 				logrus.Error("trans mq msg data error ", err.Error())
+				t.nack(data, err.Error())
 				continue
 			}
 			rc := t.handleManager.AnalystToExec(transData)
 			if rc != nil && rc != handle.ErrCallback {
 				logrus.Warningf("execute task: %v", rc)
 				TaskError++
+				t.ack(data)
 			} else if rc != nil && rc == handle.ErrCallback {
 				logrus.Errorf("err callback; analyst to exet: %v", rc)
 				ctx, cancel := context.WithCancel(t.ctx)
+				// the task is given back but not failed, so it is not counted as a delivery
+				returned := proto.Clone(data).(*pb.TaskMessage)
+				returned.DeliveryCount = 0
 				reply, err := t.client.Enqueue(ctx, &pb.EnqueueRequest{
 					Topic:   client.WorkerTopic,
-					Message: data,
+					Message: returned,
 				})
 				cancel()
 				logrus.Debugf("retry send task to mq ,reply is %v", reply)
 				if err != nil {
 					logrus.Errorf("enqueue task %v to mq topic %v Error", data, client.WorkerTopic)
+					t.nack(data, err.Error())
 					continue
 				}
+				t.ack(data)
 				//if handle is waiting, sleep 3 second
 				time.Sleep(time.Second * 3)
 			} else {
 				TaskNum++
+				t.ack(data)
 			}
 		}
 	}
 }
 
+//ack acknowledges the leased task so that it will not be redelivered
+func (t *TaskManager) ack(task *pb.TaskMessage) {
+	if err := t.leases.Ack(task.TaskId); err != nil {
+		logrus.Warningf("ack task %s failure %s", task.TaskId, err.Error())
+	}
+}
+
+//nack gives the leased task back, it is moved to the dead letter topic after too many deliveries
+func (t *TaskManager) nack(task *pb.TaskMessage, reason string) {
+	if err := t.leases.Nack(task.TaskId, reason); err != nil {
+		logrus.Warningf("nack task %s failure %s", task.TaskId, err.Error())
+	}
+}
+
 //Stop 停止
 func (t *TaskManager) Stop() error {
 	logrus.Info("discover manager is stoping.")
@@ -165,6 +165,20 @@ func NewTaskBody(taskType string, body []byte) TaskBody {
 			return nil
 		}
 		return b
+	case "canary_release":
+		b := &CanaryReleaseTaskBody{}
+		err := ffjson.Unmarshal(body, &b)
+		if err != nil {
+			return nil
+		}
+		return b
+	case "wake_component":
+		b := WakeComponentTaskBody{}
+		err := ffjson.Unmarshal(body, &b)
+		if err != nil {
+			return nil
+		}
+		return b
 	case "apply_registry_auth_secret":
 		b := ApplyRegistryAuthSecretTaskBody{}
 		err := ffjson.Unmarshal(body, &b)
@@ -205,6 +219,10 @@ func CreateTaskBody(taskType string) TaskBody {
 		return DeleteTenantTaskBody{}
 	case "refreshhpa":
 		return RefreshHPATaskBody{}
+	case "canary_release":
+		return CanaryReleaseTaskBody{}
+	case "wake_component":
+		return WakeComponentTaskBody{}
 	default:
 		return DefaultTaskBody{}
 	}
@@ -240,6 +258,9 @@ type HorizontalScalingTaskBody struct {
 	Replicas  int32  `json:"replicas"`
 	EventID   string `json:"event_id"`
 	Username  string `json:"username"`
+	// the type and the rule of the scaling record, default is manual
+	RecordType string `json:"record_type,omitempty"`
+	RuleID     string `json:"rule_id,omitempty"`
 }
 
 //VerticalScalingTaskBody 垂直伸缩操作任务主体
@@ -285,6 +306,8 @@ type RollingUpgradeTaskBody struct {
 	EventID          string            `json:"event_id"`
 	Strategy         []string          `json:"strategy"`
 	Configs          map[string]string `json:"configs"`
+	// SkipAnalysis the deploy version is not analyzed after it is ready, such as the rollback by the analysis
+	SkipAnalysis bool `json:"skip_analysis"`
 }
 
 //RollBackTaskBody 回滚操作任务主体
@@ -376,5 +399,38 @@ type ApplyRegistryAuthSecretTaskBody struct {
 	Password string `json:"password"`
 }
 
+// CanaryReleaseAction the operation on a running canary release
+type CanaryReleaseAction string
+
+const (
+	// CanaryReleasePromote moves the canary release to the next step, or promotes it fully
+	CanaryReleasePromote CanaryReleaseAction = "promote"
+	// CanaryReleaseAbort removes the canary version and sends all traffic back to the stable version
+	CanaryReleaseAbort CanaryReleaseAction = "abort"
+)
+
+// CanaryReleaseTaskBody contains information for CanaryReleaseTask
+type CanaryReleaseTaskBody struct {
+	TenantID  string              `json:"tenant_id"`
+	ServiceID string              `json:"service_id"`
+	EventID   string              `json:"event_id"`
+	Action    CanaryReleaseAction `json:"action"`
+	// Full promotes the canary version directly, skipping the remaining steps
+	Full bool `json:"full"`
+	// Auto the step is sent by the leader worker when the step interval is due,
+	// it is skipped unless the release is still progressing on Step of CanaryVersion
+	Auto          bool   `json:"auto"`
+	Step          int    `json:"step"`
+	CanaryVersion string `json:"canary_version"`
+}
+
+// WakeComponentTaskBody contains information for the idle component which a request is held for by the gateway
+type WakeComponentTaskBody struct {
+	TenantID  string `json:"tenant_id"`
+	ServiceID string `json:"service_id"`
+	// the host of the held request
+	Host string `json:"host"`
+}
+
 //DefaultTaskBody 默认操作任务主体
 type DefaultTaskBody map[string]interface{}
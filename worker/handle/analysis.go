@@ -0,0 +1,50 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handle
+
+import (
+	"time"
+
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// startReleaseAnalysis records the new version of the component to analyze. The leader worker analyzes it
+// after it is ready, and rolls back to the previous version if any threshold of the analysis is breached.
+func (m *Manager) startReleaseAnalysis(app *v1.AppService, previousVersion, eventID string) {
+	if previousVersion == "" || previousVersion == app.DeployVersion {
+		return
+	}
+	analysis, err := m.dbmanager.TenantServiceReleaseAnalysisDao().GetByServiceID(app.ServiceID)
+	if err != nil || !analysis.Enable {
+		return
+	}
+	record := &dbmodel.TenantServiceReleaseHealthRecords{
+		ServiceID:       app.ServiceID,
+		EventID:         eventID,
+		DeployVersion:   app.DeployVersion,
+		PreviousVersion: previousVersion,
+		Status:          dbmodel.ReleaseHealthAnalyzing.String(),
+		StartTime:       time.Now(),
+	}
+	if err := m.dbmanager.TenantServiceReleaseHealthRecordsDao().AddModel(record); err != nil {
+		logrus.Warningf("save release health record of component %s: %v", app.ServiceID, err)
+	}
+}
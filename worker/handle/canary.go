@@ -0,0 +1,201 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handle
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/worker/appm/controller"
+	"github.com/goodrain/rainbond/worker/appm/conversion"
+	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// canaryUpgrade starts newAppService as the canary version of oldAppService.
+// It returns false if the component can not be released as canary, the caller should upgrade it directly.
+func (m *Manager) canaryUpgrade(oldAppService, newAppService *v1.AppService, eventID string) (bool, error) {
+	deployment := oldAppService.GetDeployment()
+	if deployment == nil || newAppService.GetDeployment() == nil {
+		newAppService.Logger.Info("canary release only supports stateless component, upgrade it directly", event.GetLoggerOption("running"))
+		return false, nil
+	}
+	stableVersion := deployment.Labels["version"]
+	if stableVersion == "" || stableVersion == newAppService.DeployVersion {
+		return false, nil
+	}
+	release, err := m.dbmanager.TenantServiceCanaryReleaseDao().GetByServiceID(newAppService.ServiceID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return true, err
+	}
+	if release == nil {
+		release = &dbmodel.TenantServiceCanaryRelease{
+			ServiceID: newAppService.ServiceID,
+			Steps:     dbmodel.DefaultCanarySteps,
+			Replicas:  1,
+		}
+		if err := m.dbmanager.TenantServiceCanaryReleaseDao().AddModel(release); err != nil {
+			return true, err
+		}
+	}
+	action := controller.CanaryActionStart
+	if release.IsActive() {
+		if newAppService.DeployVersion == release.StableVersion {
+			// rollback to the stable version while a canary release is running
+			action = controller.CanaryActionAbort
+		} else {
+			stableVersion = release.StableVersion
+		}
+	}
+	if action == controller.CanaryActionStart {
+		if newAppService.UpgradeMethod == v1.BlueGreen {
+			release.Steps = "100"
+		}
+		release.StableVersion = stableVersion
+		release.CanaryVersion = newAppService.DeployVersion
+		release.CurrentStep = 0
+		release.Weight = 0
+		release.Status = dbmodel.CanaryReleaseStatusProgressing.String()
+		// the first step is scheduled after the canary version is ready
+		release.NextStepTime = nil
+	}
+	release.EventID = eventID
+	if err := m.dbmanager.TenantServiceCanaryReleaseDao().UpdateModel(release); err != nil {
+		return true, err
+	}
+	// the stable version keeps serving until the canary version is promoted
+	if err := m.dbmanager.TenantServiceDao().UpdateDeployVersion(newAppService.ServiceID, release.StableVersion); err != nil {
+		return true, err
+	}
+	setCanaryAction(newAppService, action)
+	if err := m.controllerManager.StartController(controller.TypeCanaryController, *newAppService); err != nil {
+		return true, err
+	}
+	logrus.Infof("service(%s) canary %s working is running.", newAppService.ServiceID, action)
+	return true, nil
+}
+
+// canaryReleaseExec promotes or aborts the running canary release of a component
+func (m *Manager) canaryReleaseExec(task *model.Task) error {
+	body, ok := task.Body.(*model.CanaryReleaseTaskBody)
+	if !ok {
+		logrus.Errorf("exec task 'canary_release'; wrong type: %v", reflect.TypeOf(task))
+		return fmt.Errorf("exec task 'canary_release': wrong input")
+	}
+	logger := event.GetManager().GetLogger(body.EventID)
+	release, err := m.dbmanager.TenantServiceCanaryReleaseDao().GetByServiceID(body.ServiceID)
+	if err != nil || !release.IsActive() {
+		logger.Info("component has no running canary release", event.GetLastLoggerOption())
+		event.GetManager().ReleaseLogger(logger)
+		return nil
+	}
+	// the release is paused, promoted manually or replaced after the automatic step is sent
+	if body.Auto && (release.Status != dbmodel.CanaryReleaseStatusProgressing.String() ||
+		release.CurrentStep != body.Step || release.CanaryVersion != body.CanaryVersion) {
+		logrus.Infof("skip the automatic step %d of the canary release of component %s", body.Step, body.ServiceID)
+		event.GetManager().ReleaseLogger(logger)
+		return nil
+	}
+	oldAppService := m.store.GetAppService(body.ServiceID)
+	if oldAppService == nil || oldAppService.IsClosed() {
+		logger.Info("component is closed, can not change canary release", event.GetLastLoggerOption())
+		event.GetManager().ReleaseLogger(logger)
+		return nil
+	}
+	weights, err := release.StepWeights()
+	if err != nil {
+		logger.Error(fmt.Sprintf("invalid canary steps: %s", err.Error()), event.GetCallbackLoggerOption())
+		event.GetManager().ReleaseLogger(logger)
+		return nil
+	}
+	action := controller.CanaryActionAbort
+	if body.Action == model.CanaryReleasePromote {
+		action = controller.CanaryActionStep
+		if body.Full || release.CurrentStep+1 >= len(weights) {
+			action = controller.CanaryActionPromote
+			if err := m.dbmanager.TenantServiceDao().UpdateDeployVersion(body.ServiceID, release.CanaryVersion); err != nil {
+				logrus.Errorf("update deploy version of component %s failure: %s", body.ServiceID, err.Error())
+				logger.Error("update deploy version failure", event.GetCallbackLoggerOption())
+				event.GetManager().ReleaseLogger(logger)
+				return err
+			}
+		}
+	}
+	release.EventID = body.EventID
+	if err := m.dbmanager.TenantServiceCanaryReleaseDao().UpdateModel(release); err != nil {
+		logger.Error("update canary release failure", event.GetCallbackLoggerOption())
+		event.GetManager().ReleaseLogger(logger)
+		return err
+	}
+	// promote converts the canary version, step and abort convert the stable version
+	newAppService, err := conversion.InitAppService(m.dbmanager, body.ServiceID, nil)
+	if err != nil {
+		logrus.Errorf("component init create failure:%s", err.Error())
+		logger.Error("component init create failure", event.GetCallbackLoggerOption())
+		event.GetManager().ReleaseLogger(logger)
+		return fmt.Errorf("component init create failure")
+	}
+	newAppService.Logger = logger
+	if action == controller.CanaryActionPromote {
+		if err := oldAppService.SetUpgradePatch(newAppService); err != nil && err.Error() != "no upgrade" {
+			logger.Error(fmt.Sprintf("component get upgrade info error:%s", err.Error()), event.GetCallbackLoggerOption())
+			event.GetManager().ReleaseLogger(logger)
+			return nil
+		}
+	}
+	setCanaryAction(newAppService, action)
+	if err := m.controllerManager.StartController(controller.TypeCanaryController, *newAppService); err != nil {
+		logrus.Errorf("component run canary controller failure:%s", err.Error())
+		logger.Error("component run canary controller failure", event.GetCallbackLoggerOption())
+		event.GetManager().ReleaseLogger(logger)
+		return fmt.Errorf("component canary release failure")
+	}
+	logrus.Infof("service(%s) canary %s working is running.", body.ServiceID, action)
+	return nil
+}
+
+// cleanCanaryRelease removes the canary version of a component which is going to stop
+func (m *Manager) cleanCanaryRelease(appService *v1.AppService) {
+	release, err := m.dbmanager.TenantServiceCanaryReleaseDao().GetByServiceID(appService.ServiceID)
+	if err != nil || !release.IsActive() {
+		return
+	}
+	if err := m.controllerManager.DeleteCanaryResources(context.Background(), appService.GetNamespace(), appService.ServiceID); err != nil {
+		logrus.Warningf("clean canary resources of component %s failure: %s", appService.ServiceID, err.Error())
+		return
+	}
+	release.Weight = 0
+	release.Status = dbmodel.CanaryReleaseStatusAborted.String()
+	release.NextStepTime = nil
+	if err := m.dbmanager.TenantServiceCanaryReleaseDao().UpdateModel(release); err != nil {
+		logrus.Warningf("update canary release of component %s failure: %s", appService.ServiceID, err.Error())
+	}
+}
+
+func setCanaryAction(appService *v1.AppService, action string) {
+	if appService.CustomParams == nil {
+		appService.CustomParams = make(map[string]string)
+	}
+	appService.CustomParams[controller.CanaryActionKey] = action
+}
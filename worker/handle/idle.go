@@ -0,0 +1,113 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handle
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/util"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/sirupsen/logrus"
+)
+
+// wakeComponentExec scales the idle component back up, the gateway holds the request until it is ready.
+func (m *Manager) wakeComponentExec(task *model.Task) error {
+	body, ok := task.Body.(model.WakeComponentTaskBody)
+	if !ok {
+		logrus.Errorf("exec task 'wake_component'; wrong type: %v", reflect.TypeOf(task))
+		return fmt.Errorf("exec task 'wake_component': wrong input")
+	}
+	policy, err := m.dbmanager.TenantServiceIdlePolicyDao().GetByServiceID(body.ServiceID)
+	if err != nil {
+		logrus.Debugf("get idle policy of component %s: %v", body.ServiceID, err)
+		return nil
+	}
+	// all gateways send the task when requests are held, only the first one wakes the component
+	woken, err := m.dbmanager.TenantServiceIdlePolicyDao().Wake(body.ServiceID)
+	if err != nil {
+		return err
+	}
+	if !woken {
+		return nil
+	}
+	// mark the component idle again, or the later wake tasks are ignored while it has no replicas
+	rollback := func() {
+		policy.Idle = true
+		if err := m.dbmanager.TenantServiceIdlePolicyDao().UpdateModel(policy); err != nil {
+			logrus.Errorf("rollback idle policy of component %s: %v", policy.ServiceID, err)
+		}
+	}
+	service, err := m.dbmanager.TenantServiceDao().GetServiceByID(body.ServiceID)
+	if err != nil {
+		rollback()
+		return err
+	}
+	replicas := policy.Replicas
+	if replicas <= 0 {
+		replicas = 1
+	}
+	// the task without host is sent when the policy is disabled
+	reason := "the idle policy is disabled"
+	message := fmt.Sprintf("the idle policy is disabled, scale the idle component from 0 to %d", replicas)
+	if body.Host != "" {
+		reason = fmt.Sprintf("request of %s is held by the gateway", body.Host)
+		message = fmt.Sprintf("the gateway is holding the request of %s, scale the idle component from 0 to %d", body.Host, replicas)
+	}
+	evt := &dbmodel.ServiceEvent{
+		EventID:   util.NewUUID(),
+		TenantID:  service.TenantID,
+		ServiceID: service.ServiceID,
+		Target:    dbmodel.TargetTypeService,
+		TargetID:  service.ServiceID,
+		UserName:  dbmodel.UsernameSystem,
+		StartTime: time.Now().Format(time.RFC3339),
+		OptType:   "wake-service",
+		SynType:   dbmodel.ASYNEVENTTYPE,
+		Reason:    reason,
+	}
+	if err := m.dbmanager.ServiceEventDao().AddModel(evt); err != nil {
+		rollback()
+		return err
+	}
+	logger := event.GetManager().GetLogger(evt.EventID)
+	logger.Info(message, event.GetLoggerOption("starting"))
+	service.Replicas = replicas
+	if err := m.dbmanager.TenantServiceDao().UpdateModel(service); err != nil {
+		rollback()
+		logger.Error("update replicas failure", event.GetCallbackLoggerOption())
+		event.GetManager().ReleaseLogger(logger)
+		return err
+	}
+	event.GetManager().ReleaseLogger(logger)
+	return m.horizontalScalingExec(&model.Task{
+		Type: "horizontal_scaling",
+		Body: model.HorizontalScalingTaskBody{
+			TenantID:   service.TenantID,
+			ServiceID:  service.ServiceID,
+			Replicas:   int32(replicas),
+			EventID:    evt.EventID,
+			Username:   dbmodel.UsernameSystem,
+			RecordType: dbmodel.ScalingRecordTypeIdle,
+		},
+	})
+}
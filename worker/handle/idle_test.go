@@ -0,0 +1,130 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package handle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/goodrain/rainbond/db"
+	"github.com/goodrain/rainbond/db/dao"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/worker/discover/model"
+)
+
+func TestWakeComponentExec(t *testing.T) {
+	tests := []struct {
+		name         string
+		policyErr    error
+		woken        bool
+		serviceErr   error
+		eventErr     error
+		updateErr    error
+		wantErr      bool
+		wantRollback bool
+	}{
+		{
+			name:      "no idle policy",
+			policyErr: fmt.Errorf("record not found"),
+		},
+		{
+			name: "woken by another gateway",
+		},
+		{
+			name:         "get component failure",
+			woken:        true,
+			serviceErr:   fmt.Errorf("record not found"),
+			wantErr:      true,
+			wantRollback: true,
+		},
+		{
+			name:         "add event failure",
+			woken:        true,
+			eventErr:     fmt.Errorf("db is unavailable"),
+			wantErr:      true,
+			wantRollback: true,
+		},
+		{
+			name:         "update replicas failure",
+			woken:        true,
+			updateErr:    fmt.Errorf("db is unavailable"),
+			wantErr:      true,
+			wantRollback: true,
+		},
+	}
+	for idx := range tests {
+		tc := tests[idx]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			policy := &dbmodel.TenantServiceIdlePolicy{ServiceID: "component", Enable: true, Idle: true, Replicas: 2}
+			service := &dbmodel.TenantServices{TenantID: "tenant", ServiceID: "component"}
+
+			dbmanager := db.NewMockManager(ctrl)
+			policyDao := dao.NewMockTenantServiceIdlePolicyDao(ctrl)
+			dbmanager.EXPECT().TenantServiceIdlePolicyDao().AnyTimes().Return(policyDao)
+			if tc.policyErr != nil {
+				policyDao.EXPECT().GetByServiceID("component").Return(nil, tc.policyErr)
+			} else {
+				policyDao.EXPECT().GetByServiceID("component").Return(policy, nil)
+				policyDao.EXPECT().Wake("component").Return(tc.woken, nil)
+			}
+			if tc.woken {
+				serviceDao := dao.NewMockTenantServiceDao(ctrl)
+				dbmanager.EXPECT().TenantServiceDao().AnyTimes().Return(serviceDao)
+				if tc.serviceErr != nil {
+					serviceDao.EXPECT().GetServiceByID("component").Return(nil, tc.serviceErr)
+				} else {
+					serviceDao.EXPECT().GetServiceByID("component").Return(service, nil)
+					eventDao := dao.NewMockEventDao(ctrl)
+					dbmanager.EXPECT().ServiceEventDao().AnyTimes().Return(eventDao)
+					eventDao.EXPECT().AddModel(gomock.Any()).Return(tc.eventErr)
+				}
+				if tc.serviceErr == nil && tc.eventErr == nil {
+					lm := event.NewMockManager(ctrl)
+					event.NewTestManager(lm)
+					l := event.NewLogger("event", make(chan []byte, 10))
+					lm.EXPECT().GetLogger(gomock.Any()).Return(l)
+					lm.EXPECT().ReleaseLogger(l)
+					serviceDao.EXPECT().UpdateModel(service).Return(tc.updateErr)
+				}
+			}
+			if tc.wantRollback {
+				policyDao.EXPECT().UpdateModel(policy).DoAndReturn(func(mo dbmodel.Interface) error {
+					if !mo.(*dbmodel.TenantServiceIdlePolicy).Idle {
+						t.Errorf("expected the policy to be idle again")
+					}
+					return nil
+				})
+			}
+
+			m := &Manager{dbmanager: dbmanager}
+			err := m.wakeComponentExec(&model.Task{
+				Type: "wake_component",
+				Body: model.WakeComponentTaskBody{ServiceID: "component", Host: "www.example.com"},
+			})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, but got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -125,6 +125,12 @@ func (m *Manager) AnalystToExec(task *model.Task) error {
 	case "apply_registry_auth_secret":
 		logrus.Info("start a 'apply_registry_auth_secret' task worker")
 		return m.ExecApplyRegistryAuthSecretTask(task)
+	case "canary_release":
+		logrus.Info("start a 'canary_release' task worker")
+		return m.canaryReleaseExec(task)
+	case "wake_component":
+		logrus.Info("start a 'wake_component' task worker")
+		return m.wakeComponentExec(task)
 	default:
 		logrus.Warning("task can not execute because no type is identified")
 		return nil
@@ -183,6 +189,7 @@ func (m *Manager) stopExec(task *model.Task) error {
 	for k, v := range body.Configs {
 		appService.ExtensionSet[k] = v
 	}
+	m.cleanCanaryRelease(appService)
 	err := m.controllerManager.StartController(controller.TypeStopController, *appService)
 	if err != nil {
 		logrus.Errorf("component run  stop controller failure:%s", err.Error())
@@ -256,10 +263,15 @@ func (m *Manager) horizontalScalingExec(task *model.Task) (err error) {
 			desc = fmt.Sprintf(desc, oldReplicas, newReplicas, err)
 			reason = "FailedRescale"
 		}
+		recordType := body.RecordType
+		if recordType == "" {
+			recordType = dbmodel.ScalingRecordTypeManual
+		}
 		scalingRecord := &dbmodel.TenantServiceScalingRecords{
 			ServiceID:   body.ServiceID,
+			RuleID:      body.RuleID,
 			EventName:   util.NewUUID(),
-			RecordType:  "manual",
+			RecordType:  recordType,
 			Reason:      reason,
 			Count:       1,
 			Description: desc,
@@ -334,6 +346,12 @@ func (m *Manager) rollingUpgradeExec(task *model.Task) error {
 		logrus.Error("rolling_upgrade body convert to taskbody error", task.Body)
 		return fmt.Errorf("rolling_upgrade body convert to taskbody error")
 	}
+	return m.rollingUpgrade(body, !body.SkipAnalysis)
+}
+
+// rollingUpgrade upgrades the component to the deploy version in db.
+// If analyze is true, the new version is analyzed by the leader after it is ready and rolled back on failure.
+func (m *Manager) rollingUpgrade(body model.RollingUpgradeTaskBody, analyze bool) error {
 	logger := event.GetManager().GetLogger(body.EventID)
 	newAppService, err := conversion.InitAppService(m.dbmanager, body.ServiceID, body.Configs)
 	if err != nil {
@@ -358,6 +376,19 @@ func (m *Manager) rollingUpgradeExec(task *model.Task) error {
 		logrus.Infof("service(%s) %s working is running.", body.ServiceID, "start")
 		return nil
 	}
+	if newAppService.UpgradeMethod == v1.Canary || newAppService.UpgradeMethod == v1.BlueGreen {
+		handled, err := m.canaryUpgrade(oldAppService, newAppService, body.EventID)
+		if err != nil {
+			logrus.Errorf("component run canary release failure:%s", err.Error())
+			logger.Error("component run canary release failure", event.GetCallbackLoggerOption())
+			event.GetManager().ReleaseLogger(logger)
+			return fmt.Errorf("component canary release failure")
+		}
+		if handled {
+			return nil
+		}
+	}
+	previousVersion := oldAppService.GetRunningVersion()
 	if err := oldAppService.SetUpgradePatch(newAppService); err != nil {
 		if err.Error() == "no upgrade" {
 			logger.Info("component no change no need upgrade.", event.GetLastLoggerOption())
@@ -375,6 +406,9 @@ func (m *Manager) rollingUpgradeExec(task *model.Task) error {
 		event.GetManager().ReleaseLogger(logger)
 		return fmt.Errorf("component upgrade failure")
 	}
+	if analyze {
+		m.startReleaseAnalysis(newAppService, previousVersion, body.EventID)
+	}
 	logrus.Infof("service(%s) %s working is running.", body.ServiceID, "upgrade")
 	return nil
 }
@@ -510,6 +544,12 @@ func (m *Manager) deleteTenant(task *model.Task) (err error) {
 		err = fmt.Errorf("delete tenant: %v", err)
 		return
 	}
+	if err := db.GetManager().TenantImageScanPolicyDao().DeleteByTenantID(body.TenantID); err != nil {
+		logrus.Warningf("tenant id: %s; delete image scan policy: %v", body.TenantID, err)
+	}
+	if err := db.GetManager().TenantLogRetentionPolicyDao().DeleteByTenantID(body.TenantID); err != nil {
+		logrus.Warningf("tenant id: %s; delete log retention policy: %v", body.TenantID, err)
+	}
 
 	return
 }
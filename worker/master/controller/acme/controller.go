@@ -0,0 +1,300 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goodrain/rainbond/cmd/worker/option"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/util"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/acme"
+	"k8s.io/client-go/kubernetes"
+)
+
+// checkInterval the interval to check the certificates of the auto TLS http rules
+var checkInterval = time.Hour
+
+// issueTimeout the timeout to issue the certificate of a domain
+var issueTimeout = 10 * time.Minute
+
+// minRetryInterval and maxRetryInterval the issuance of a domain is retried with exponential backoff after it fails,
+// because the ACME servers limit the failed validations.
+var (
+	minRetryInterval = time.Hour
+	maxRetryInterval = 24 * time.Hour
+)
+
+// certificateNamePrefix the prefix of the names of the certificates issued by the controller
+const certificateNamePrefix = "auto-tls-"
+
+const (
+	// ChallengeHTTP01 validates the domain by the http request to the gateway
+	ChallengeHTTP01 = "http-01"
+	// ChallengeDNS01 validates the domain by the TXT record, which is required by the wildcard domains
+	ChallengeDNS01 = "dns-01"
+)
+
+type failure struct {
+	times   int
+	retryAt time.Time
+}
+
+// Controller issues and renews the certificates of the auto TLS http rules through the ACME protocol.
+// The renewed certificates are applied by the 'apply_rule' task, and the gateways reload them from the secrets.
+// It should only run on the leader.
+type Controller struct {
+	conf        option.ACME
+	namespace   string
+	kubeClient  kubernetes.Interface
+	mqclient    client.MQClient
+	dnsProvider DNSProvider
+	client      *acme.Client
+	failures    map[string]*failure
+}
+
+// NewController creates a new acme controller, the account and the http-01 challenges are stored in the namespace.
+func NewController(conf option.ACME, namespace string, kubeClient kubernetes.Interface, mqclient client.MQClient) (*Controller, error) {
+	c := &Controller{
+		conf:       conf,
+		namespace:  namespace,
+		kubeClient: kubeClient,
+		mqclient:   mqclient,
+		failures:   make(map[string]*failure),
+	}
+	if conf.DNSProvider != "" {
+		provider, err := NewDNSProvider(conf.DNSProvider, conf.DNSConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		c.dnsProvider = provider
+	}
+	return c, nil
+}
+
+// Start checks the certificates until the context is done.
+func (c *Controller) Start(ctx context.Context) {
+	logrus.Info("acme controller starting")
+	c.check(ctx, time.Now())
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			c.check(ctx, now)
+		}
+	}
+}
+
+func (c *Controller) check(ctx context.Context, now time.Time) {
+	extensions, err := db.GetManager().RuleExtensionDao().ListByKey(string(dbmodel.AutoTLS))
+	if err != nil {
+		logrus.Warningf("list auto tls rules: %v", err)
+		return
+	}
+	// the rules of the same domain share the certificate
+	domainRules := make(map[string][]*dbmodel.HTTPRule)
+	var domains []string
+	for _, ext := range extensions {
+		if ext.Value != "true" {
+			continue
+		}
+		rule, err := db.GetManager().HTTPRuleDao().GetHTTPRuleByID(ext.RuleID)
+		if err != nil || rule == nil || rule.UUID == "" {
+			continue
+		}
+		domain := strings.ToLower(strings.TrimSpace(rule.Domain))
+		if domain == "" {
+			continue
+		}
+		if _, ok := domainRules[domain]; !ok {
+			domains = append(domains, domain)
+		}
+		domainRules[domain] = append(domainRules[domain], rule)
+	}
+	for _, domain := range domains {
+		if f := c.failures[domain]; f != nil && now.Before(f.retryAt) {
+			continue
+		}
+		rules := domainRules[domain]
+		cert := c.currentCertificate(rules, domain, now)
+		if cert != nil && !c.expiring(rules, cert) {
+			continue
+		}
+		if err := c.issue(ctx, domain, rules, cert); err != nil {
+			logrus.Errorf("issue the certificate of %s: %v", domain, err)
+			c.failed(domain, now)
+			continue
+		}
+		delete(c.failures, domain)
+	}
+}
+
+// currentCertificate returns the certificate issued by the controller or the one used by any rule,
+// which is valid for the domain and is not going to expire, or nil if there is none.
+func (c *Controller) currentCertificate(rules []*dbmodel.HTTPRule, domain string, now time.Time) *dbmodel.Certificate {
+	var current *dbmodel.Certificate
+	for _, rule := range rules {
+		if rule.CertificateID == "" {
+			continue
+		}
+		cert, err := db.GetManager().CertificateDao().GetCertificateByID(rule.CertificateID)
+		if err != nil || cert == nil {
+			continue
+		}
+		if needsRenewal(cert.Certificate, domain, now, c.conf.RenewBefore) {
+			if current == nil && cert.CertificateName == certificateNamePrefix+domain {
+				// renew the certificate in place
+				current = cert
+			}
+			continue
+		}
+		return cert
+	}
+	return current
+}
+
+// expiring returns true if the certificate needs to be issued again, or false if it is valid,
+// the rules using another certificate are switched to it.
+func (c *Controller) expiring(rules []*dbmodel.HTTPRule, cert *dbmodel.Certificate) bool {
+	if needsRenewal(cert.Certificate, "", time.Now(), c.conf.RenewBefore) {
+		return true
+	}
+	if err := c.applyCertificate(rules, cert, false); err != nil {
+		logrus.Warningf("apply certificate %s: %v", cert.UUID, err)
+	}
+	return false
+}
+
+func (c *Controller) failed(domain string, now time.Time) {
+	f := c.failures[domain]
+	if f == nil {
+		f = &failure{}
+		c.failures[domain] = f
+	}
+	f.times++
+	interval := minRetryInterval
+	for i := 1; i < f.times && interval < maxRetryInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxRetryInterval {
+		interval = maxRetryInterval
+	}
+	f.retryAt = now.Add(interval)
+}
+
+// issue obtains a new certificate of the domain, which replaces the certificate issued by the controller before
+func (c *Controller) issue(ctx context.Context, domain string, rules []*dbmodel.HTTPRule, old *dbmodel.Certificate) error {
+	challenge := ChallengeHTTP01
+	if strings.HasPrefix(domain, "*.") {
+		challenge = ChallengeDNS01
+	}
+	exts, err := db.GetManager().RuleExtensionDao().GetRuleExtensionByRuleID(rules[0].UUID)
+	if err != nil {
+		return err
+	}
+	for _, ext := range exts {
+		if ext.Key == string(dbmodel.AutoTLSChallenge) && ext.Value != "" {
+			challenge = ext.Value
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, issueTimeout)
+	defer cancel()
+	logrus.Infof("issue the certificate of %s by the %s challenge", domain, challenge)
+	certPEM, keyPEM, err := c.obtain(ctx, domain, challenge)
+	if err != nil {
+		return err
+	}
+
+	cert := &dbmodel.Certificate{
+		UUID:            util.NewUUID(),
+		CertificateName: certificateNamePrefix + domain,
+	}
+	if old != nil && old.CertificateName == certificateNamePrefix+domain {
+		cert = old
+	}
+	cert.Certificate = string(certPEM)
+	cert.PrivateKey = string(keyPEM)
+	if err := db.GetManager().CertificateDao().AddOrUpdate(cert); err != nil {
+		return fmt.Errorf("save certificate: %v", err)
+	}
+	logrus.Infof("the certificate of %s is issued", domain)
+	return c.applyCertificate(rules, cert, true)
+}
+
+// applyCertificate updates the certificate of the rules and sends the 'apply_rule' tasks,
+// force is true if the certificate is renewed in place, which is applied even if the rule uses it already.
+func (c *Controller) applyCertificate(rules []*dbmodel.HTTPRule, cert *dbmodel.Certificate, force bool) error {
+	for _, rule := range rules {
+		if rule.CertificateID == cert.UUID && !force {
+			continue
+		}
+		rule.CertificateID = cert.UUID
+		if err := db.GetManager().HTTPRuleDao().UpdateModel(rule); err != nil {
+			return err
+		}
+		service, err := db.GetManager().TenantServiceDao().GetServiceByID(rule.ServiceID)
+		if err != nil {
+			return err
+		}
+		err = c.mqclient.SendBuilderTopic(client.TaskStruct{
+			TaskType: "apply_rule",
+			TaskBody: map[string]interface{}{
+				"service_id":     rule.ServiceID,
+				"deploy_version": service.DeployVersion,
+				"action":         "update-http-rule",
+				"limit":          map[string]string{"domain": rule.Domain},
+			},
+			Topic: client.WorkerTopic,
+		})
+		if err != nil {
+			return fmt.Errorf("send apply rule task: %v", err)
+		}
+	}
+	return nil
+}
+
+// needsRenewal returns true if the certificate is invalid, not for the domain or expires in the renew duration,
+// the domain is not checked if it is empty.
+func needsRenewal(certPEM, domain string, now time.Time, renewBefore time.Duration) bool {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return true
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	// a wildcard domain is covered by the certificate if any of its subdomains is
+	if domain != "" && cert.VerifyHostname(strings.Replace(domain, "*", "wildcard", 1)) != nil {
+		return true
+	}
+	return now.Add(renewBefore).After(cert.NotAfter)
+}
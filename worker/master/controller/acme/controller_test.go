@@ -0,0 +1,141 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package acme
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func selfSignedCert(t *testing.T, notAfter time.Time, dnsNames ...string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     dnsNames,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	now := time.Now()
+	renewBefore := 30 * 24 * time.Hour
+	tests := []struct {
+		name   string
+		cert   string
+		domain string
+		want   bool
+	}{
+		{"valid", selfSignedCert(t, now.Add(60*24*time.Hour), "www.example.com"), "www.example.com", false},
+		{"expiring", selfSignedCert(t, now.Add(10*24*time.Hour), "www.example.com"), "www.example.com", true},
+		{"other domain", selfSignedCert(t, now.Add(60*24*time.Hour), "www.example.com"), "api.example.com", true},
+		{"wildcard", selfSignedCert(t, now.Add(60*24*time.Hour), "*.example.com"), "*.example.com", false},
+		{"domain not checked", selfSignedCert(t, now.Add(60*24*time.Hour), "www.example.com"), "", false},
+		{"invalid", "foo", "www.example.com", true},
+	}
+	for _, tc := range tests {
+		if got := needsRenewal(tc.cert, tc.domain, now, renewBefore); got != tc.want {
+			t.Errorf("%s: expected %v, but got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestFailedBackoff(t *testing.T) {
+	c := &Controller{failures: make(map[string]*failure)}
+	now := time.Now()
+	for i, want := range []time.Duration{time.Hour, 2 * time.Hour, 4 * time.Hour} {
+		c.failed("www.example.com", now)
+		if got := c.failures["www.example.com"].retryAt.Sub(now); got != want {
+			t.Errorf("failure %d: expected retry after %s, but got %s", i+1, want, got)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		c.failed("www.example.com", now)
+	}
+	if got := c.failures["www.example.com"].retryAt.Sub(now); got != maxRetryInterval {
+		t.Errorf("expected retry after %s, but got %s", maxRetryInterval, got)
+	}
+}
+
+func TestChallengeSecretName(t *testing.T) {
+	name := challengeSecretName("evaGxfADs6pSRb2LAv9IZf17Dt3juxGJ-PCt92wr-oA")
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		t.Errorf("invalid secret name %s: %v", name, errs)
+	}
+}
+
+func TestWebhookProvider(t *testing.T) {
+	var requests []webhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req webhookRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+	}))
+	defer server.Close()
+
+	if _, err := newWebhookProvider(map[string]string{}); err == nil {
+		t.Errorf("expected an error of the missing url")
+	}
+	provider, err := newWebhookProvider(map[string]string{"url": server.URL, "token": "secret", "propagation_seconds": "0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.Present(context.Background(), "_acme-challenge.example.com.", "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.CleanUp(context.Background(), "_acme-challenge.example.com.", "foo"); err != nil {
+		t.Fatal(err)
+	}
+	want := []webhookRequest{
+		{Action: "present", FQDN: "_acme-challenge.example.com.", Value: "foo"},
+		{Action: "cleanup", FQDN: "_acme-challenge.example.com.", Value: "foo"},
+	}
+	if len(requests) != 2 || requests[0] != want[0] || requests[1] != want[1] {
+		t.Errorf("expected %v, but got %v", want, requests)
+	}
+
+	provider, _ = newWebhookProvider(map[string]string{"url": server.URL, "propagation_seconds": "0"})
+	if err := provider.Present(context.Background(), "_acme-challenge.example.com.", "foo"); err == nil {
+		t.Errorf("expected an error of the unauthorized webhook")
+	}
+}
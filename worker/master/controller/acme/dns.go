@@ -0,0 +1,149 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package acme
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DNSProvider creates and removes the TXT records of the dns-01 challenges
+type DNSProvider interface {
+	// Present creates the TXT record of the fqdn, it returns after the record is propagated
+	Present(ctx context.Context, fqdn, value string) error
+	// CleanUp removes the TXT record of the fqdn
+	CleanUp(ctx context.Context, fqdn, value string) error
+}
+
+// DNSProviderFactory creates a dns provider by the config
+type DNSProviderFactory func(config map[string]string) (DNSProvider, error)
+
+var dnsProviders = map[string]DNSProviderFactory{
+	"webhook": newWebhookProvider,
+}
+
+// RegisterDNSProvider registers the factory of a dns provider, it should be called in init.
+func RegisterDNSProvider(name string, factory DNSProviderFactory) {
+	dnsProviders[name] = factory
+}
+
+// NewDNSProvider creates the dns provider by the name, the config is read from the json file.
+func NewDNSProvider(name, configFile string) (DNSProvider, error) {
+	factory, ok := dnsProviders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown dns provider %s", name)
+	}
+	config := make(map[string]string)
+	if configFile != "" {
+		data, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return nil, fmt.Errorf("read dns provider config: %v", err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("parse dns provider config: %v", err)
+		}
+	}
+	return factory(config)
+}
+
+// webhookProvider sends the TXT records to a webhook, which manages the records of the dns service.
+// The config contains the url of the webhook, the optional bearer token, and propagation_seconds
+// the time to wait for the record propagation, 60 by default.
+type webhookProvider struct {
+	url         string
+	token       string
+	propagation time.Duration
+	client      *http.Client
+}
+
+// webhookRequest the body posted to the webhook
+type webhookRequest struct {
+	// Action present or cleanup
+	Action string `json:"action"`
+	FQDN   string `json:"fqdn"`
+	Value  string `json:"value"`
+}
+
+func newWebhookProvider(config map[string]string) (DNSProvider, error) {
+	if config["url"] == "" {
+		return nil, fmt.Errorf("the url of the webhook dns provider is required")
+	}
+	p := &webhookProvider{
+		url:         config["url"],
+		token:       config["token"],
+		propagation: 60 * time.Second,
+		client:      &http.Client{Timeout: 30 * time.Second},
+	}
+	if s := config["propagation_seconds"]; s != "" {
+		seconds, err := strconv.Atoi(s)
+		if err != nil || seconds < 0 {
+			return nil, fmt.Errorf("invalid propagation_seconds %s", s)
+		}
+		p.propagation = time.Duration(seconds) * time.Second
+	}
+	return p, nil
+}
+
+func (p *webhookProvider) Present(ctx context.Context, fqdn, value string) error {
+	if err := p.call(ctx, webhookRequest{Action: "present", FQDN: fqdn, Value: value}); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(p.propagation):
+		return nil
+	}
+}
+
+func (p *webhookProvider) CleanUp(ctx context.Context, fqdn, value string) error {
+	return p.call(ctx, webhookRequest{Action: "cleanup", FQDN: fqdn, Value: value})
+}
+
+func (p *webhookProvider) call(ctx context.Context, body webhookRequest) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("webhook responds %d: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
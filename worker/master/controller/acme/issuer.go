@@ -0,0 +1,270 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// accountSecretName the secret the private key of the ACME account is stored in
+	accountSecretName = "rbd-acme-account"
+	accountKey        = "account.key"
+
+	// ChallengeLabel the label of the secrets of the http-01 challenges, which are served by the gateways.
+	// The keys of the secret are the tokens, and the values are the key authorizations.
+	ChallengeLabel = "rainbond.io/acme-challenge"
+)
+
+// challengeCheckTimeout the time to wait for the gateways serving the http-01 challenge
+var challengeCheckTimeout = time.Minute
+
+// obtain orders the certificate of the domain, returns the PEM encoded certificate chain and private key
+func (c *Controller) obtain(ctx context.Context, domain, challengeType string) ([]byte, []byte, error) {
+	cli, err := c.acmeClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	order, err := cli.AuthorizeOrder(ctx, acme.DomainIDs(domain))
+	if err != nil {
+		return nil, nil, fmt.Errorf("authorize order: %v", err)
+	}
+	for _, url := range order.AuthzURLs {
+		authz, err := cli.GetAuthorization(ctx, url)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get authorization: %v", err)
+		}
+		if authz.Status != acme.StatusPending {
+			continue
+		}
+		if err := c.authorize(ctx, cli, domain, authz, challengeType); err != nil {
+			return nil, nil, err
+		}
+	}
+	order, err = cli.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wait order: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{domain}}, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	chain, _, err := cli.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create certificate: %v", err)
+	}
+	var certPEM []byte
+	for _, der := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certPEM, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+// authorize fulfills the challenge of the authorization and waits for it to be valid
+func (c *Controller) authorize(ctx context.Context, cli *acme.Client, domain string, authz *acme.Authorization, challengeType string) error {
+	var challenge *acme.Challenge
+	for _, chal := range authz.Challenges {
+		if chal.Type == challengeType {
+			challenge = chal
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("the %s challenge is not offered by the ACME server", challengeType)
+	}
+
+	var cleanup func()
+	switch challengeType {
+	case ChallengeHTTP01:
+		response, err := cli.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return err
+		}
+		if err := c.presentHTTP01(ctx, challenge.Token, response); err != nil {
+			return err
+		}
+		cleanup = func() { c.cleanUpHTTP01(challenge.Token) }
+		c.waitHTTP01(ctx, domain, challenge.Token, response)
+	case ChallengeDNS01:
+		if c.dnsProvider == nil {
+			return fmt.Errorf("no dns provider for the dns-01 challenge, set it by --acme-dns-provider")
+		}
+		value, err := cli.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return err
+		}
+		fqdn := "_acme-challenge." + strings.TrimPrefix(domain, "*.") + "."
+		if err := c.dnsProvider.Present(ctx, fqdn, value); err != nil {
+			return fmt.Errorf("present dns record %s: %v", fqdn, err)
+		}
+		cleanup = func() {
+			if err := c.dnsProvider.CleanUp(context.Background(), fqdn, value); err != nil {
+				logrus.Warningf("clean up dns record %s: %v", fqdn, err)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported challenge %s", challengeType)
+	}
+	defer cleanup()
+
+	if _, err := cli.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("accept challenge: %v", err)
+	}
+	if _, err := cli.WaitAuthorization(ctx, authz.URI); err != nil {
+		return fmt.Errorf("wait authorization of %s: %v", domain, err)
+	}
+	return nil
+}
+
+// challengeSecretName the name of the secret of the http-01 challenge token
+func challengeSecretName(token string) string {
+	sum := sha1.Sum([]byte(token))
+	return "rbd-acme-" + hex.EncodeToString(sum[:])[:20]
+}
+
+func (c *Controller) presentHTTP01(ctx context.Context, token, response string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      challengeSecretName(token),
+			Namespace: c.namespace,
+			Labels: map[string]string{
+				// watched by the gateways
+				"creator":      "Rainbond",
+				ChallengeLabel: ChallengeHTTP01,
+			},
+		},
+		Data: map[string][]byte{token: []byte(response)},
+		Type: corev1.SecretTypeOpaque,
+	}
+	_, err := c.kubeClient.CoreV1().Secrets(c.namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil && !k8sErrors.IsAlreadyExists(err) {
+		return fmt.Errorf("create challenge secret: %v", err)
+	}
+	return nil
+}
+
+func (c *Controller) cleanUpHTTP01(token string) {
+	err := c.kubeClient.CoreV1().Secrets(c.namespace).Delete(context.Background(), challengeSecretName(token), metav1.DeleteOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		logrus.Warningf("delete challenge secret: %v", err)
+	}
+}
+
+// waitHTTP01 waits for the gateways serving the challenge, so that the validation is not failed by the propagation.
+// It gives up after the timeout, because the domain may be not resolved in the cluster.
+func (c *Controller) waitHTTP01(ctx context.Context, domain, token, response string) {
+	cli := &http.Client{Timeout: 5 * time.Second}
+	url := fmt.Sprintf("http://%s/.well-known/acme-challenge/%s", domain, token)
+	deadline := time.Now().Add(challengeCheckTimeout)
+	for time.Now().Before(deadline) {
+		if res, err := cli.Get(url); err == nil {
+			body, _ := ioutil.ReadAll(res.Body)
+			res.Body.Close()
+			if res.StatusCode == http.StatusOK && strings.TrimSpace(string(body)) == response {
+				return
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(2 * time.Second):
+		}
+	}
+	logrus.Warningf("the http-01 challenge of %s is not served in %s", domain, challengeCheckTimeout)
+}
+
+// acmeClient returns the client of the registered account, the account key is created at the first time
+func (c *Controller) acmeClient(ctx context.Context) (*acme.Client, error) {
+	if c.client != nil {
+		return c.client, nil
+	}
+	key, err := c.accountKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get acme account key: %v", err)
+	}
+	cli := &acme.Client{Key: key, DirectoryURL: c.conf.DirectoryURL, UserAgent: "rainbond"}
+	account := &acme.Account{}
+	if c.conf.Email != "" {
+		account.Contact = []string{"mailto:" + c.conf.Email}
+	}
+	if _, err := cli.Register(ctx, account, acme.AcceptTOS); err != nil && err != acme.ErrAccountAlreadyExists {
+		return nil, fmt.Errorf("register acme account: %v", err)
+	}
+	c.client = cli
+	return cli, nil
+}
+
+func (c *Controller) accountKey(ctx context.Context) (crypto.Signer, error) {
+	secret, err := c.kubeClient.CoreV1().Secrets(c.namespace).Get(ctx, accountSecretName, metav1.GetOptions{})
+	if err == nil {
+		block, _ := pem.Decode(secret.Data[accountKey])
+		if block == nil {
+			return nil, fmt.Errorf("no pem data in secret %s", accountSecretName)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	if !k8sErrors.IsNotFound(err) {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: accountSecretName, Namespace: c.namespace},
+		Data:       map[string][]byte{accountKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})},
+		Type:       corev1.SecretTypeOpaque,
+	}
+	if _, err := c.kubeClient.CoreV1().Secrets(c.namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,120 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package canaryrelease
+
+import (
+	"context"
+	"time"
+
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// checkInterval the interval to check the canary releases whose next step is due
+var checkInterval = 10 * time.Second
+
+// Controller moves the progressing canary releases to the next step when their step interval is due.
+// The next step time is saved in the canary release, so the releases are resumed after the workers restart.
+// It should only run on the leader.
+type Controller struct {
+	mqclient client.MQClient
+}
+
+// NewController creates a new canary release controller.
+func NewController(mqclient client.MQClient) *Controller {
+	return &Controller{mqclient: mqclient}
+}
+
+// Start checks the canary releases until the context is done.
+func (c *Controller) Start(ctx context.Context) {
+	logrus.Info("canary release controller starting")
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.check(time.Now())
+		}
+	}
+}
+
+func (c *Controller) check(now time.Time) {
+	releases, err := db.GetManager().TenantServiceCanaryReleaseDao().ListDueReleases(now)
+	if err != nil {
+		logrus.Warningf("list due canary releases: %v", err)
+		return
+	}
+	for _, release := range releases {
+		if err := c.step(release, now); err != nil {
+			logrus.Errorf("move the canary release of component %s to the next step: %v", release.ServiceID, err)
+		}
+	}
+}
+
+// step sends the automatic step of the release to the worker.
+// The next step time is pushed back first, so a failed step is retried after another interval,
+// and a successful step schedules the next one by itself.
+func (c *Controller) step(release *dbmodel.TenantServiceCanaryRelease, now time.Time) error {
+	service, err := db.GetManager().TenantServiceDao().GetServiceByID(release.ServiceID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// the component is deleted, stop the release instead of retrying it forever
+			logrus.Warningf("component %s of the canary release is not found, abort the release", release.ServiceID)
+			release.Weight = 0
+			release.Status = dbmodel.CanaryReleaseStatusAborted.String()
+			release.NextStepTime = nil
+			return db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release)
+		}
+		return err
+	}
+	next := release.NextStepTime
+	release.ScheduleNextStep(now)
+	if err := db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release); err != nil {
+		return err
+	}
+	err = c.mqclient.SendBuilderTopic(client.TaskStruct{
+		TaskType: "canary_release",
+		TaskBody: model.CanaryReleaseTaskBody{
+			TenantID:      service.TenantID,
+			ServiceID:     release.ServiceID,
+			EventID:       release.EventID,
+			Action:        model.CanaryReleasePromote,
+			Auto:          true,
+			Step:          release.CurrentStep,
+			CanaryVersion: release.CanaryVersion,
+		},
+		Topic:    client.WorkerTopic,
+		TenantID: service.TenantID,
+	})
+	if err != nil {
+		release.NextStepTime = next
+		if uerr := db.GetManager().TenantServiceCanaryReleaseDao().UpdateModel(release); uerr != nil {
+			logrus.Warningf("restore the next step time of the canary release of component %s: %v", release.ServiceID, uerr)
+		}
+		return err
+	}
+	logrus.Infof("canary release of component %s moves to the next step of %d automatically", release.ServiceID, release.CurrentStep)
+	return nil
+}
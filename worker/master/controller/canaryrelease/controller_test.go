@@ -0,0 +1,96 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package canaryrelease
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/goodrain/rainbond/db"
+	"github.com/goodrain/rainbond/db/dao"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/jinzhu/gorm"
+)
+
+type fakeMQClient struct {
+	client.MQClient
+	tasks []client.TaskStruct
+}
+
+func (f *fakeMQClient) SendBuilderTopic(t client.TaskStruct) error {
+	f.tasks = append(f.tasks, t)
+	return nil
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		serviceErr error
+		wantTask   bool
+		wantStatus string
+	}{
+		{name: "due", wantTask: true, wantStatus: dbmodel.CanaryReleaseStatusProgressing.String()},
+		{name: "component deleted", serviceErr: gorm.ErrRecordNotFound, wantStatus: dbmodel.CanaryReleaseStatusAborted.String()},
+	}
+	for idx := range tests {
+		tc := tests[idx]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			due := now.Add(-time.Second)
+			release := &dbmodel.TenantServiceCanaryRelease{
+				ServiceID:    "component",
+				StepInterval: 60,
+				Weight:       10,
+				Status:       dbmodel.CanaryReleaseStatusProgressing.String(),
+				NextStepTime: &due,
+			}
+			dbmanager := db.NewMockManager(ctrl)
+			db.SetTestManager(dbmanager)
+			releaseDao := dao.NewMockTenantServiceCanaryReleaseDao(ctrl)
+			dbmanager.EXPECT().TenantServiceCanaryReleaseDao().AnyTimes().Return(releaseDao)
+			releaseDao.EXPECT().ListDueReleases(now).Return([]*dbmodel.TenantServiceCanaryRelease{release}, nil)
+			releaseDao.EXPECT().UpdateModel(release).Return(nil)
+			serviceDao := dao.NewMockTenantServiceDao(ctrl)
+			dbmanager.EXPECT().TenantServiceDao().AnyTimes().Return(serviceDao)
+			var service *dbmodel.TenantServices
+			if tc.serviceErr == nil {
+				service = &dbmodel.TenantServices{TenantID: "tenant", ServiceID: "component"}
+			}
+			serviceDao.EXPECT().GetServiceByID("component").Return(service, tc.serviceErr)
+
+			mqclient := &fakeMQClient{}
+			NewController(mqclient).check(now)
+
+			if (len(mqclient.tasks) == 1) != tc.wantTask {
+				t.Errorf("expected task %v, but got %v", tc.wantTask, mqclient.tasks)
+			}
+			if release.Status != tc.wantStatus {
+				t.Errorf("expected status %s, but got %s", tc.wantStatus, release.Status)
+			}
+			if tc.wantTask != (release.NextStepTime != nil) {
+				t.Errorf("expected the next step scheduled %v, but got %v", tc.wantTask, release.NextStepTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package idlepolicy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/goodrain/rainbond/api/client/prometheus"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/util"
+	"github.com/goodrain/rainbond/worker/appm/store"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/sirupsen/logrus"
+)
+
+// checkInterval the interval to check the idle policies
+var checkInterval = time.Minute
+
+// Controller scales the components to zero when the gateway sees no request in their idle timeout.
+// The components are woken up by the 'wake_component' task which is sent by the gateway.
+// It should only run on the leader.
+type Controller struct {
+	store         store.Storer
+	mqclient      client.MQClient
+	prometheusCli prometheus.Interface
+}
+
+// NewController creates a new idle policy controller.
+func NewController(store store.Storer, mqclient client.MQClient, prometheusCli prometheus.Interface) *Controller {
+	return &Controller{
+		store:         store,
+		mqclient:      mqclient,
+		prometheusCli: prometheusCli,
+	}
+}
+
+// Start checks the idle policies until the context is done.
+func (c *Controller) Start(ctx context.Context) {
+	logrus.Info("idle policy controller starting")
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.check(time.Now())
+		}
+	}
+}
+
+func (c *Controller) check(now time.Time) {
+	policies, err := db.GetManager().TenantServiceIdlePolicyDao().ListEnableOnes()
+	if err != nil {
+		logrus.Warningf("list idle policies: %v", err)
+		return
+	}
+	for _, policy := range policies {
+		if policy.Idle || policy.IdleTimeout <= 0 {
+			continue
+		}
+		app := c.store.GetAppService(policy.ServiceID)
+		if app == nil || app.IsClosed() || app.Replicas == 0 {
+			continue
+		}
+		// the replicas of the component is managed by the autoscaler
+		rules, err := db.GetManager().TenantServceAutoscalerRulesDao().ListEnableOnesByServiceID(policy.ServiceID)
+		if err != nil || len(rules) > 0 {
+			continue
+		}
+		lastActive, err := c.lastRequestTime(policy, now)
+		if err != nil {
+			// keep the component if the activity is unknown
+			logrus.Warningf("get the last request time of component %s: %v", policy.ServiceID, err)
+			continue
+		}
+		if policy.ActiveTime.After(lastActive) {
+			lastActive = policy.ActiveTime
+		}
+		if now.Sub(lastActive) < time.Duration(policy.IdleTimeout)*time.Second {
+			continue
+		}
+		if err := c.scaleToZero(policy, lastActive); err != nil {
+			logrus.Errorf("scale idle component %s to zero: %v", policy.ServiceID, err)
+		}
+	}
+}
+
+// lastRequestTime returns the last time the gateways see a request of the component in the idle timeout,
+// the zero time if there is no request.
+func (c *Controller) lastRequestTime(policy *dbmodel.TenantServiceIdlePolicy, now time.Time) (time.Time, error) {
+	metric := c.prometheusCli.GetMetric(fmt.Sprintf(`max(max_over_time(gateway_last_request_timestamp_seconds{service_id="%s"}[%ds]))`,
+		policy.ServiceID, policy.IdleTimeout), now)
+	if metric.Error != "" {
+		return time.Time{}, fmt.Errorf("query last request time: %s", metric.Error)
+	}
+	for _, value := range metric.MetricValues {
+		if value.Sample == nil {
+			continue
+		}
+		if v := value.Sample.Value(); v > 0 && !math.IsNaN(v) && !math.IsInf(v, 0) {
+			return time.Unix(int64(v), 0), nil
+		}
+	}
+	return time.Time{}, nil
+}
+
+// scaleToZero records the replicas of the component in the policy and sends a horizontal scaling task to the worker
+func (c *Controller) scaleToZero(policy *dbmodel.TenantServiceIdlePolicy, lastActive time.Time) error {
+	service, err := db.GetManager().TenantServiceDao().GetServiceByID(policy.ServiceID)
+	if err != nil {
+		return err
+	}
+	if service.Replicas == 0 {
+		return nil
+	}
+	policy.Idle = true
+	policy.Replicas = service.Replicas
+	if err := db.GetManager().TenantServiceIdlePolicyDao().UpdateModel(policy); err != nil {
+		return err
+	}
+	rollback := func() {
+		policy.Idle = false
+		if err := db.GetManager().TenantServiceIdlePolicyDao().UpdateModel(policy); err != nil {
+			logrus.Errorf("rollback idle policy of component %s: %v", policy.ServiceID, err)
+		}
+	}
+	evt := &dbmodel.ServiceEvent{
+		EventID:   util.NewUUID(),
+		TenantID:  service.TenantID,
+		ServiceID: service.ServiceID,
+		Target:    dbmodel.TargetTypeService,
+		TargetID:  service.ServiceID,
+		UserName:  dbmodel.UsernameSystem,
+		StartTime: time.Now().Format(time.RFC3339),
+		OptType:   "idle-service",
+		SynType:   dbmodel.ASYNEVENTTYPE,
+		Reason:    fmt.Sprintf("no request in %d seconds", policy.IdleTimeout),
+	}
+	if err := db.GetManager().ServiceEventDao().AddModel(evt); err != nil {
+		rollback()
+		return err
+	}
+	logger := event.GetManager().GetLogger(evt.EventID)
+	defer event.GetManager().ReleaseLogger(logger)
+	logger.Info(fmt.Sprintf("no request since %s, scale the component from %d to 0", lastActive.Format(time.RFC3339), service.Replicas), event.GetLoggerOption("starting"))
+
+	service.Replicas = 0
+	if err := db.GetManager().TenantServiceDao().UpdateModel(service); err != nil {
+		rollback()
+		logger.Error("update replicas failure", event.GetCallbackLoggerOption())
+		return err
+	}
+	err = c.mqclient.SendBuilderTopic(client.TaskStruct{
+		TaskType: "horizontal_scaling",
+		TaskBody: model.HorizontalScalingTaskBody{
+			TenantID:   service.TenantID,
+			ServiceID:  service.ServiceID,
+			Replicas:   0,
+			EventID:    evt.EventID,
+			Username:   dbmodel.UsernameSystem,
+			RecordType: dbmodel.ScalingRecordTypeIdle,
+		},
+		Topic: client.WorkerTopic,
+	})
+	if err != nil {
+		rollback()
+		service.Replicas = policy.Replicas
+		if err := db.GetManager().TenantServiceDao().UpdateModel(service); err != nil {
+			logrus.Errorf("rollback replicas of component %s: %v", service.ServiceID, err)
+		}
+		logger.Error("send horizontal scaling task failure", event.GetCallbackLoggerOption())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package idlepolicy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/goodrain/rainbond/api/client/prometheus"
+	"github.com/goodrain/rainbond/db"
+	"github.com/goodrain/rainbond/db/dao"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/worker/appm/store"
+	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakePrometheus struct {
+	prometheus.Interface
+	metric  prometheus.Metric
+	queries int
+}
+
+func (f *fakePrometheus) GetMetric(expr string, time time.Time) prometheus.Metric {
+	f.queries++
+	return f.metric
+}
+
+type fakeMQClient struct {
+	client.MQClient
+	err   error
+	tasks []client.TaskStruct
+}
+
+func (f *fakeMQClient) SendBuilderTopic(t client.TaskStruct) error {
+	f.tasks = append(f.tasks, t)
+	return f.err
+}
+
+func lastRequestAt(t time.Time) prometheus.Metric {
+	return prometheus.Metric{MetricData: prometheus.MetricData{MetricValues: []prometheus.MetricValue{
+		{Sample: &prometheus.Point{float64(t.Unix()), float64(t.Unix())}},
+	}}}
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		rules      []*dbmodel.TenantServiceAutoscalerRules
+		metric     prometheus.Metric
+		activeTime time.Time
+		sendErr    error
+		wantQuery  bool
+		wantScale  bool
+		wantIdle   bool
+	}{
+		{
+			name:      "timeout",
+			metric:    lastRequestAt(now.Add(-10 * time.Minute)),
+			wantQuery: true,
+			wantScale: true,
+			wantIdle:  true,
+		},
+		{
+			name:      "no request",
+			metric:    prometheus.Metric{},
+			wantQuery: true,
+			wantScale: true,
+			wantIdle:  true,
+		},
+		{
+			name:      "active",
+			metric:    lastRequestAt(now.Add(-time.Minute)),
+			wantQuery: true,
+		},
+		{
+			name:       "woken recently",
+			metric:     prometheus.Metric{},
+			activeTime: now.Add(-time.Minute),
+			wantQuery:  true,
+		},
+		{
+			name:  "managed by autoscaler",
+			rules: []*dbmodel.TenantServiceAutoscalerRules{{RuleID: "rule"}},
+		},
+		{
+			name:      "unknown activity",
+			metric:    prometheus.Metric{Error: "prometheus is unavailable"},
+			wantQuery: true,
+		},
+		{
+			name:      "send task failure",
+			metric:    lastRequestAt(now.Add(-10 * time.Minute)),
+			sendErr:   fmt.Errorf("mq is unavailable"),
+			wantQuery: true,
+			wantScale: true,
+		},
+	}
+	for idx := range tests {
+		tc := tests[idx]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			policy := &dbmodel.TenantServiceIdlePolicy{
+				ServiceID:   "component",
+				Enable:      true,
+				IdleTimeout: 300,
+				ActiveTime:  tc.activeTime,
+			}
+			service := &dbmodel.TenantServices{TenantID: "tenant", ServiceID: "component", Replicas: 2}
+
+			dbmanager := db.NewMockManager(ctrl)
+			db.SetTestManager(dbmanager)
+			policyDao := dao.NewMockTenantServiceIdlePolicyDao(ctrl)
+			dbmanager.EXPECT().TenantServiceIdlePolicyDao().AnyTimes().Return(policyDao)
+			policyDao.EXPECT().ListEnableOnes().Return([]*dbmodel.TenantServiceIdlePolicy{policy}, nil)
+			rulesDao := dao.NewMockTenantServceAutoscalerRulesDao(ctrl)
+			dbmanager.EXPECT().TenantServceAutoscalerRulesDao().AnyTimes().Return(rulesDao)
+			rulesDao.EXPECT().ListEnableOnesByServiceID("component").Return(tc.rules, nil)
+
+			var idle []bool
+			var replicas []int
+			if tc.wantScale {
+				serviceDao := dao.NewMockTenantServiceDao(ctrl)
+				dbmanager.EXPECT().TenantServiceDao().AnyTimes().Return(serviceDao)
+				serviceDao.EXPECT().GetServiceByID("component").Return(service, nil)
+				serviceDao.EXPECT().UpdateModel(service).AnyTimes().DoAndReturn(func(mo dbmodel.Interface) error {
+					replicas = append(replicas, mo.(*dbmodel.TenantServices).Replicas)
+					return nil
+				})
+				policyDao.EXPECT().UpdateModel(policy).AnyTimes().DoAndReturn(func(mo dbmodel.Interface) error {
+					idle = append(idle, mo.(*dbmodel.TenantServiceIdlePolicy).Idle)
+					return nil
+				})
+				eventDao := dao.NewMockEventDao(ctrl)
+				dbmanager.EXPECT().ServiceEventDao().AnyTimes().Return(eventDao)
+				eventDao.EXPECT().AddModel(gomock.Any()).Return(nil)
+
+				lm := event.NewMockManager(ctrl)
+				event.NewTestManager(lm)
+				l := event.NewLogger("event", make(chan []byte, 10))
+				lm.EXPECT().GetLogger(gomock.Any()).Return(l)
+				lm.EXPECT().ReleaseLogger(l)
+			}
+
+			deployReplicas := int32(2)
+			app := &v1.AppService{}
+			app.SetDeployment(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &deployReplicas},
+			})
+			storer := store.NewMockStorer(ctrl)
+			storer.EXPECT().GetAppService("component").Return(app)
+
+			prometheusCli := &fakePrometheus{metric: tc.metric}
+			mqclient := &fakeMQClient{err: tc.sendErr}
+			c := NewController(storer, mqclient, prometheusCli)
+			c.check(now)
+
+			if (prometheusCli.queries > 0) != tc.wantQuery {
+				t.Errorf("expected query %v, but got %d queries", tc.wantQuery, prometheusCli.queries)
+			}
+			if !tc.wantScale {
+				if len(mqclient.tasks) != 0 {
+					t.Errorf("expected no task, but got %v", mqclient.tasks)
+				}
+				return
+			}
+			if len(mqclient.tasks) != 1 {
+				t.Fatalf("expected 1 task, but got %d", len(mqclient.tasks))
+			}
+			body := mqclient.tasks[0].TaskBody.(model.HorizontalScalingTaskBody)
+			if mqclient.tasks[0].TaskType != "horizontal_scaling" || body.Replicas != 0 {
+				t.Errorf("expected scaling to 0, but got %s %v", mqclient.tasks[0].TaskType, body)
+			}
+			if policy.Idle != tc.wantIdle {
+				t.Errorf("expected idle %v, but got %v (updates: %v)", tc.wantIdle, policy.Idle, idle)
+			}
+			if policy.Replicas != 2 {
+				t.Errorf("expected 2 replicas recorded in the policy, but got %d", policy.Replicas)
+			}
+			wantReplicas := []int{0}
+			if tc.sendErr != nil {
+				wantReplicas = []int{0, 2}
+			}
+			if fmt.Sprint(replicas) != fmt.Sprint(wantReplicas) {
+				t.Errorf("expected replicas updates %v, but got %v", wantReplicas, replicas)
+			}
+		})
+	}
+}
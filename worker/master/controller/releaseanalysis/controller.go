@@ -0,0 +1,262 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package releaseanalysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/goodrain/rainbond/api/client/prometheus"
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/util"
+	"github.com/goodrain/rainbond/worker/appm/store"
+	v1 "github.com/goodrain/rainbond/worker/appm/types/v1"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/sirupsen/logrus"
+)
+
+// checkInterval the interval to check the analyzing releases
+var checkInterval = 10 * time.Second
+
+// releaseReadyTimeout the max time to wait for the new version to be ready before the analysis
+var releaseReadyTimeout = 10 * time.Minute
+
+// minInterval the min interval between two evaluations of a release
+var minInterval = 10 * time.Second
+
+// Controller analyzes the new versions of the components recorded by the rolling upgrades,
+// and rolls back to the previous versions if any threshold of the analysis is breached.
+// The state of the analysis is saved in the health records, so the analysis is resumed after the workers restart.
+// It should only run on the leader.
+type Controller struct {
+	store         store.Storer
+	mqclient      client.MQClient
+	prometheusCli prometheus.Interface
+}
+
+// NewController creates a new release analysis controller.
+func NewController(store store.Storer, mqclient client.MQClient, prometheusCli prometheus.Interface) *Controller {
+	return &Controller{
+		store:         store,
+		mqclient:      mqclient,
+		prometheusCli: prometheusCli,
+	}
+}
+
+// Start checks the analyzing releases until the context is done.
+func (c *Controller) Start(ctx context.Context) {
+	logrus.Info("release analysis controller starting")
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.check(time.Now())
+		}
+	}
+}
+
+func (c *Controller) check(now time.Time) {
+	records, err := db.GetManager().TenantServiceReleaseHealthRecordsDao().ListByStatus(dbmodel.ReleaseHealthAnalyzing.String())
+	if err != nil {
+		logrus.Warningf("list analyzing releases: %v", err)
+		return
+	}
+	for _, record := range records {
+		c.analyze(record, now)
+	}
+}
+
+// analyze evaluates the release once if it is ready and its interval is due
+func (c *Controller) analyze(record *dbmodel.TenantServiceReleaseHealthRecords, now time.Time) {
+	service, reason := c.releaseChanged(record)
+	if reason != "" {
+		c.finish(record, dbmodel.ReleaseHealthCanceled, reason, now)
+		return
+	}
+	analysis, err := db.GetManager().TenantServiceReleaseAnalysisDao().GetByServiceID(record.ServiceID)
+	if err != nil || !analysis.Enable {
+		c.finish(record, dbmodel.ReleaseHealthCanceled, "release analysis is disabled", now)
+		return
+	}
+	app := c.store.GetAppService(record.ServiceID)
+	if record.ReadyTime == nil {
+		if app.GetRunningVersion() != record.DeployVersion || !app.UpgradeComlete() {
+			if now.Sub(record.StartTime) > releaseReadyTimeout {
+				c.finish(record, dbmodel.ReleaseHealthCanceled, fmt.Sprintf("version %s is not ready in %s", record.DeployVersion, releaseReadyTimeout), now)
+			}
+			return
+		}
+		// the analysis window starts after the new version is ready
+		record.ReadyTime = &now
+		c.update(record)
+		return
+	}
+	interval := time.Duration(analysis.Interval) * time.Second
+	if interval < minInterval {
+		interval = minInterval
+	}
+	if record.CheckTime != nil && now.Sub(*record.CheckTime) < interval {
+		return
+	}
+	record.CheckTime = &now
+	errorRate, p99Latency, restarts, err := c.queryReleaseMetrics(app, now.Sub(*record.ReadyTime), now)
+	if err != nil {
+		// metrics are not available, keep the current version
+		logrus.Warningf("query release metrics of component %s: %v", record.ServiceID, err)
+	} else {
+		record.ErrorRate, record.P99Latency, record.Restarts = errorRate, p99Latency, restarts
+		if reason := analysis.Breached(errorRate, p99Latency, restarts); reason != "" {
+			c.rollbackRelease(service, record, reason, now)
+			return
+		}
+	}
+	if !now.Before(record.ReadyTime.Add(time.Duration(analysis.Window) * time.Second)) {
+		c.finish(record, dbmodel.ReleaseHealthHealthy, "", now)
+		return
+	}
+	c.update(record)
+}
+
+// releaseChanged returns the reason if the deploy version of the component is no longer analyzed
+func (c *Controller) releaseChanged(record *dbmodel.TenantServiceReleaseHealthRecords) (*dbmodel.TenantServices, string) {
+	service, err := db.GetManager().TenantServiceDao().GetServiceByID(record.ServiceID)
+	if err != nil {
+		return nil, fmt.Sprintf("get component failure: %v", err)
+	}
+	if service.DeployVersion != record.DeployVersion {
+		return nil, fmt.Sprintf("component is deployed with version %s", service.DeployVersion)
+	}
+	if app := c.store.GetAppService(record.ServiceID); app == nil || app.IsClosed() {
+		return nil, "component is closed"
+	}
+	return service, ""
+}
+
+func (c *Controller) update(record *dbmodel.TenantServiceReleaseHealthRecords) {
+	if err := db.GetManager().TenantServiceReleaseHealthRecordsDao().UpdateModel(record); err != nil {
+		logrus.Warningf("update release health record of component %s: %v", record.ServiceID, err)
+	}
+}
+
+func (c *Controller) finish(record *dbmodel.TenantServiceReleaseHealthRecords, status dbmodel.ReleaseHealthStatus, reason string, now time.Time) {
+	record.Status = status.String()
+	record.Reason = reason
+	record.EndTime = now
+	c.update(record)
+}
+
+// queryReleaseMetrics returns the percentage of 5xx responses, the p99 latency in milliseconds
+// and the container restarts of the component since the new version is ready.
+func (c *Controller) queryReleaseMetrics(app *v1.AppService, elapsed time.Duration, now time.Time) (float64, float64, int, error) {
+	window := int(elapsed.Seconds())
+	if window < 60 {
+		window = 60
+	}
+	errorRate, err := c.queryScalar(fmt.Sprintf(`sum(rate(gateway_requests{service_id="%s",status=~"5.."}[%ds])) / sum(rate(gateway_requests{service_id="%s"}[%ds])) * 100`,
+		app.ServiceID, window, app.ServiceID, window), now)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	p99Latency, err := c.queryScalar(fmt.Sprintf(`histogram_quantile(0.99, sum(rate(gateway_request_duration_seconds_bucket{service_id="%s"}[%ds])) by (le)) * 1000`,
+		app.ServiceID, window), now)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	restarts, err := c.queryScalar(fmt.Sprintf(`sum(increase(kube_pod_container_status_restarts_total{namespace="%s",pod=~"%s-.*"}[%ds]))`,
+		app.GetNamespace(), app.GetK8sWorkloadName(), window), now)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return errorRate, p99Latency, int(math.Round(restarts)), nil
+}
+
+// queryScalar returns the first value of the query, 0 if there is no data, such as no requests.
+func (c *Controller) queryScalar(expr string, ts time.Time) (float64, error) {
+	metric := c.prometheusCli.GetMetric(expr, ts)
+	if metric.Error != "" {
+		return 0, fmt.Errorf("query %s: %s", expr, metric.Error)
+	}
+	for _, value := range metric.MetricValues {
+		if value.Sample == nil {
+			continue
+		}
+		if v := value.Sample.Value(); !math.IsNaN(v) && !math.IsInf(v, 0) {
+			return v, nil
+		}
+	}
+	return 0, nil
+}
+
+// rollbackRelease rolls the component back to the previous version through the rolling upgrade,
+// the reason is recorded in a new event and in the health record.
+func (c *Controller) rollbackRelease(service *dbmodel.TenantServices, record *dbmodel.TenantServiceReleaseHealthRecords, reason string, now time.Time) {
+	reason = fmt.Sprintf("release analysis of version %s failed: %s", record.DeployVersion, reason)
+	evt := &dbmodel.ServiceEvent{
+		EventID:   util.NewUUID(),
+		TenantID:  service.TenantID,
+		ServiceID: service.ServiceID,
+		Target:    dbmodel.TargetTypeService,
+		TargetID:  service.ServiceID,
+		UserName:  dbmodel.UsernameSystem,
+		StartTime: now.Format(time.RFC3339),
+		OptType:   "rollback-service",
+		SynType:   dbmodel.ASYNEVENTTYPE,
+		Reason:    reason,
+	}
+	record.RollbackEventID = evt.EventID
+	c.finish(record, dbmodel.ReleaseHealthRolledBack, reason, now)
+	if err := db.GetManager().ServiceEventDao().AddModel(evt); err != nil {
+		logrus.Errorf("create rollback event of component %s: %v", service.ServiceID, err)
+		return
+	}
+	logger := event.GetManager().GetLogger(evt.EventID)
+	defer event.GetManager().ReleaseLogger(logger)
+	logger.Info(fmt.Sprintf("%s, rollback to version %s", reason, record.PreviousVersion), event.GetLoggerOption("starting"))
+	if err := db.GetManager().TenantServiceDao().UpdateDeployVersion(service.ServiceID, record.PreviousVersion); err != nil {
+		logrus.Errorf("update deploy version of component %s: %v", service.ServiceID, err)
+		logger.Error("update deploy version failure", event.GetCallbackLoggerOption())
+		return
+	}
+	// the previous version is trusted, it is not analyzed again
+	err := c.mqclient.SendBuilderTopic(client.TaskStruct{
+		TaskType: "rolling_upgrade",
+		TaskBody: model.RollingUpgradeTaskBody{
+			TenantID:         service.TenantID,
+			ServiceID:        service.ServiceID,
+			NewDeployVersion: record.PreviousVersion,
+			EventID:          evt.EventID,
+			SkipAnalysis:     true,
+		},
+		Topic:    client.WorkerTopic,
+		Priority: client.PriorityHigh,
+		TenantID: service.TenantID,
+	})
+	if err != nil {
+		logrus.Errorf("send rollback task of component %s: %v", service.ServiceID, err)
+		logger.Error("send rollback task failure", event.GetCallbackLoggerOption())
+	}
+}
@@ -0,0 +1,173 @@
+// RAINBOND, Application Management Platform
+// Copyright (C) 2022-2022 Goodrain Co., Ltd.
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version. For any non-GPL usage of Rainbond,
+// one or multiple Commercial Licenses authorized by Goodrain Co., Ltd.
+// must be obtained first.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package scalingschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goodrain/rainbond/db"
+	dbmodel "github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/event"
+	"github.com/goodrain/rainbond/mq/client"
+	"github.com/goodrain/rainbond/util"
+	"github.com/goodrain/rainbond/util/cron"
+	"github.com/goodrain/rainbond/worker/discover/model"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// checkInterval the interval to check the scaling schedules
+	checkInterval = 30 * time.Second
+	// missTolerance the schedule which is missed longer than it will be skipped,
+	// such as no leader of the worker at the time.
+	missTolerance = 5 * time.Minute
+)
+
+// Controller scales the components at the time of their scaling schedules.
+// It should only run on the leader, so that a schedule is fired only once.
+type Controller struct {
+	mqclient client.MQClient
+}
+
+// NewController creates a new scaling schedule controller.
+func NewController(mqclient client.MQClient) *Controller {
+	return &Controller{
+		mqclient: mqclient,
+	}
+}
+
+// Start checks the scaling schedules until the context is done.
+func (c *Controller) Start(ctx context.Context) {
+	logrus.Info("scaling schedule controller starting")
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.check(time.Now())
+		}
+	}
+}
+
+func (c *Controller) check(now time.Time) {
+	schedules, err := db.GetManager().TenantServiceScalingSchedulesDao().ListEnableOnes()
+	if err != nil {
+		logrus.Warningf("list scaling schedules: %v", err)
+		return
+	}
+	for _, schedule := range schedules {
+		fire, err := due(schedule, now)
+		if err != nil {
+			logrus.Warningf("scaling schedule %s: %v", schedule.ScheduleID, err)
+			continue
+		}
+		if !fire {
+			continue
+		}
+		if err := c.scale(schedule); err != nil {
+			logrus.Errorf("scale component %s by schedule %s: %v", schedule.ServiceID, schedule.ScheduleID, err)
+		}
+	}
+}
+
+// due moves the last time of the schedule to now if there is a scheduled time between them.
+// It returns true if the scheduled time is not missed too long.
+func due(schedule *dbmodel.TenantServiceScalingSchedules, now time.Time) (bool, error) {
+	loc, err := schedule.Location()
+	if err != nil {
+		return false, err
+	}
+	sched, err := cron.Parse(schedule.Cron)
+	if err != nil {
+		return false, err
+	}
+	next := sched.Next(schedule.LastTime.In(loc))
+	if next.IsZero() || next.After(now) {
+		return false, nil
+	}
+	schedule.LastTime = now
+	if err := db.GetManager().TenantServiceScalingSchedulesDao().UpdateModel(schedule); err != nil {
+		return false, err
+	}
+	if now.Sub(next) > missTolerance {
+		logrus.Warningf("scaling schedule %s at %s is missed, skip it", schedule.ScheduleID, next)
+		return false, nil
+	}
+	return true, nil
+}
+
+// scale sets the replicas of the component and sends a horizontal scaling task to the worker
+func (c *Controller) scale(schedule *dbmodel.TenantServiceScalingSchedules) error {
+	service, err := db.GetManager().TenantServiceDao().GetServiceByID(schedule.ServiceID)
+	if err != nil {
+		return err
+	}
+	if service.Replicas == schedule.Replicas {
+		return nil
+	}
+	evt := &dbmodel.ServiceEvent{
+		EventID:   util.NewUUID(),
+		TenantID:  service.TenantID,
+		ServiceID: service.ServiceID,
+		Target:    dbmodel.TargetTypeService,
+		TargetID:  service.ServiceID,
+		UserName:  dbmodel.UsernameSystem,
+		StartTime: time.Now().Format(time.RFC3339),
+		OptType:   "horizontal-service",
+		SynType:   dbmodel.ASYNEVENTTYPE,
+		Reason:    fmt.Sprintf("scaling schedule %s(%s)", schedule.ScheduleID, schedule.Cron),
+	}
+	if err := db.GetManager().ServiceEventDao().AddModel(evt); err != nil {
+		return err
+	}
+	logger := event.GetManager().GetLogger(evt.EventID)
+	defer event.GetManager().ReleaseLogger(logger)
+	logger.Info(fmt.Sprintf("scale the replicas from %d to %d by scaling schedule %s", service.Replicas, schedule.Replicas, schedule.ScheduleID), event.GetLoggerOption("starting"))
+
+	oldReplicas := service.Replicas
+	service.Replicas = schedule.Replicas
+	if err := db.GetManager().TenantServiceDao().UpdateModel(service); err != nil {
+		logger.Error("update replicas failure", event.GetCallbackLoggerOption())
+		return err
+	}
+	err = c.mqclient.SendBuilderTopic(client.TaskStruct{
+		TaskType: "horizontal_scaling",
+		TaskBody: model.HorizontalScalingTaskBody{
+			TenantID:   service.TenantID,
+			ServiceID:  service.ServiceID,
+			Replicas:   int32(schedule.Replicas),
+			EventID:    evt.EventID,
+			Username:   dbmodel.UsernameSystem,
+			RecordType: dbmodel.ScalingRecordTypeCron,
+			RuleID:     schedule.ScheduleID,
+		},
+		Topic: client.WorkerTopic,
+	})
+	if err != nil {
+		service.Replicas = oldReplicas
+		_ = db.GetManager().TenantServiceDao().UpdateModel(service)
+		logger.Error("send horizontal scaling task failure", event.GetCallbackLoggerOption())
+		return err
+	}
+	return nil
+}
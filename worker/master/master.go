@@ -24,15 +24,23 @@ import (
 	"strings"
 	"time"
 
+	promclient "github.com/goodrain/rainbond/api/client/prometheus"
 	"github.com/goodrain/rainbond/cmd/worker/option"
 	"github.com/goodrain/rainbond/db"
 	"github.com/goodrain/rainbond/db/model"
+	"github.com/goodrain/rainbond/mq/client"
 	"github.com/goodrain/rainbond/pkg/common"
 	"github.com/goodrain/rainbond/pkg/generated/clientset/versioned"
+	etcdutil "github.com/goodrain/rainbond/util/etcd"
 	"github.com/goodrain/rainbond/util/leader"
 	"github.com/goodrain/rainbond/worker/appm/store"
 	mcontroller "github.com/goodrain/rainbond/worker/master/controller"
+	"github.com/goodrain/rainbond/worker/master/controller/acme"
+	"github.com/goodrain/rainbond/worker/master/controller/canaryrelease"
 	"github.com/goodrain/rainbond/worker/master/controller/helmapp"
+	"github.com/goodrain/rainbond/worker/master/controller/idlepolicy"
+	"github.com/goodrain/rainbond/worker/master/controller/releaseanalysis"
+	"github.com/goodrain/rainbond/worker/master/controller/scalingschedule"
 	"github.com/goodrain/rainbond/worker/master/controller/thirdcomponent"
 	"github.com/goodrain/rainbond/worker/master/podevent"
 	"github.com/goodrain/rainbond/worker/master/volumes/provider"
@@ -65,6 +73,12 @@ type Controller struct {
 	namespaceCPULimit   *prometheus.GaugeVec
 	pc                  *controller.ProvisionController
 	helmAppController   *helmapp.Controller
+	scheduleController  *scalingschedule.Controller
+	idleController      *idlepolicy.Controller
+	canaryController    *canaryrelease.Controller
+	analysisController  *releaseanalysis.Controller
+	acmeController      *acme.Controller
+	mqclient            client.MQClient
 	controllers         []mcontroller.Controller
 	isLeader            bool
 
@@ -110,16 +124,52 @@ func NewMasterController(conf option.Config, store store.Storer, kubeClient kube
 	helmAppController := helmapp.NewController(ctx, stopCh, kubeClient, rainbondClient,
 		store.Informer().HelmApp, store.Lister().HelmApp, conf.Helm.RepoFile, conf.Helm.RepoCache, conf.Helm.RepoCache)
 
+	// the scaling schedules are fired through the horizontal scaling task
+	mqclient, err := client.NewMqClient(&etcdutil.ClientArgs{
+		Endpoints: conf.EtcdEndPoints,
+		CaFile:    conf.EtcdCaFile,
+		CertFile:  conf.EtcdCertFile,
+		KeyFile:   conf.EtcdKeyFile,
+	}, conf.MQAPI)
+	if err != nil {
+		logrus.Errorf("new mq client: %v", err)
+		cancel()
+		return nil, err
+	}
+	var idleController *idlepolicy.Controller
+	var analysisController *releaseanalysis.Controller
+	prometheusCli, err := promclient.NewPrometheus(&promclient.Options{
+		Endpoint: conf.PrometheusAPI,
+	})
+	if err != nil {
+		logrus.Warningf("new prometheus client failure, idle policy and post-deploy analysis are disabled: %v", err)
+	} else {
+		idleController = idlepolicy.NewController(store, mqclient, prometheusCli)
+		analysisController = releaseanalysis.NewController(store, mqclient, prometheusCli)
+	}
+	acmeController, err := acme.NewController(conf.ACME, conf.RBDNamespace, kubeClient, mqclient)
+	if err != nil {
+		logrus.Errorf("new acme controller: %v", err)
+		cancel()
+		return nil, err
+	}
+
 	return &Controller{
-		conf:              conf,
-		restConfig:        restConfig,
-		pc:                pc,
-		helmAppController: helmAppController,
-		store:             store,
-		stopCh:            stopCh,
-		cancel:            cancel,
-		ctx:               ctx,
-		dbmanager:         db.GetManager(),
+		conf:               conf,
+		restConfig:         restConfig,
+		pc:                 pc,
+		helmAppController:  helmAppController,
+		scheduleController: scalingschedule.NewController(mqclient),
+		idleController:     idleController,
+		canaryController:   canaryrelease.NewController(mqclient),
+		analysisController: analysisController,
+		acmeController:     acmeController,
+		mqclient:           mqclient,
+		store:              store,
+		stopCh:             stopCh,
+		cancel:             cancel,
+		ctx:                ctx,
+		dbmanager:          db.GetManager(),
 		memoryUse: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: "app_resource",
 			Name:      "appmemory",
@@ -197,6 +247,21 @@ func (m *Controller) Start() error {
 		go m.helmAppController.Start()
 		defer m.helmAppController.Stop()
 
+		// scaling schedule controller
+		go m.scheduleController.Start(ctx)
+		// idle policy controller
+		if m.idleController != nil {
+			go m.idleController.Start(ctx)
+		}
+		// canary release controller
+		go m.canaryController.Start(ctx)
+		// post-deploy release analysis controller
+		if m.analysisController != nil {
+			go m.analysisController.Start(ctx)
+		}
+		// auto tls certificates controller
+		go m.acmeController.Start(ctx)
+
 		// start controller
 		mgr, err := ctrl.NewManager(m.restConfig, ctrl.Options{
 			Scheme:           common.Scheme,
@@ -265,6 +330,7 @@ func (m *Controller) Start() error {
 //Stop stop
 func (m *Controller) Stop() {
 	close(m.stopCh)
+	m.mqclient.Close()
 }
 
 //Scrape scrape app runtime